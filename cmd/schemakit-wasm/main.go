@@ -0,0 +1,18 @@
+//go:build js && wasm
+
+// Package main provides the schemakit WebAssembly module.
+//
+// Build with:
+//
+//	GOOS=js GOARCH=wasm go build -o schemakit.wasm ./cmd/schemakit-wasm
+package main
+
+import (
+	"github.com/grokify/schemakit/wasm"
+)
+
+func main() {
+	wasm.Register()
+	// Keep the module alive so JavaScript can call the registered functions.
+	select {}
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var avroCompatOutput string
+
+func init() {
+	rootCmd.AddCommand(avroCompatCmd)
+
+	avroCompatCmd.Flags().StringVarP(&avroCompatOutput, "output", "o", "text", "Output format: text, json, github")
+}
+
+var avroCompatCmd = &cobra.Command{
+	Use:   "avro-compat <schema.json>",
+	Short: "Check that a JSON Schema can be represented in Avro",
+	Long: `Check whether a JSON Schema can be represented as an Avro record,
+for payloads that are also published with Avro, and list the blocking
+constructs:
+
+  - Objects mixing properties with additionalProperties (avro-open-map, error)
+  - Unions that are not a single type with null, or named records
+    (avro-union, error)
+  - Values of any type, or objects with untyped additional properties
+    (avro-untyped, error)
+  - allOf, which has no Avro equivalent (avro-allof, error)
+  - Property names Avro does not accept (avro-invalid-name, error)
+  - Enum values that are not Avro symbols (avro-enum-symbol, warning)
+
+Exit codes:
+  0 - No issues found
+  1 - Errors found
+  2 - Warnings found but no errors
+
+Examples:
+  schemakit avro-compat schema.json
+  schemakit avro-compat schema.json -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runAvroCompat,
+}
+
+func runAvroCompat(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read schema: %w", err)
+	}
+	schema, err := linter.ParseSchema(data)
+	if err != nil {
+		return err
+	}
+
+	result := &linter.Result{
+		SchemaPath: args[0],
+		Issues:     linter.CheckAvro(schema),
+	}
+
+	switch avroCompatOutput {
+	case "json":
+		data, err := result.JSON()
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(data))
+	case "github":
+		fmt.Print(result.GitHubAnnotations())
+	default:
+		fmt.Print(result.String())
+	}
+
+	if result.HasErrors() {
+		os.Exit(1)
+	}
+	if result.WarningCount() > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
@@ -0,0 +1,328 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	checkGoType   string
+	checkGoSchema string
+	checkGoOutput string
+)
+
+func init() {
+	rootCmd.AddCommand(checkGoCmd)
+
+	checkGoCmd.Flags().StringVarP(&checkGoType, "type", "t", "", "Go type as <package>.<Type> (required)")
+	checkGoCmd.Flags().StringVarP(&checkGoSchema, "schema", "s", "", "JSON Schema file (required)")
+	checkGoCmd.Flags().StringVarP(&checkGoOutput, "output", "o", "text", "Output format: text, json, github")
+	_ = checkGoCmd.MarkFlagRequired("type")
+	_ = checkGoCmd.MarkFlagRequired("schema")
+}
+
+var checkGoCmd = &cobra.Command{
+	Use:   "check-go --type <package>.<Type> --schema <schema.json>",
+	Short: "Check that a Go struct type matches a JSON Schema",
+	Long: `Check that a hand-written Go struct type matches a JSON Schema.
+
+This command creates a temporary Go program that uses reflection to
+describe the type's JSON encoding (json tags, embedded structs, field
+types) and compares it with the schema, following local $refs.
+
+Reported mismatches:
+  - Schema properties with no Go field (go-missing-field, error)
+  - Go fields that are not schema properties (go-extra-field, warning;
+    error if the schema sets additionalProperties: false)
+  - Type drift, e.g. float64 for an integer property (go-type-mismatch, error)
+  - Required properties with omitempty fields, or optional properties
+    that are always encoded (go-optionality-mismatch, warning)
+
+Exit codes:
+  0 - No mismatches found
+  1 - Errors found
+  2 - Warnings found but no errors
+
+Examples:
+  schemakit check-go --type github.com/myorg/myproject/types.Event --schema event.json`,
+	Args: cobra.NoArgs,
+	RunE: runCheckGo,
+}
+
+const checkGoTemplate = `//go:build ignore
+
+package main
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"strings"
+	"time"
+
+	target "{{.Package}}"
+)
+
+// GoType and GoField mirror linter.GoType and linter.GoField.
+type GoType struct {
+	Kind     string    ` + "`json:\"kind\"`" + `
+	Name     string    ` + "`json:\"name,omitempty\"`" + `
+	Nullable bool      ` + "`json:\"nullable,omitempty\"`" + `
+	Format   string    ` + "`json:\"format,omitempty\"`" + `
+	Elem     *GoType   ` + "`json:\"elem,omitempty\"`" + `
+	Fields   []GoField ` + "`json:\"fields,omitempty\"`" + `
+}
+
+type GoField struct {
+	Name      string  ` + "`json:\"name\"`" + `
+	JSONName  string  ` + "`json:\"json_name\"`" + `
+	OmitEmpty bool    ` + "`json:\"omitempty,omitempty\"`" + `
+	Type      *GoType ` + "`json:\"type\"`" + `
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+func main() {
+	t := describe(reflect.TypeOf(target.{{.Type}}{}), map[reflect.Type]bool{})
+	data, err := json.Marshal(t)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error marshaling type: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Println(string(data))
+}
+
+func describe(t reflect.Type, active map[reflect.Type]bool) *GoType {
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		nullable = true
+	}
+	gt := &GoType{Name: t.String(), Nullable: nullable}
+
+	switch {
+	case t == timeType:
+		gt.Kind, gt.Format = "string", "date-time"
+		return gt
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		gt.Kind = "any"
+		return gt
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		gt.Kind = "string"
+		return gt
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		gt.Kind = "string"
+	case reflect.Bool:
+		gt.Kind = "boolean"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		gt.Kind = "integer"
+	case reflect.Float32, reflect.Float64:
+		gt.Kind = "number"
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			gt.Kind = "string"
+			break
+		}
+		gt.Kind, gt.Elem = "array", describe(t.Elem(), active)
+		gt.Nullable = gt.Nullable || t.Kind() == reflect.Slice
+	case reflect.Map:
+		gt.Kind, gt.Elem, gt.Nullable = "map", describe(t.Elem(), active), true
+	case reflect.Struct:
+		gt.Kind = "struct"
+		if active[t] {
+			return gt
+		}
+		active[t] = true
+		gt.Fields = describeFields(t, active)
+		delete(active, t)
+	default:
+		gt.Kind, gt.Nullable = "any", true
+	}
+	return gt
+}
+
+func describeFields(t reflect.Type, active map[reflect.Type]bool) []GoField {
+	var fields []GoField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				fields = append(fields, describeFields(ft, active)...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, GoField{
+			Name:      field.Name,
+			JSONName:  name,
+			OmitEmpty: strings.Contains(","+opts+",", ",omitempty,") || strings.Contains(","+opts+",", ",omitzero,"),
+			Type:      describe(field.Type, active),
+		})
+	}
+	return fields
+}
+`
+
+func runCheckGo(cmd *cobra.Command, args []string) error {
+	dot := strings.LastIndex(checkGoType, ".")
+	if dot <= strings.LastIndex(checkGoType, "/") {
+		return fmt.Errorf("type must be <package>.<Type>: %s", checkGoType)
+	}
+	pkgPath, typeName := checkGoType[:dot], checkGoType[dot+1:]
+
+	// Validate type name starts with uppercase (exported)
+	if len(typeName) == 0 || typeName[0] < 'A' || typeName[0] > 'Z' {
+		return fmt.Errorf("type name must be exported (start with uppercase): %s", typeName)
+	}
+
+	schemaData, err := os.ReadFile(checkGoSchema)
+	if err != nil {
+		return fmt.Errorf("failed to read schema: %w", err)
+	}
+	schema, err := linter.ParseSchema(schemaData)
+	if err != nil {
+		return err
+	}
+
+	// Find the module root and module name
+	modRoot, modName := findModule(pkgPath)
+
+	// Create temp directory
+	tmpDir, err := os.MkdirTemp("", "schemakit-checkgo-*")
+	if err != nil {
+		return fmt.Errorf("failed to create temp directory: %w", err)
+	}
+	defer os.RemoveAll(tmpDir)
+
+	// Generate the temporary program
+	tmpl, err := template.New("checkgo").Parse(checkGoTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var buf bytes.Buffer
+	err = tmpl.Execute(&buf, map[string]any{
+		"Package": pkgPath,
+		"Type":    typeName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	// Write the temporary program
+	progFile := filepath.Join(tmpDir, "checkgo.go")
+	if err := os.WriteFile(progFile, buf.Bytes(), 0600); err != nil {
+		return fmt.Errorf("failed to write temp file: %w", err)
+	}
+
+	// Helper function to run go commands
+	goCmd := func(args ...string) error {
+		c := exec.Command("go", args...)
+		c.Dir = tmpDir
+		c.Env = append(os.Environ(), "GO111MODULE=on")
+		var stderr bytes.Buffer
+		c.Stderr = &stderr
+		if err := c.Run(); err != nil {
+			return fmt.Errorf("go %v failed: %w\n%s", args, err, stderr.String())
+		}
+		return nil
+	}
+
+	// Initialize the go module
+	if err := goCmd("mod", "init", "schemakit-checkgo"); err != nil {
+		return err
+	}
+
+	// Fetch the target module
+	if modRoot != "" {
+		if err := goCmd("mod", "edit", "-replace", modName+"="+modRoot); err != nil {
+			return err
+		}
+		if err := goCmd("get", pkgPath); err != nil {
+			return err
+		}
+	} else {
+		if err := goCmd("get", modName+"@latest"); err != nil {
+			return err
+		}
+	}
+
+	// Describe the Go type
+	describeCmd := exec.Command("go", "run", "checkgo.go")
+	describeCmd.Dir = tmpDir
+	describeCmd.Env = append(os.Environ(), "GO111MODULE=on")
+	var stdout, stderr bytes.Buffer
+	describeCmd.Stdout = &stdout
+	describeCmd.Stderr = &stderr
+
+	if err := describeCmd.Run(); err != nil {
+		return fmt.Errorf("failed to describe Go type: %w\n%s", err, stderr.String())
+	}
+
+	var goType linter.GoType
+	if err := json.Unmarshal(stdout.Bytes(), &goType); err != nil {
+		return fmt.Errorf("failed to parse Go type description: %w", err)
+	}
+
+	result := &linter.Result{
+		SchemaPath: checkGoSchema,
+		Issues:     linter.CheckGoType(schema, &goType),
+	}
+
+	switch checkGoOutput {
+	case "json":
+		data, err := result.JSON()
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(data))
+	case "github":
+		fmt.Print(result.GitHubAnnotations())
+	default:
+		fmt.Print(result.String())
+	}
+
+	if result.HasErrors() {
+		os.Exit(1)
+	}
+	if result.WarningCount() > 0 {
+		os.Exit(2)
+	}
+
+	return nil
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	compareLabels []string
+	compareOutput string
+)
+
+func init() {
+	rootCmd.AddCommand(compareCmd)
+
+	compareCmd.Flags().StringArrayVar(&compareLabels, "label", nil, "Environment and its schema file as name=path (e.g., prod=prod.json); repeat for each environment")
+	compareCmd.Flags().StringVarP(&compareOutput, "output", "o", "text", "Output format: text, json")
+}
+
+var compareCmd = &cobra.Command{
+	Use:   "compare --label <name>=<schema.json> --label <name>=<schema.json> ...",
+	Short: "Compare versions of a schema deployed in different environments",
+	Long: `Compare versions of the same schema deployed in different environments
+(e.g., prod, staging) and show the differences as a matrix with a column
+per environment:
+
+  - Definitions that some environments do not define
+  - Properties whose type, format, enum, requiredness, or nullability
+    differs, or that some environments do not declare
+
+The root schema and its $defs and definitions are compared.
+
+Exit codes:
+  0 - No differences
+  1 - Definitions missing in some environments
+  2 - Property drift but no missing definitions
+
+Examples:
+  schemakit compare --label prod=prod.json --label staging=staging.json
+  schemakit compare --label prod=prod.json --label staging=staging.json --label dev=dev.json -o json`,
+	Args: cobra.NoArgs,
+	RunE: runCompare,
+}
+
+func runCompare(cmd *cobra.Command, args []string) error {
+	if len(compareLabels) < 2 {
+		return fmt.Errorf("compare requires at least two --label flags")
+	}
+	envs := make([]linter.Environment, 0, len(compareLabels))
+	for _, label := range compareLabels {
+		name, path, ok := strings.Cut(label, "=")
+		if !ok || name == "" || path == "" {
+			return fmt.Errorf("invalid --label %q (expected name=path)", label)
+		}
+		for _, env := range envs {
+			if env.Label == name {
+				return fmt.Errorf("duplicate --label %q", name)
+			}
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read schema for %s: %w", name, err)
+		}
+		schema, err := linter.ParseSchema(data)
+		if err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+		envs = append(envs, linter.Environment{Label: name, Schema: schema})
+	}
+
+	m := linter.CompareEnvironments(envs)
+	switch compareOutput {
+	case "json":
+		data, err := m.JSON()
+		if err != nil {
+			return fmt.Errorf("failed to serialize matrix: %w", err)
+		}
+		fmt.Println(string(data))
+	default:
+		fmt.Print(m.String())
+	}
+
+	if len(m.Missing) > 0 {
+		os.Exit(1)
+	}
+	if len(m.Drift) > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
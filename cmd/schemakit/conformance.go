@@ -0,0 +1,214 @@
+package main
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// expectedSuffix is the file name suffix of golden issue files.
+const expectedSuffix = ".expected.json"
+
+var testUpdate bool
+
+func init() {
+	rootCmd.AddCommand(testCmd)
+
+	testCmd.Flags().BoolVarP(&testUpdate, "update", "u", false, "Write the current issues to the expected files")
+	addLintConfigFlags(testCmd)
+}
+
+var testCmd = &cobra.Command{
+	Use:   "test <dir>",
+	Short: "Run golden-file lint conformance tests",
+	Long: `Lint each schema in a directory and compare the issues against
+an adjacent golden file: schema.json is checked against
+schema.expected.json, a JSON array of issues in the lint -o json format.
+
+Directories are searched recursively. Use --update to (re)generate the
+expected files after an intended change, and review the diff.
+
+Exit codes:
+  0 - All schemas match their expected issues
+  1 - One or more schemas differ or have no expected file
+
+Examples:
+  schemakit test ./testdata
+  schemakit test ./testdata --config schemakit.json
+  schemakit test ./testdata --update`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTest,
+}
+
+func runTest(cmd *cobra.Command, args []string) error {
+	config, err := loadLintConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	schemas, err := findSchemaFiles(args[0])
+	if err != nil {
+		return err
+	}
+	if len(schemas) == 0 {
+		return fmt.Errorf("no schemas found in %s", args[0])
+	}
+
+	out := cmd.OutOrStdout()
+	l := linter.New(config)
+	failed := 0
+	for _, path := range schemas {
+		ok, err := testSchema(out, l, path, testUpdate)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			failed++
+		}
+	}
+
+	fmt.Fprintf(out, "\n%d passed, %d failed\n", len(schemas)-failed, failed)
+	if failed > 0 {
+		os.Exit(1)
+	}
+	return nil
+}
+
+// isSchemaFile reports whether a path has a schema file extension: .json,
+// or .jsonc or .json5 for schemas with comments.
+func isSchemaFile(path string) bool {
+	switch filepath.Ext(path) {
+	case ".json", ".jsonc", ".json5":
+		return true
+	}
+	return false
+}
+
+// findSchemaFiles returns the schema files under dir, excluding golden files.
+func findSchemaFiles(dir string) ([]string, error) {
+	var schemas []string
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && isSchemaFile(path) && !strings.HasSuffix(path, expectedSuffix) {
+			schemas = append(schemas, path)
+		}
+		return nil
+	})
+	return schemas, err
+}
+
+// expectedPath returns the golden file path for a schema path, replacing
+// the schema's extension: both schema.json and schema.jsonc map to
+// schema.expected.json.
+func expectedPath(schemaPath string) string {
+	return strings.TrimSuffix(schemaPath, filepath.Ext(schemaPath)) + expectedSuffix
+}
+
+// testSchema lints the schema and compares the issues against its golden
+// file, or writes the golden file when update is set. It reports whether
+// the schema passed.
+func testSchema(w io.Writer, l *linter.Linter, path string, update bool) (bool, error) {
+	result, err := l.LintFile(path)
+	if err != nil {
+		fmt.Fprintf(w, "FAIL %s\n  %v\n", path, err)
+		return false, nil
+	}
+	actual := result.Issues
+	if actual == nil {
+		actual = []linter.Issue{}
+	}
+
+	goldenPath := expectedPath(path)
+	if update {
+		// Sorted so that regenerating the file does not reorder issues that
+		// are reported in map order.
+		slices.SortFunc(actual, compareIssues)
+		data, err := json.MarshalIndent(actual, "", "  ")
+		if err != nil {
+			return false, fmt.Errorf("failed to serialize issues: %w", err)
+		}
+		if err := os.WriteFile(goldenPath, append(data, '\n'), 0o600); err != nil {
+			return false, fmt.Errorf("failed to write expected file: %w", err)
+		}
+		fmt.Fprintf(w, "UPDATE %s\n", path)
+		return true, nil
+	}
+
+	data, err := os.ReadFile(goldenPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		fmt.Fprintf(w, "FAIL %s\n  missing %s (run with --update to create it)\n", path, goldenPath)
+		return false, nil
+	} else if err != nil {
+		return false, fmt.Errorf("failed to read expected file: %w", err)
+	}
+	var expected []linter.Issue
+	if err := json.Unmarshal(data, &expected); err != nil {
+		return false, fmt.Errorf("failed to parse %s: %w", goldenPath, err)
+	}
+
+	missing, unexpected := diffIssues(expected, actual)
+	if len(missing) == 0 && len(unexpected) == 0 {
+		fmt.Fprintf(w, "PASS %s\n", path)
+		return true, nil
+	}
+
+	fmt.Fprintf(w, "FAIL %s\n", path)
+	for _, issue := range missing {
+		fmt.Fprintf(w, "  - %s [%s] %s: %s\n", issue.Path, issue.Code, issue.Severity, issue.Message)
+	}
+	for _, issue := range unexpected {
+		fmt.Fprintf(w, "  + %s [%s] %s: %s\n", issue.Path, issue.Code, issue.Severity, issue.Message)
+	}
+	return false, nil
+}
+
+// compareIssues orders issues by path, code, and message.
+func compareIssues(a, b linter.Issue) int {
+	return cmp.Or(
+		strings.Compare(a.Path.String(), b.Path.String()),
+		strings.Compare(string(a.Code), string(b.Code)),
+		strings.Compare(a.Message, b.Message),
+	)
+}
+
+// goldenKey returns a comparable key covering every field of the issue.
+func goldenKey(issue linter.Issue) string {
+	data, _ := json.Marshal(issue)
+	return string(data)
+}
+
+// diffIssues returns the expected issues that were not reported and the
+// reported issues that were not expected, matching duplicates by count.
+func diffIssues(expected, actual []linter.Issue) (missing, unexpected []linter.Issue) {
+	counts := make(map[string]int, len(expected))
+	for _, issue := range expected {
+		counts[goldenKey(issue)]++
+	}
+	for _, issue := range actual {
+		if key := goldenKey(issue); counts[key] > 0 {
+			counts[key]--
+		} else {
+			unexpected = append(unexpected, issue)
+		}
+	}
+	for _, issue := range expected {
+		if key := goldenKey(issue); counts[key] > 0 {
+			counts[key]--
+			missing = append(missing, issue)
+		}
+	}
+	return missing, unexpected
+}
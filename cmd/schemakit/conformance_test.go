@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+func TestExpectedPath(t *testing.T) {
+	tests := []struct {
+		path string
+		want string
+	}{
+		{"schemas/order.json", "schemas/order.expected.json"},
+		{"schemas/order.jsonc", "schemas/order.expected.json"},
+		{"schemas/order.json5", "schemas/order.expected.json"},
+		{"schemas/order.v2.jsonc", "schemas/order.v2.expected.json"},
+	}
+
+	for _, tt := range tests {
+		if got := expectedPath(tt.path); got != tt.want {
+			t.Errorf("expectedPath(%q) = %q, want %q", tt.path, got, tt.want)
+		}
+	}
+}
+
+func TestTestSchemaJSONC(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "order.jsonc")
+	schema := `{
+		// An order.
+		"type": "object",
+		"properties": {"order_id": {"type": "string"}}
+	}`
+	if err := os.WriteFile(path, []byte(schema), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	l := linter.New(linter.Config{})
+	if ok, err := testSchema(io.Discard, l, path, true); err != nil || !ok {
+		t.Fatalf("update: ok = %v, err = %v", ok, err)
+	}
+	if _, err := os.Stat(filepath.Join(dir, "order.expected.json")); err != nil {
+		t.Fatalf("golden file not written: %v", err)
+	}
+	if _, err := os.Stat(path + expectedSuffix); err == nil {
+		t.Errorf("golden file written with the schema extension kept")
+	}
+
+	if ok, err := testSchema(io.Discard, l, path, false); err != nil || !ok {
+		t.Errorf("check: ok = %v, err = %v", ok, err)
+	}
+}
+
+func TestTestSchemaUpdateSorted(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "order.json")
+	schema := `{
+		"type": "object",
+		"properties": {
+			"order_id": {"type": "string"},
+			"customer_id": {"type": "string"},
+			"line_items": {"type": "array"},
+			"created_at": {"type": "string"},
+			"ship_to": {"type": "string"}
+		}
+	}`
+	if err := os.WriteFile(path, []byte(schema), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	l := linter.New(linter.Config{})
+	var first []byte
+	for i := 0; i < 5; i++ {
+		if ok, err := testSchema(io.Discard, l, path, true); err != nil || !ok {
+			t.Fatalf("update: ok = %v, err = %v", ok, err)
+		}
+		data, err := os.ReadFile(filepath.Join(dir, "order.expected.json"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i == 0 {
+			first = data
+		} else if !bytes.Equal(data, first) {
+			t.Fatalf("update %d wrote\n%s\nwant\n%s", i, data, first)
+		}
+	}
+}
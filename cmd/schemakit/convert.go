@@ -0,0 +1,86 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	convertTo  string
+	convertOut string
+)
+
+func init() {
+	rootCmd.AddCommand(convertCmd)
+
+	convertCmd.Flags().StringVar(&convertTo, "to", "2020-12", "Target draft: 2020-12")
+	convertCmd.Flags().StringVar(&convertOut, "out", "", "Output file (default: stdout)")
+	addLintConfigFlags(convertCmd)
+}
+
+var convertCmd = &cobra.Command{
+	Use:   "convert <schema.json>",
+	Short: "Convert a draft-07 schema to JSON Schema 2020-12",
+	Long: `Rewrite a draft-07 (or earlier) schema for JSON Schema 2020-12:
+
+  - definitions becomes $defs, and #/definitions/ $refs follow
+  - tuple items becomes prefixItems, and additionalItems becomes items
+  - dependencies is split into dependentRequired and dependentSchemas
+  - $schema is set to the 2020-12 meta-schema
+
+Key order and all other keywords are preserved. The converted schema is
+then linted, with the report written to stderr.
+
+Exit codes:
+  0 - Converted schema has no issues
+  1 - Converted schema has errors
+  2 - Converted schema has warnings but no errors
+
+Examples:
+  schemakit convert schema.json --to 2020-12 > schema.2020-12.json
+  schemakit convert schema.json --out schema.json --config schemakit.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runConvert,
+}
+
+func runConvert(cmd *cobra.Command, args []string) error {
+	config, err := loadLintConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read schema: %w", err)
+	}
+	converted, err := linter.Convert(data, convertTo)
+	if err != nil {
+		return err
+	}
+
+	if convertOut == "" {
+		if _, err := os.Stdout.Write(converted); err != nil {
+			return err
+		}
+	} else if err := os.WriteFile(convertOut, converted, 0o600); err != nil {
+		return fmt.Errorf("failed to write schema: %w", err)
+	}
+
+	result, err := linter.New(config).Lint(converted)
+	if err != nil {
+		return fmt.Errorf("failed to lint converted schema: %w", err)
+	}
+	fmt.Fprint(os.Stderr, result.String())
+
+	if result.HasErrors() {
+		os.Exit(1)
+	}
+	if result.WarningCount() > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
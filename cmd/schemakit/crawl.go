@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+	"github.com/grokify/schemakit/linter/httpfetch"
+)
+
+func init() {
+	rootCmd.AddCommand(crawlCmd)
+
+	crawlCmd.Flags().StringVarP(&lintOutput, "output", "o", "text", "Output format: text, json, github")
+	addLintConfigFlags(crawlCmd)
+}
+
+var crawlCmd = &cobra.Command{
+	Use:   "crawl <manifest.json>",
+	Short: "Lint every schema listed in a manifest",
+	Long: `Lint the schemas listed in a manifest file in one run, with a result
+per schema. Sources are local files or glob patterns (relative to the
+manifest), URLs, and subjects in a Confluent-compatible schema registry:
+
+  {
+    "sources": [
+      {"name": "orders", "path": "../orders/schemas/*.json"},
+      {"name": "billing", "url": "https://example.com/schemas/billing.json"},
+      {"registry": "http://registry:8081", "subject": "payments-value"}
+    ]
+  }
+
+The crawl is read-only: files are not modified and registries are only
+queried. A source that cannot be fetched or parsed is reported as a
+source-unreadable error in its result, and the other sources are still
+linted.
+
+Exit codes:
+  0 - No issues found
+  1 - Errors found in any source
+  2 - Warnings found but no errors
+
+Examples:
+  schemakit crawl schemas.manifest.json
+  schemakit crawl schemas.manifest.json -o json --profile scale`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCrawl,
+}
+
+func runCrawl(cmd *cobra.Command, args []string) error {
+	manifest, fsys, err := loadManifest(args[0])
+	if err != nil {
+		return err
+	}
+	config, err := loadLintConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	crawled := linter.New(config).Crawl(context.Background(), manifest, fsys, httpfetch.New(nil))
+	if len(crawled) == 0 {
+		return fmt.Errorf("no schemas found in %s", args[0])
+	}
+	results := make([]*linter.Result, len(crawled))
+	for i := range crawled {
+		results[i] = &crawled[i]
+	}
+	return reportResults(results)
+}
+
+// loadManifest reads a manifest file. Its path sources are read from the
+// returned file system, which is rooted at the volume root so that paths
+// may leave the manifest's directory, with Dir set to that directory.
+func loadManifest(path string) (*linter.Manifest, fs.FS, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read manifest: %w", err)
+	}
+	defer f.Close()
+	m, err := linter.ParseManifest(f)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s: %w", path, err)
+	}
+
+	dir, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve manifest directory: %w", err)
+	}
+	root := filepath.VolumeName(dir) + string(filepath.Separator)
+	rel, err := filepath.Rel(root, dir)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve manifest directory: %w", err)
+	}
+	m.Dir = filepath.ToSlash(rel)
+	return m, os.DirFS(root), nil
+}
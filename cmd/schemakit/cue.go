@@ -0,0 +1,73 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	cueOutput  string
+	cuePackage string
+)
+
+func init() {
+	generateCmd.AddCommand(cueCmd)
+
+	cueCmd.Flags().StringVarP(&cueOutput, "output", "o", "", "Output file (default: stdout)")
+	cueCmd.Flags().StringVar(&cuePackage, "package", "", "CUE package name")
+}
+
+var cueCmd = &cobra.Command{
+	Use:   "cue <schema.json>",
+	Short: "Export a JSON Schema as CUE definitions",
+	Long: `Export a JSON Schema as CUE definitions, so configuration validated
+with CUE can reuse linted schemas.
+
+The root schema (named by its title) and each definition become CUE
+definitions. Required properties are regular fields and others are
+optional; objects stay open unless additionalProperties is false.
+Length, pattern, numeric, and item count constraints are kept.
+
+Keywords CUE cannot express (most formats, multipleOf, contains, and
+oneOf exclusivity) are left out and reported on stderr as
+cue-unsupported info issues.
+
+Examples:
+  schemakit gen cue schema.json
+  schemakit gen cue schema.json --package config -o config.cue`,
+	Args: cobra.ExactArgs(1),
+	RunE: runCUE,
+}
+
+func runCUE(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	schema, err := linter.ParseSchema(data)
+	if err != nil {
+		return err
+	}
+
+	cue, issues := linter.GenerateCUE(schema, cuePackage)
+
+	if cueOutput != "" {
+		if err := os.WriteFile(cueOutput, []byte(cue), 0600); err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Generated %s\n", cueOutput)
+	} else {
+		fmt.Print(cue)
+	}
+
+	if len(issues) > 0 {
+		result := linter.Result{SchemaPath: args[0], Issues: issues}
+		fmt.Fprint(cmd.ErrOrStderr(), result.String())
+	}
+	return nil
+}
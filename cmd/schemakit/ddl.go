@@ -0,0 +1,97 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var ddlOutput string
+
+func init() {
+	rootCmd.AddCommand(ddlCmd)
+
+	ddlCmd.Flags().StringVarP(&ddlOutput, "output", "o", "text", "Output format: text, json, github")
+}
+
+var ddlCmd = &cobra.Command{
+	Use:   "ddl <schema.json>",
+	Short: "Map a JSON Schema to Postgres tables",
+	Long: `Map the top-level object definitions of a JSON Schema to Postgres
+tables, for payloads validated by the schema and persisted in a
+database, and report constructs with no clean column mapping.
+
+The root schema (named by its title) and each definition with
+properties become tables, with a column per property:
+  - string     -> text (timestamptz, date, time, or uuid by format)
+  - integer    -> bigint
+  - number     -> double precision
+  - boolean    -> boolean
+  - arrays     -> arrays of the item type (jsonb for nested values)
+  - objects    -> jsonb
+Required properties that do not allow null are NOT NULL. allOf parts
+are merged into the table.
+
+These are reported as ddl-unmappable warnings:
+  - Values of mixed or unknown type, stored as jsonb
+  - Additional properties, which have no columns
+  - Unions of objects, which have no single table
+  - Properties that map to the same column name
+
+The text output is CREATE TABLE statements, with the issues on stderr.
+
+Exit codes:
+  0 - No issues found
+  2 - Warnings found
+
+Examples:
+  schemakit ddl schema.json
+  schemakit ddl schema.json -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDDL,
+}
+
+func runDDL(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read schema: %w", err)
+	}
+	schema, err := linter.ParseSchema(data)
+	if err != nil {
+		return err
+	}
+
+	report := linter.MapDDL(schema)
+	result := &linter.Result{
+		SchemaPath: args[0],
+		Issues:     report.Issues,
+	}
+
+	switch ddlOutput {
+	case "json":
+		data, err := json.MarshalIndent(struct {
+			SchemaPath string `json:"schema_path"`
+			*linter.DDLReport
+		}{args[0], report}, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(data))
+	case "github":
+		fmt.Print(result.GitHubAnnotations())
+	default:
+		fmt.Print(report.SQL())
+		if len(result.Issues) > 0 {
+			fmt.Fprint(cmd.ErrOrStderr(), result.String())
+		}
+	}
+
+	if result.WarningCount() > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	docsOut        string
+	docsFormat     string
+	docsConfigPath string
+)
+
+func init() {
+	rootCmd.AddCommand(docsCmd)
+
+	docsCmd.Flags().StringVar(&docsOut, "out", "site", "Output directory")
+	docsCmd.Flags().StringVar(&docsFormat, "format", "markdown", "Output format: markdown, html")
+	docsCmd.Flags().StringVarP(&docsConfigPath, "config", "c", "", "JSON lint config file, for the discriminator fields")
+}
+
+var docsCmd = &cobra.Command{
+	Use:   "docs <schema.json>",
+	Short: "Generate a documentation site from a JSON Schema",
+	Long: `Generate browsable reference documentation for a JSON Schema: an
+index page and a page for the root schema and each definition.
+
+Each page lists:
+  - Fields with their types, required status, and descriptions
+  - Enum values
+  - Union variants, with their discriminator values
+  - The definitions that reference it
+
+Local $refs become links between pages. Discriminators are found as in
+lint, using the discriminator fields of the --config file (default:
+component_type, type, kind).
+
+Examples:
+  schemakit docs schema.json --out ./site
+  schemakit docs schema.json --out ./site --format html`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDocs,
+}
+
+// docsPage is the template data of a definition page.
+type docsPage struct {
+	// Site is the title of the documentation site.
+	Site string
+	linter.DefinitionDoc
+}
+
+// docsIndex is the template data of the index page.
+type docsIndex struct {
+	Title       string
+	Description string
+	Definitions []linter.DefinitionDoc
+}
+
+func runDocs(cmd *cobra.Command, args []string) error {
+	var ext string
+	switch docsFormat {
+	case "markdown":
+		ext = ".md"
+	case "html":
+		ext = ".html"
+	default:
+		return fmt.Errorf("unknown format %q (use 'markdown' or 'html')", docsFormat)
+	}
+
+	config := linter.DefaultConfig()
+	if docsConfigPath != "" {
+		var err error
+		if config, err = linter.LoadConfig(docsConfigPath); err != nil {
+			return err
+		}
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read schema: %w", err)
+	}
+	schema, err := linter.ParseSchema(data)
+	if err != nil {
+		return err
+	}
+	defs := linter.New(config).Document(schema)
+
+	title := schema.Title
+	if title == "" {
+		title = strings.TrimSuffix(filepath.Base(args[0]), filepath.Ext(args[0]))
+	}
+
+	if err := os.MkdirAll(docsOut, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	write := func(name string, render func(w io.Writer) error) error {
+		path := filepath.Join(docsOut, name+ext)
+		f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+		if err != nil {
+			return fmt.Errorf("failed to write page: %w", err)
+		}
+		if err := render(f); err != nil {
+			f.Close()
+			return fmt.Errorf("failed to render %s: %w", path, err)
+		}
+		if err := f.Close(); err != nil {
+			return fmt.Errorf("failed to write page: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), path)
+		return nil
+	}
+
+	index := docsIndex{Title: title, Description: schema.Description, Definitions: defs}
+	if err := write("index", func(w io.Writer) error {
+		return executeDocsTemplate(w, "index", ext, index)
+	}); err != nil {
+		return err
+	}
+	for _, def := range defs {
+		page := docsPage{Site: title, DefinitionDoc: def}
+		if err := write(docsFileName(def.Name), func(w io.Writer) error {
+			return executeDocsTemplate(w, "page", ext, page)
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// docsUnsafeChars matches characters not used in page file names.
+var docsUnsafeChars = regexp.MustCompile(`[^A-Za-z0-9._-]+`)
+
+// docsFileName returns the page file name (without extension) of a
+// definition.
+func docsFileName(name string) string {
+	name = docsUnsafeChars.ReplaceAllString(name, "_")
+	if name == "index" || strings.HasPrefix(name, ".") {
+		name = "_" + name
+	}
+	return name
+}
+
+// docsCell escapes text for a Markdown table cell.
+func docsCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	return strings.Join(strings.Fields(s), " ")
+}
+
+func executeDocsTemplate(w io.Writer, name, ext string, data any) error {
+	link := func(name string) string { return docsFileName(name) + ext }
+	if ext == ".html" {
+		t := htmltemplate.Must(htmltemplate.New("docs").Funcs(htmltemplate.FuncMap{
+			"link": link,
+			"join": strings.Join,
+		}).Parse(docsHTMLTemplate))
+		return t.ExecuteTemplate(w, name, data)
+	}
+	t := template.Must(template.New("docs").Funcs(template.FuncMap{
+		"link": link,
+		"cell": docsCell,
+		"join": strings.Join,
+	}).Parse(docsMarkdownTemplate))
+	return t.ExecuteTemplate(w, name, data)
+}
+
+const docsMarkdownTemplate = `{{define "index"}}# {{.Title}}
+{{with .Description}}
+{{.}}
+{{end}}
+| Definition | Type | Description |
+|------------|------|-------------|
+{{range .Definitions}}| [{{.Name}}]({{link .Name}}) | {{cell .Type}} | {{cell .Description}} |
+{{end}}{{end}}
+
+{{define "type"}}{{if .Ref}}[{{cell .Type}}]({{link .Ref}}){{else}}{{cell .Type}}{{end}}{{end}}
+
+{{define "page"}}# {{.Name}}
+
+[{{.Site}}](index.md) / ` + "`{{.Path}}`" + `
+{{if and .Title (ne .Title .Name)}}
+**{{.Title}}**
+{{end}}{{with .Description}}
+{{.}}
+{{end}}
+**Type:** {{.Type}}
+{{if .Fields}}
+## Fields
+
+| Field | Type | Required | Description |
+|-------|------|----------|-------------|
+{{range .Fields}}| ` + "`{{.Name}}`" + ` | {{template "type" .}} | {{if .Required}}yes{{else}}no{{end}} | {{cell .Description}}{{if .Enum}}{{if .Description}} {{end}}One of: {{cell (join .Enum ", ")}}{{end}} |
+{{end}}{{end}}{{if .Enum}}
+## Values
+
+{{range .Enum}}- ` + "`{{.}}`" + `
+{{end}}{{end}}{{with .Union}}
+## Variants
+
+{{if .Discriminator}}The ` + "`{{.Discriminator}}`" + ` property selects the variant ({{.Keyword}}).
+
+| Variant | ` + "`{{.Discriminator}}`" + ` |
+|---------|-------|
+{{range .Variants}}| {{template "type" .}} | {{with .Value}}` + "`{{.}}`" + `{{end}} |
+{{end}}{{else}}The value matches {{if eq .Keyword "oneOf"}}exactly one{{else}}at least one{{end}} of ({{.Keyword}}), with no discriminator:
+
+{{range .Variants}}- {{template "type" .}}
+{{end}}{{end}}{{end}}{{if .ReferencedBy}}
+## Referenced By
+
+{{range .ReferencedBy}}- [{{.}}]({{link .}})
+{{end}}{{end}}{{end}}`
+
+const docsHTMLTemplate = `{{define "head"}}<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>{{.}}</title>
+<style>
+body { font-family: sans-serif; max-width: 60rem; margin: 2rem auto; padding: 0 1rem; }
+table { border-collapse: collapse; }
+th, td { border: 1px solid #ccc; padding: 0.3rem 0.6rem; text-align: left; vertical-align: top; }
+code { background: #f4f4f4; padding: 0 0.2rem; }
+</style>
+</head>
+<body>
+{{end}}
+
+{{define "index"}}{{template "head" .Title}}<h1>{{.Title}}</h1>
+{{with .Description}}<p>{{.}}</p>
+{{end}}<table>
+<tr><th>Definition</th><th>Type</th><th>Description</th></tr>
+{{range .Definitions}}<tr><td><a href="{{link .Name}}">{{.Name}}</a></td><td>{{.Type}}</td><td>{{.Description}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+{{end}}
+
+{{define "type"}}{{if .Ref}}<a href="{{link .Ref}}">{{.Type}}</a>{{else}}{{.Type}}{{end}}{{end}}
+
+{{define "page"}}{{template "head" .Name}}<p><a href="index.html">{{.Site}}</a> / <code>{{.Path}}</code></p>
+<h1>{{.Name}}</h1>
+{{if and .Title (ne .Title .Name)}}<p><strong>{{.Title}}</strong></p>
+{{end}}{{with .Description}}<p>{{.}}</p>
+{{end}}<p><strong>Type:</strong> {{.Type}}</p>
+{{if .Fields}}<h2>Fields</h2>
+<table>
+<tr><th>Field</th><th>Type</th><th>Required</th><th>Description</th></tr>
+{{range .Fields}}<tr><td><code>{{.Name}}</code></td><td>{{template "type" .}}</td><td>{{if .Required}}yes{{else}}no{{end}}</td><td>{{.Description}}{{if .Enum}}{{if .Description}} {{end}}One of: {{join .Enum ", "}}{{end}}</td></tr>
+{{end}}</table>
+{{end}}{{if .Enum}}<h2>Values</h2>
+<ul>
+{{range .Enum}}<li><code>{{.}}</code></li>
+{{end}}</ul>
+{{end}}{{with .Union}}<h2>Variants</h2>
+{{if .Discriminator}}<p>The <code>{{.Discriminator}}</code> property selects the variant ({{.Keyword}}).</p>
+<table>
+<tr><th>Variant</th><th><code>{{.Discriminator}}</code></th></tr>
+{{range .Variants}}<tr><td>{{template "type" .}}</td><td>{{with .Value}}<code>{{.}}</code>{{end}}</td></tr>
+{{end}}</table>
+{{else}}<p>The value matches {{if eq .Keyword "oneOf"}}exactly one{{else}}at least one{{end}} of ({{.Keyword}}), with no discriminator:</p>
+<ul>
+{{range .Variants}}<li>{{template "type" .}}</li>
+{{end}}</ul>
+{{end}}{{end}}{{if .ReferencedBy}}<h2>Referenced By</h2>
+<ul>
+{{range .ReferencedBy}}<li><a href="{{link .}}">{{.}}</a></li>
+{{end}}</ul>
+{{end}}</body>
+</html>
+{{end}}`
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var doctorOutput string
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+
+	doctorCmd.Flags().StringVarP(&doctorOutput, "output", "o", "text", "Output format: text, json, github")
+}
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor <schema.json|dir>",
+	Short: "Check schema files for encoding and structural problems",
+	Long: `Check schema files for problems outside the scope of lint rules
+that cause confusing behavior:
+
+  - UTF-8 byte order mark, which schemakit skips but encoding/json
+    rejects (error)
+  - Invalid UTF-8, silently replaced with U+FFFD (warning)
+  - Duplicate object keys, silently collapsed to the last value (error)
+  - JSON nested more than 64 levels deep (warning)
+  - Definitions not reachable through $refs from the root (warning)
+
+Given a directory, every .json file under it is checked.
+
+Exit codes:
+  0 - No issues found
+  1 - Errors found
+  2 - Warnings found but no errors
+
+Examples:
+  schemakit doctor schema.json
+  schemakit doctor ./schemas -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runDoctor,
+}
+
+func runDoctor(cmd *cobra.Command, args []string) error {
+	files := []string{args[0]}
+	if info, err := os.Stat(args[0]); err == nil && info.IsDir() {
+		found, err := findSchemaFiles(args[0])
+		if err != nil {
+			return err
+		}
+		if len(found) == 0 {
+			return fmt.Errorf("no schemas found in %s", args[0])
+		}
+		files = found
+	}
+
+	results := make([]*linter.Result, 0, len(files))
+	for _, path := range files {
+		result, err := linter.DiagnoseFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to check %s: %w", path, err)
+		}
+		results = append(results, result)
+	}
+
+	switch doctorOutput {
+	case "json":
+		var v any = results
+		if len(results) == 1 {
+			v = results[0]
+		}
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(data))
+	case "github":
+		for _, result := range results {
+			fmt.Print(result.GitHubAnnotations())
+		}
+	default:
+		for i, result := range results {
+			if len(results) > 1 {
+				if i > 0 {
+					fmt.Println()
+				}
+				fmt.Printf("%s:\n", result.SchemaPath)
+			}
+			fmt.Print(result.String())
+		}
+	}
+
+	warnings := false
+	for _, result := range results {
+		if result.HasErrors() {
+			os.Exit(1)
+		}
+		warnings = warnings || result.WarningCount() > 0
+	}
+	if warnings {
+		os.Exit(2)
+	}
+	return nil
+}
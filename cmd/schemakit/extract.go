@@ -0,0 +1,66 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var extractOut string
+
+func init() {
+	rootCmd.AddCommand(extractCmd)
+
+	extractCmd.Flags().StringVar(&extractOut, "out", ".", "Output directory")
+}
+
+var extractCmd = &cobra.Command{
+	Use:   "extract <openapi.yaml>",
+	Short: "Extract JSON Schemas from an OpenAPI 3.0 document",
+	Long: `Write each schema under components/schemas of an OpenAPI 3.0
+document (YAML or JSON) to <out>/<Name>.json as a standard JSON Schema
+2020-12 file, so it can be linted and fed to standard generators.
+
+The OpenAPI 3.0 dialect is rewritten:
+  - nullable: true adds "null" to the type
+  - example becomes examples
+  - boolean exclusiveMinimum/exclusiveMaximum become numeric bounds
+  - format: byte adds contentEncoding: base64
+  - #/components/schemas/Name $refs become Name.json
+
+Examples:
+  schemakit extract openapi.yaml --out ./schemas/
+  schemakit extract openapi.yaml --out ./schemas/ && schemakit lint ./schemas/`,
+	Args: cobra.ExactArgs(1),
+	RunE: runExtract,
+}
+
+func runExtract(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read OpenAPI document: %w", err)
+	}
+	schemas, err := linter.ExtractOpenAPI(data)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(extractOut, 0o755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	for _, schema := range schemas {
+		if schema.Name != filepath.Base(schema.Name) || schema.Name == ".." {
+			return fmt.Errorf("invalid component name %q", schema.Name)
+		}
+		path := filepath.Join(extractOut, schema.Name+".json")
+		if err := os.WriteFile(path, schema.Data, 0o600); err != nil {
+			return fmt.Errorf("failed to write schema: %w", err)
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), path)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	fixOut   string
+	fixRules []string
+)
+
+func init() {
+	rootCmd.AddCommand(fixCmd)
+
+	fixCmd.Flags().StringVar(&fixOut, "out", "", "Output file (default: stdout)")
+	fixCmd.Flags().StringSliceVar(&fixRules, "rule", nil, "Fix only the findings of these rules (default: all fixable rules)")
+	addLintConfigFlags(fixCmd)
+}
+
+var fixCmd = &cobra.Command{
+	Use:   "fix <schema.json>",
+	Short: "Apply automatic fixes for lint findings",
+	Long: `Lint a schema and rewrite it to fix the findings of rules with an
+automatic fix:
+
+` + fixHelp() + `
+Renames of properties, enum members, and definitions change what
+instances and generated code use, so those rules are only fixed when
+given with --rule.
+
+Findings are fixed where lint reports them, so the lint configuration
+(profile, config file, x-schemalint suppressions) applies. Key order and
+all other keywords are preserved. Each fix is listed on stderr, followed
+by the lint report for the fixed schema.
+
+Exit codes:
+  0 - Fixed schema has no issues
+  1 - Fixed schema has errors
+  2 - Fixed schema has warnings but no errors
+
+Examples:
+  schemakit fix schema.json > schema.fixed.json
+  schemakit fix schema.json --out schema.json --rule missing-content-encoding`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFix,
+}
+
+// fixHelp lists the automatic fixes for command help, one per line.
+func fixHelp() string {
+	var sb strings.Builder
+	for _, fix := range linter.Fixes() {
+		fmt.Fprintf(&sb, "  - %s: %s", fix.Code, fix.Description)
+		if fix.OptIn {
+			sb.WriteString(" (only with --rule)")
+		}
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
+
+func runFix(cmd *cobra.Command, args []string) error {
+	config, err := loadLintConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read schema: %w", err)
+	}
+	codes := make([]linter.IssueCode, len(fixRules))
+	for i, rule := range fixRules {
+		codes[i] = linter.IssueCode(rule)
+	}
+	l := linter.New(config)
+	fixed, issues, err := l.Fix(data, codes...)
+	if err != nil {
+		return err
+	}
+
+	if fixOut == "" {
+		if _, err := os.Stdout.Write(fixed); err != nil {
+			return err
+		}
+	} else if err := os.WriteFile(fixOut, fixed, 0o600); err != nil {
+		return fmt.Errorf("failed to write schema: %w", err)
+	}
+
+	for _, issue := range issues {
+		fmt.Fprintf(os.Stderr, "fixed [%s] %s: %s\n", issue.Code, issue.Path, issue.Message)
+	}
+	if len(issues) > 0 {
+		fmt.Fprintln(os.Stderr)
+	}
+
+	result, err := l.Lint(fixed)
+	if err != nil {
+		return fmt.Errorf("failed to lint fixed schema: %w", err)
+	}
+	fmt.Fprint(os.Stderr, result.String())
+
+	if result.HasErrors() {
+		os.Exit(1)
+	}
+	if result.WarningCount() > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var flattenOut string
+
+func init() {
+	rootCmd.AddCommand(flattenCmd)
+
+	flattenCmd.Flags().StringVar(&flattenOut, "out", "", "Output file (default: stdout)")
+	addLintConfigFlags(flattenCmd)
+}
+
+var flattenCmd = &cobra.Command{
+	Use:   "flatten <schema.json>",
+	Short: "Merge allOf inheritance into plain object schemas",
+	Long: `Rewrite allOf inheritance (allOf: [{$ref: Base}, {extra properties}])
+into the merged object it describes:
+
+  - the properties and required lists of the parts replace the allOf,
+    with later parts overriding earlier properties of the same name
+  - bases that use allOf inheritance themselves are flattened first
+  - if a part sets additionalProperties: false, the merged object does
+
+The schema's own keywords and key order are kept. An allOf with a part
+that is not an object, or that uses anyOf or oneOf, is left alone. The
+flattened schema is then linted, with the report written to stderr.
+
+Exit codes:
+  0 - Flattened schema has no issues
+  1 - Flattened schema has errors
+  2 - Flattened schema has warnings but no errors
+
+Examples:
+  schemakit flatten schema.json > schema.flat.json
+  schemakit flatten schema.json --out schema.json --profile scale`,
+	Args: cobra.ExactArgs(1),
+	RunE: runFlatten,
+}
+
+func runFlatten(cmd *cobra.Command, args []string) error {
+	config, err := loadLintConfig(cmd)
+	if err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read schema: %w", err)
+	}
+	flattened, err := linter.Flatten(data)
+	if err != nil {
+		return err
+	}
+
+	if flattenOut == "" {
+		if _, err := os.Stdout.Write(flattened); err != nil {
+			return err
+		}
+	} else if err := os.WriteFile(flattenOut, flattened, 0o600); err != nil {
+		return fmt.Errorf("failed to write schema: %w", err)
+	}
+
+	result, err := linter.New(config).Lint(flattened)
+	if err != nil {
+		return fmt.Errorf("failed to lint flattened schema: %w", err)
+	}
+	fmt.Fprint(os.Stderr, result.String())
+
+	if result.HasErrors() {
+		os.Exit(1)
+	}
+	if result.WarningCount() > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
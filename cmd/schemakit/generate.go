@@ -25,8 +25,9 @@ func init() {
 }
 
 var generateCmd = &cobra.Command{
-	Use:   "generate <package> <type>",
-	Short: "Generate JSON Schema from Go struct type",
+	Use:     "generate <package> <type>",
+	Aliases: []string{"gen"},
+	Short:   "Generate JSON Schema from Go struct type",
 	Long: `Generate a JSON Schema from a Go struct type using reflection.
 
 This command creates a temporary Go program that imports your type and
@@ -42,6 +43,11 @@ Examples:
   # Generate without indentation
   schemakit generate --indent=false github.com/myorg/myproject/types Config
 
+Subcommands:
+  sample  - Generate an example instance of a JSON Schema
+  proto   - Preview a JSON Schema as proto3 messages
+  cue     - Export a JSON Schema as CUE definitions
+
 Notes:
   - The package must be importable (available locally or via go get)
   - The type must be exported (start with uppercase)
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var graphOutput string
+
+func init() {
+	rootCmd.AddCommand(graphCmd)
+
+	graphCmd.Flags().StringVarP(&graphOutput, "output", "o", "dot", "Output format: dot, mermaid, json")
+}
+
+var graphCmd = &cobra.Command{
+	Use:   "graph <schema.json>",
+	Short: "Visualize the definition/reference graph of a JSON Schema",
+	Long: `Render the definition/reference graph of a JSON Schema.
+
+Nodes are the root schema and its $defs/definitions; edges are $refs
+between them. Union definitions (anyOf/oneOf) are highlighted and edges
+from union variants are drawn dashed, making the structure behind
+nested-union and circular-reference warnings visible.
+
+Examples:
+  # Render with Graphviz
+  schemakit graph schema.json | dot -Tsvg > schema.svg
+
+  # Mermaid flowchart for Markdown docs
+  schemakit graph schema.json --output mermaid`,
+	Args: cobra.ExactArgs(1),
+	RunE: runGraph,
+}
+
+func runGraph(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	schema, err := linter.ParseSchema(data)
+	if err != nil {
+		return err
+	}
+
+	graph := linter.BuildGraph(schema)
+
+	switch graphOutput {
+	case "dot":
+		fmt.Print(graph.DOT())
+	case "mermaid":
+		fmt.Print(graph.Mermaid())
+	case "json":
+		data, err := json.MarshalIndent(graph, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize graph: %w", err)
+		}
+		fmt.Println(string(data))
+	default:
+		return fmt.Errorf("unknown output format: %s (use 'dot', 'mermaid', or 'json')", graphOutput)
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/grokify/schemakit/linter"
+	schemakitv1 "github.com/grokify/schemakit/proto/schemakit/v1"
+)
+
+// lintService implements the schemakit.v1.LintService gRPC API on top of
+// the linter, like lintHandler does for HTTP.
+type lintService struct {
+	schemakitv1.UnimplementedLintServiceServer
+	provider trace.TracerProvider
+	metrics  *serveMetrics
+}
+
+// newGRPCServer returns a gRPC server with the LintService registered,
+// accepting messages up to maxMsgSize bytes, recording lint requests in
+// metrics under the grpc transport, and recording spans if provider is
+// not nil.
+func newGRPCServer(provider trace.TracerProvider, metrics *serveMetrics, maxMsgSize int) *grpc.Server {
+	server := grpc.NewServer(
+		grpc.MaxRecvMsgSize(maxMsgSize),
+		grpc.StreamInterceptor(metrics.streamInterceptor),
+	)
+	schemakitv1.RegisterLintServiceServer(server, &lintService{provider: provider, metrics: metrics})
+	return server
+}
+
+// Lint lints a single document and streams its issues.
+func (s *lintService) Lint(req *schemakitv1.LintRequest, stream grpc.ServerStreamingServer[schemakitv1.Issue]) error {
+	config, err := s.config(req.GetConfig())
+	if err != nil {
+		return err
+	}
+	result, err := lintDocument(stream.Context(), linter.New(config), req.GetDocument())
+	if err != nil {
+		return err
+	}
+	s.metrics.observeResult(transportGRPC, result)
+	return sendIssues(stream, result)
+}
+
+// LintProject lints a set of documents, reporting root $ids declared by
+// more than one of them, and streams the issues of each document in turn.
+func (s *lintService) LintProject(req *schemakitv1.LintProjectRequest, stream grpc.ServerStreamingServer[schemakitv1.Issue]) error {
+	if len(req.GetDocuments()) == 0 {
+		return status.Error(codes.InvalidArgument, "no documents")
+	}
+	config, err := s.config(req.GetConfig())
+	if err != nil {
+		return err
+	}
+
+	l := linter.New(config)
+	results := make([]*linter.Result, 0, len(req.GetDocuments()))
+	for _, doc := range req.GetDocuments() {
+		result, err := lintDocument(stream.Context(), l, doc)
+		if err != nil {
+			return err
+		}
+		results = append(results, result)
+	}
+	l.CheckDuplicateIDs(results)
+
+	for _, result := range results {
+		s.metrics.observeResult(transportGRPC, result)
+		if err := sendIssues(stream, result); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ListRules returns the issue codes the linter can report.
+func (s *lintService) ListRules(context.Context, *schemakitv1.ListRulesRequest) (*schemakitv1.ListRulesResponse, error) {
+	rules := linter.Rules()
+	resp := &schemakitv1.ListRulesResponse{Rules: make([]*schemakitv1.Rule, 0, len(rules))}
+	for _, r := range rules {
+		resp.Rules = append(resp.Rules, &schemakitv1.Rule{Code: string(r.Code), Severity: string(r.Severity)})
+	}
+	return resp, nil
+}
+
+// config builds the lint configuration of a request, with the defaults of
+// the lint command for unset fields.
+func (s *lintService) config(c *schemakitv1.Config) (linter.Config, error) {
+	profile := c.GetProfile()
+	if profile == "" {
+		profile = "default"
+	}
+	propertyCase := c.GetPropertyCase()
+	if propertyCase == "" {
+		propertyCase = "camelCase"
+	}
+
+	config, err := buildConfig(profile, propertyCase)
+	if err != nil {
+		return config, status.Error(codes.InvalidArgument, err.Error())
+	}
+	config.StrictUnresolved = c.GetStrictUnresolved()
+	config.TracerProvider = s.provider
+	return config, nil
+}
+
+// lintDocument lints a request document, naming the result after its path.
+func lintDocument(ctx context.Context, l *linter.Linter, doc *schemakitv1.Document) (*linter.Result, error) {
+	if len(doc.GetContent()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: empty document", doc.GetPath())
+	}
+	result, err := l.LintContext(ctx, doc.GetContent())
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "%s: %v", doc.GetPath(), err)
+	}
+	result.SchemaPath = doc.GetPath()
+	return result, nil
+}
+
+// sendIssues streams the issues of a result.
+func sendIssues(stream grpc.ServerStreamingServer[schemakitv1.Issue], result *linter.Result) error {
+	for _, issue := range result.Issues {
+		err := stream.Send(&schemakitv1.Issue{
+			SchemaPath:   result.SchemaPath,
+			Code:         string(issue.Code),
+			Severity:     string(issue.Severity),
+			Path:         issue.Path.String(),
+			Message:      issue.Message,
+			Suggestion:   issue.Suggestion,
+			ReferencedBy: issue.ReferencedBy,
+			Line:         int32(issue.Line),
+			Column:       int32(issue.Column),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
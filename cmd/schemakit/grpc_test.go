@@ -0,0 +1,240 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"slices"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/grokify/schemakit/linter"
+	schemakitv1 "github.com/grokify/schemakit/proto/schemakit/v1"
+)
+
+// newTestLintClient serves the LintService over an in-memory connection.
+func newTestLintClient(t *testing.T) schemakitv1.LintServiceClient {
+	t.Helper()
+	return newTestLintClientMetrics(t, newServeMetrics())
+}
+
+// newTestLintClientMetrics is newTestLintClient recording in metrics.
+func newTestLintClientMetrics(t *testing.T, metrics *serveMetrics) schemakitv1.LintServiceClient {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	server := newGRPCServer(nil, metrics, 1<<20)
+	go func() { _ = server.Serve(lis) }()
+	t.Cleanup(server.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return schemakitv1.NewLintServiceClient(conn)
+}
+
+// recvIssues reads a stream of issues until it ends, returning the error
+// that ended it, if any.
+func recvIssues(stream grpc.ServerStreamingClient[schemakitv1.Issue]) ([]*schemakitv1.Issue, error) {
+	var issues []*schemakitv1.Issue
+	for {
+		issue, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return issues, nil
+		}
+		if err != nil {
+			return issues, err
+		}
+		issues = append(issues, issue)
+	}
+}
+
+func TestGRPCLint(t *testing.T) {
+	client := newTestLintClient(t)
+	schema := `{"type": "object", "properties": {"order_id": {"type": "string", "format": "uuid"}}}`
+
+	tests := []struct {
+		name     string
+		config   *schemakitv1.Config
+		wantCode string
+	}{
+		{name: "default config", wantCode: string(linter.CodeInvalidPropertyCase)},
+		{name: "snake_case", config: &schemakitv1.Config{PropertyCase: "snake_case"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.Lint(context.Background(), &schemakitv1.LintRequest{
+				Document: &schemakitv1.Document{Path: "order.json", Content: []byte(schema)},
+				Config:   tt.config,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			issues, err := recvIssues(stream)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var got []string
+			for _, issue := range issues {
+				if issue.GetSchemaPath() != "order.json" || issue.GetPath() != "$/properties/order_id" {
+					t.Errorf("issue %s at %s in %q", issue.GetCode(), issue.GetPath(), issue.GetSchemaPath())
+				}
+				got = append(got, issue.GetCode())
+			}
+			var want []string
+			if tt.wantCode != "" {
+				want = []string{tt.wantCode}
+			}
+			if !slices.Equal(got, want) {
+				t.Errorf("issues = %v, want %v", got, want)
+			}
+		})
+	}
+}
+
+func TestGRPCLintErrors(t *testing.T) {
+	client := newTestLintClient(t)
+
+	tests := []struct {
+		name string
+		req  *schemakitv1.LintRequest
+	}{
+		{
+			name: "unknown profile",
+			req: &schemakitv1.LintRequest{
+				Document: &schemakitv1.Document{Content: []byte(`{"type": "string"}`)},
+				Config:   &schemakitv1.Config{Profile: "strict"},
+			},
+		},
+		{
+			name: "malformed document",
+			req:  &schemakitv1.LintRequest{Document: &schemakitv1.Document{Content: []byte(`{"type": `)}},
+		},
+		{
+			name: "missing document",
+			req:  &schemakitv1.LintRequest{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stream, err := client.Lint(context.Background(), tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = recvIssues(stream)
+			if status.Code(err) != codes.InvalidArgument {
+				t.Errorf("err = %v, want InvalidArgument", err)
+			}
+		})
+	}
+}
+
+func TestGRPCLintProject(t *testing.T) {
+	client := newTestLintClient(t)
+	doc := func(path string) *schemakitv1.Document {
+		return &schemakitv1.Document{
+			Path:    path,
+			Content: []byte(`{"$id": "https://schemas.example.com/order.json", "type": "string"}`),
+		}
+	}
+
+	stream, err := client.LintProject(context.Background(), &schemakitv1.LintProjectRequest{
+		Documents: []*schemakitv1.Document{doc("a.json"), doc("b.json")},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues, err := recvIssues(stream)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("got %d issues, want 1: %v", len(issues), issues)
+	}
+	if issues[0].GetSchemaPath() != "b.json" || issues[0].GetCode() != string(linter.CodeDuplicateID) {
+		t.Errorf("issue = %s in %s, want %s in b.json", issues[0].GetCode(), issues[0].GetSchemaPath(), linter.CodeDuplicateID)
+	}
+
+	stream, err = client.LintProject(context.Background(), &schemakitv1.LintProjectRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recvIssues(stream); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("no documents: err = %v, want InvalidArgument", err)
+	}
+}
+
+func TestGRPCListRules(t *testing.T) {
+	client := newTestLintClient(t)
+
+	resp, err := client.ListRules(context.Background(), &schemakitv1.ListRulesRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	rules := linter.Rules()
+	if len(resp.GetRules()) != len(rules) {
+		t.Fatalf("got %d rules, want %d", len(resp.GetRules()), len(rules))
+	}
+	for i, r := range resp.GetRules() {
+		if r.GetCode() != string(rules[i].Code) || r.GetSeverity() != string(rules[i].Severity) {
+			t.Errorf("rule %d = %s/%s, want %s/%s", i, r.GetCode(), r.GetSeverity(), rules[i].Code, rules[i].Severity)
+		}
+	}
+}
+
+func TestGRPCMetrics(t *testing.T) {
+	metrics := newServeMetrics()
+	client := newTestLintClientMetrics(t, metrics)
+	schema := []byte(`{"type": "object", "properties": {"order_id": {"type": "string"}}}`)
+
+	stream, err := client.Lint(context.Background(), &schemakitv1.LintRequest{
+		Document: &schemakitv1.Document{Path: "order.json", Content: schema},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recvIssues(stream); err != nil {
+		t.Fatal(err)
+	}
+	stream, err = client.Lint(context.Background(), &schemakitv1.LintRequest{
+		Document: &schemakitv1.Document{Path: "empty.json"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := recvIssues(stream); status.Code(err) != codes.InvalidArgument {
+		t.Fatalf("err = %v, want InvalidArgument", err)
+	}
+	if _, err := client.ListRules(context.Background(), &schemakitv1.ListRulesRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	out := metrics.render()
+	for _, want := range []string{
+		`schemakit_lint_requests_total{transport="grpc",status="OK"} 1`,
+		`schemakit_lint_requests_total{transport="grpc",status="InvalidArgument"} 1`,
+		`schemakit_lint_results_total{transport="grpc",outcome="error"} 1`,
+		`schemakit_lint_issues_total{transport="grpc",code="invalid-property-case",severity="error"} 1`,
+		`schemakit_lint_duration_seconds_count{transport="grpc"} 2`,
+		`schemakit_lint_duration_seconds_count{transport="http"} 0`,
+	} {
+		if !strings.Contains(out, want+"\n") {
+			t.Errorf("missing line %q in:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+	"github.com/grokify/schemakit/store"
+)
+
+var (
+	lintStore     string
+	historyStore  string
+	historyOutput string
+)
+
+func init() {
+	rootCmd.AddCommand(historyCmd)
+
+	lintCmd.Flags().StringVar(&lintStore, "store", "", "Record the results in this SQLite database for history")
+
+	historyCmd.Flags().StringVar(&historyStore, "store", "", "SQLite database written by lint --store (required)")
+	historyCmd.Flags().StringVarP(&historyOutput, "output", "o", "text", "Output format: text, json")
+	_ = historyCmd.MarkFlagRequired("store")
+}
+
+var historyCmd = &cobra.Command{
+	Use:   "history <schema.json>",
+	Short: "Show when lint issues appeared and disappeared",
+	Long: `Show the lint runs of a schema recorded with lint --store, oldest
+first, with the issues that appeared (+) and disappeared (-) at each run.
+
+Runs are recorded by schema path as given to lint, with the time of the
+run and a SHA-256 hash of the schema file. Issues are matched across runs
+by code and path, as in lint --compare.
+
+Examples:
+  schemakit lint schema.json --store results.db
+  schemakit history schema.json --store results.db
+  schemakit history schema.json --store results.db -o json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runHistory,
+}
+
+func runHistory(cmd *cobra.Command, args []string) error {
+	if _, err := os.Stat(historyStore); err != nil {
+		return fmt.Errorf("failed to open result store: %w", err)
+	}
+	s, err := store.Open(historyStore)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	changes, err := s.History(args[0])
+	if err != nil {
+		return err
+	}
+	if len(changes) == 0 {
+		return fmt.Errorf("no runs recorded for %s", args[0])
+	}
+
+	if historyOutput == "json" {
+		data, err := json.MarshalIndent(changes, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize history: %w", err)
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+
+	for _, c := range changes {
+		fmt.Printf("%s  %.12s  %d error(s), %d warning(s)\n",
+			c.LintedAt.Format(time.RFC3339), c.SchemaHash, c.Errors, c.Warnings)
+		for _, issue := range c.Appeared {
+			fmt.Printf("  + [%s] %s: %s\n", issue.Severity, issue.Path, issue.Message)
+		}
+		for _, issue := range c.Disappeared {
+			fmt.Printf("  - [%s] %s: %s\n", issue.Severity, issue.Path, issue.Message)
+		}
+	}
+	return nil
+}
+
+// record stores the results in the --store database, if set, with the
+// hash of each schema file.
+func record(results ...linter.Result) error {
+	if lintStore == "" {
+		return nil
+	}
+	s, err := store.Open(lintStore)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	now := time.Now()
+	for _, result := range results {
+		data, err := os.ReadFile(result.SchemaPath)
+		if err != nil {
+			return fmt.Errorf("failed to read schema: %w", err)
+		}
+		if _, err := s.Record(result, store.Hash(data), now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
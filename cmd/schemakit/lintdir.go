@@ -0,0 +1,150 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// lintDir lints every schema file under dir, showing progress on stderr,
+// and exits with the most severe status across all files. A file that
+// cannot be parsed is reported as a parse-error result, and the other
+// files are still linted.
+func lintDir(l *linter.Linter, dir string) error {
+	files, err := findSchemaFiles(dir)
+	if err != nil {
+		return err
+	}
+	if len(files) == 0 {
+		return fmt.Errorf("no schemas found in %s", dir)
+	}
+
+	results, err := lintFiles(l, files)
+	if err != nil {
+		return err
+	}
+	l.CheckDuplicateIDs(results)
+	return reportResults(results)
+}
+
+// lintFiles lints schema files, showing progress on stderr. A file that
+// cannot be parsed gets a parse-error result rather than stopping the run.
+func lintFiles(l *linter.Linter, files []string) ([]*linter.Result, error) {
+	bar := newProgress(os.Stderr, len(files), !lintNoProgress)
+	defer bar.Finish()
+	results := make([]*linter.Result, 0, len(files))
+	for _, path := range files {
+		result, err := lintSchemaFile(l, path)
+		if errors.Is(err, linter.ErrParse) {
+			result, err = linter.ParseErrorResult(path, err), nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to lint schema: %w", err)
+		}
+		results = append(results, result)
+		bar.Step()
+	}
+	return results, nil
+}
+
+// lintSchemaFile lints a schema file, one definition at a time with
+// --low-memory, or only the subschema at --pointer.
+func lintSchemaFile(l *linter.Linter, path string) (*linter.Result, error) {
+	switch {
+	case lintPointer != "" && lintLowMemory:
+		return nil, fmt.Errorf("--pointer cannot be used with --low-memory")
+	case lintPointer != "":
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+		result, err := l.LintAt(data, lintPointer)
+		if err != nil {
+			return nil, err
+		}
+		result.SchemaPath = path
+		l.ApplyPathClass(result, path)
+		return result, nil
+	case lintLowMemory:
+		return l.LintFileLowMemory(path)
+	}
+	return l.LintFile(path)
+}
+
+// reportResults prints the results of several schemas in the lint output
+// format, records and announces them, and exits with the most severe
+// status across all of them.
+func reportResults(results []*linter.Result) error {
+	rollup, err := rollupResults(results)
+	if err != nil {
+		return err
+	}
+
+	switch lintOutput {
+	case "json":
+		var v any = results
+		if lintGroupBy == "owner" {
+			grouped := make([]ownerResult, len(results))
+			for i, result := range results {
+				grouped[i] = ownerResult{SchemaPath: result.SchemaPath, Owners: result.GroupByOwner()}
+			}
+			v = grouped
+		}
+		if lintRollup || lintRollupBaseline != "" {
+			v = rollupOutput{Results: v, Rollup: rollup}
+		}
+		data, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(data))
+	case "github":
+		for _, result := range results {
+			fmt.Print(result.GitHubAnnotations())
+		}
+	case "html":
+		if err := writeHTMLReport(os.Stdout, results, &rollup); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	default:
+		for i, result := range results {
+			if i > 0 {
+				fmt.Println()
+			}
+			fmt.Printf("%s:\n", result.SchemaPath)
+			if lintGroupBy == "owner" {
+				fmt.Print(result.StringByOwner())
+			} else {
+				fmt.Print(result.String())
+			}
+		}
+		fmt.Println()
+		fmt.Print(rollup.String())
+	}
+
+	summary := make([]linter.Result, len(results))
+	for i, result := range results {
+		summary[i] = *result
+	}
+	if err := record(summary...); err != nil {
+		return err
+	}
+	notifyResults(summary...)
+	checkSuppressions(summary...)
+	printRuleProfile(os.Stderr, results...)
+
+	warnings := false
+	for _, result := range results {
+		if result.HasErrors() {
+			os.Exit(1)
+		}
+		warnings = warnings || result.WarningCount() > 0
+	}
+	if warnings {
+		os.Exit(2)
+	}
+	return nil
+}
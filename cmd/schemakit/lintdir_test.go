@@ -0,0 +1,35 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+func TestLintFilesParseError(t *testing.T) {
+	dir := t.TempDir()
+	files := []string{filepath.Join(dir, "broken.json"), filepath.Join(dir, "pet.json")}
+	if err := os.WriteFile(files[0], []byte(`{"type": }`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(files[1], []byte(`{"type": "object", "properties": {"pet_name": {"type": "string"}}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	lintNoProgress = true
+	results, err := lintFiles(linter.NewWithDefaults(), files)
+	if err != nil {
+		t.Fatalf("Expected the run to continue past the broken file, got: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("Expected 2 results, got %d", len(results))
+	}
+	if issues := results[0].Issues; len(issues) != 1 || issues[0].Code != linter.CodeParseError || results[0].SchemaPath != files[0] {
+		t.Errorf("Expected a parse-error result for broken.json, got %+v", results[0])
+	}
+	if results[1].SchemaPath != files[1] || len(results[1].Issues) == 0 {
+		t.Errorf("Expected pet.json to be linted, got %+v", results[1])
+	}
+}
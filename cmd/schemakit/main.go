@@ -2,16 +2,29 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	"github.com/grokify/schemakit/linter"
+	"github.com/grokify/schemakit/linter/goplugin"
 )
 
 var version = "dev"
 
+func init() {
+	// Write the version set at link time to JSON results, or report the
+	// module version of the build
+	if version != "dev" {
+		linter.ToolVersion = version
+	} else {
+		version = linter.ToolVersion
+	}
+}
+
 func main() {
 	if err := rootCmd.Execute(); err != nil {
 		fmt.Fprintln(os.Stderr, err)
@@ -25,9 +38,29 @@ var rootCmd = &cobra.Command{
 	Long: `schemakit is a toolkit for working with JSON Schema in Go projects.
 
 Commands:
-  lint      - Check schemas for static type compatibility
-  generate  - Generate JSON Schema from Go struct types
-  doc       - Generate Markdown documentation from Go types
+  lint         - Check schemas for static type compatibility
+  crawl        - Lint every schema listed in a manifest
+  doctor       - Check schema files for encoding and structural problems
+  convert      - Convert a draft-07 schema to JSON Schema 2020-12
+  flatten      - Merge allOf inheritance into plain object schemas
+  fix          - Apply automatic fixes for lint findings
+  trim         - Strip annotations and unused definitions for runtime use
+  redact       - Anonymize a schema for sharing in bug reports
+  extract      - Extract JSON Schemas from an OpenAPI 3.0 document
+  validate     - Validate JSON documents against a schema
+  check-go     - Check that a Go struct type matches a schema
+  avro-compat  - Check that a schema can be represented in Avro
+  ddl          - Map a schema to Postgres tables
+  generate     - Generate JSON Schema from Go struct types
+  doc          - Generate Markdown documentation from Go types
+  docs         - Generate a documentation site from a schema
+  graph        - Visualize the definition/reference graph
+  history      - Show when lint issues appeared and disappeared
+  compare      - Compare schema versions deployed in different environments
+  test         - Run golden-file lint conformance tests
+  selftest     - Check the schema model against the JSON Schema Test Suite
+  serve        - Run lint as an HTTP service with Prometheus metrics
+  mcp          - Run a Model Context Protocol server for AI assistants
 
 Profiles (for lint):
   default  - Check for common issues (discriminators, large unions)
@@ -35,96 +68,239 @@ Profiles (for lint):
 }
 
 var lintCmd = &cobra.Command{
-	Use:   "lint <schema.json>",
+	Use:   "lint <schema.json|dir>",
 	Short: "Lint JSON Schema for static type compatibility",
 	Long: `Lint a JSON Schema file and report patterns that cause problems
 when generating code for statically-typed languages.
 
-Default profile checks:
-  - Unions without discriminator fields (error)
-  - Inconsistent discriminator field names (error)
-  - Missing const values in union variants (error)
-  - Large unions with many variants (warning)
-  - Deeply nested unions (warning)
-  - additionalProperties on union variants (warning)
+Given a directory, every .json file under it is linted. A progress bar
+is shown on stderr when it is a terminal; use --no-progress to hide it.
 
+Default profile checks:
+` + ruleHelp(linter.LintRules(linter.ProfileDefault), linter.ProfileDefault) + `
 Scale profile additionally checks:
-  - Composition keywords anyOf/oneOf/allOf (error)
-  - additionalProperties: true (error)
-  - Missing explicit type field (error)
-  - Mixed type arrays like ["string", "number"] (error)
-
+` + ruleHelp(linter.LintRules(linter.ProfileScale), linter.ProfileScale) + `
 Exit codes:
   0 - No issues found
   1 - Errors found (schema has problems)
-  2 - Warnings found but no errors`,
+  2 - Warnings found but no errors
+
+With --strictness relaxed or pedantic, the union size, nesting, and
+enum size thresholds are raised or lowered together; pedantic also
+enables the opt-in rules (prose-enum, nullable-optional,
+missing-unit-suffix, and --strict-unresolved).
+
+With --root, only the definitions reachable through $refs from the
+given entry schemas are linted; the others are listed as
+unreachable-from-roots info issues.
+
+With --category, only the rules in the given categories run (unions,
+naming, typing, documentation, compatibility), e.g., to adopt the linter
+one category at a time.
+
+With --compare, only issues not in the previous result are counted.
+
+For a directory, the text and HTML output end with a rollup of the
+issue counts by directory and by rule; --rollup adds it to the JSON
+output. With --rollup-baseline, a previous JSON result for the
+directory, the rollup shows the change in each count.
+
+With --redact, the JSON output uses the pseudonyms of schemakit redact
+for property and definition names and values, so that it can be shared
+with the redacted schema in a bug report.
+
+With --notify-webhook, a summary of the results (issue counts by schema
+and owner, and the first issues) is posted to the URL as a Slack
+message or, with --notify-format json, as JSON.
+
+Findings can be suppressed inline with an x-schemalint annotation
+({"ignore": ["large-enum"], "reason": "..."}), by ignore_id_prefixes,
+or by a --compare baseline. --suppression-report lists them, and
+--max-suppressions N fails the run when more than N are suppressed.
+
+With --profile-rules, the time spent in each rule and file is recorded
+in the JSON output, and the slowest rules and files are printed to
+stderr.
+
+With --pointer, only the subschema at a JSON pointer is linted, with
+$refs resolved against the whole file, to iterate on one definition of
+a large schema.
+
+With --low-memory, each file is memory-mapped and its top-level
+definitions are parsed and linted one at a time, with the definitions
+they reference, so that very large bundles fit in small containers.
+Checks that compare unrelated definitions are skipped in this mode.
+
+With --store, the results are recorded in a SQLite database; see
+schemakit history.`,
 	Args: cobra.ExactArgs(1),
 	RunE: runLint,
 }
 
+// ruleHelp lists the rules of a profile for command help, one per line
+// with its default severity.
+func ruleHelp(rules []linter.RuleInfo, profile linter.Profile) string {
+	var sb strings.Builder
+	for _, r := range rules {
+		if r.Profile == profile {
+			fmt.Fprintf(&sb, "  - %s (%s)\n", r.Code, r.Severity)
+		}
+	}
+	return sb.String()
+}
+
 var (
-	lintOutput       string
-	lintProfile      string
-	lintPropertyCase string
+	lintOutput           string
+	lintProfile          string
+	lintStrictness       string
+	lintPropertyCase     string
+	lintStrictUnresolved bool
+	lintRulePlugins      []string
+	lintRoots            []string
+	lintCategories       []string
+	lintMaxUnionVariants int
+	lintMaxUnionNesting  int
+	lintDiscriminators   []string
+	lintConfigPath       string
+	lintGroupBy          string
+	lintCompare          string
+	lintNoProgress       bool
+	lintRedact           bool
+	lintRollup           bool
+	lintRollupBaseline   string
+	lintProfileRules     bool
+	lintLowMemory        bool
+	lintPointer          string
 )
 
 func init() {
 	rootCmd.AddCommand(lintCmd)
 	rootCmd.AddCommand(versionCmd)
 
-	lintCmd.Flags().StringVarP(&lintOutput, "output", "o", "text", "Output format: text, json, github")
-	lintCmd.Flags().StringVarP(&lintProfile, "profile", "p", "default", "Linting profile: default, scale")
-	lintCmd.Flags().StringVar(&lintPropertyCase, "property-case", "camelCase", "Property case convention: none, camelCase, snake_case, kebab-case, PascalCase")
+	lintCmd.Flags().StringVarP(&lintOutput, "output", "o", "text", "Output format: text, json, github, html")
+	lintCmd.Flags().StringVar(&lintGroupBy, "group-by", "", "Group text and JSON output: owner")
+	lintCmd.Flags().StringVar(&lintCompare, "compare", "", "Compare against a previous JSON result; exit status reflects only new issues")
+	lintCmd.Flags().BoolVar(&lintNoProgress, "no-progress", false, "Do not show a progress bar when linting a directory")
+	lintCmd.Flags().BoolVar(&lintRollup, "rollup", false, "Include the rollup by directory and rule in JSON output for a directory")
+	lintCmd.Flags().StringVar(&lintRollupBaseline, "rollup-baseline", "", "Previous JSON result for a directory; the rollup shows the change in issue counts")
+	lintCmd.Flags().BoolVar(&lintProfileRules, "profile-rules", false, "Print the slowest rules and files to stderr, and add timings to JSON output")
+	lintCmd.Flags().StringVar(&lintPointer, "pointer", "", "Lint only the subschema at this JSON pointer (e.g., '#/$defs/Order'), resolving $refs against the whole file")
+	lintCmd.Flags().BoolVar(&lintLowMemory, "low-memory", false, "Memory-map schema files and lint their definitions one at a time, for very large bundles")
+	lintCmd.Flags().BoolVar(&lintRedact, "redact", false, "Redact names and text in JSON output, as schemakit redact does for the schema")
+	addLintConfigFlags(lintCmd)
+}
+
+// addLintConfigFlags registers the flags read by loadLintConfig.
+func addLintConfigFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&lintProfile, "profile", "p", "default", "Linting profile: default, scale")
+	cmd.Flags().StringVar(&lintStrictness, "strictness", "standard", "Thresholds and opt-in rules: relaxed, standard, pedantic")
+	cmd.Flags().IntVar(&lintMaxUnionVariants, "max-union-variants", 10, "Threshold for large-union warnings")
+	cmd.Flags().IntVar(&lintMaxUnionNesting, "max-union-nesting-depth", 2, "Threshold for nested-union warnings")
+	cmd.Flags().StringSliceVar(&lintDiscriminators, "discriminator-fields", nil, "Field names to look for as discriminators (default: component_type,type,kind)")
+	cmd.Flags().StringVar(&lintPropertyCase, "property-case", "camelCase", "Property case convention: none, camelCase, snake_case, kebab-case, PascalCase, auto")
+	cmd.Flags().BoolVar(&lintStrictUnresolved, "strict-unresolved", false, "Report unions skipped due to unresolved $refs as errors")
+	cmd.Flags().StringVarP(&lintConfigPath, "config", "c", "", "JSON config file; explicitly set flags take precedence")
+	cmd.Flags().StringSliceVar(&lintRulePlugins, "rule-plugin", nil, "Load additional rules from a Go plugin (.so); repeatable")
+	cmd.Flags().StringArrayVar(&lintRoots, "root", nil, "Lint only definitions reachable from this entry schema (e.g., '#/$defs/PublicAPI'); repeatable")
+	cmd.Flags().StringSliceVar(&lintCategories, "category", nil, "Run only the rules in these categories: unions, naming, typing, documentation, compatibility")
 }
 
 func runLint(cmd *cobra.Command, args []string) error {
 	schemaPath := args[0]
 
-	config := linter.DefaultConfig()
-	switch lintProfile {
-	case "scale":
-		config.Profile = linter.ProfileScale
-	case "navigable":
-		config.Profile = linter.ProfileNavigable
-	case "default":
-		config.Profile = linter.ProfileDefault
+	switch lintGroupBy {
+	case "", "owner":
 	default:
-		return fmt.Errorf("unknown profile: %s (use 'default', 'scale', or 'navigable')", lintProfile)
+		return fmt.Errorf("unknown --group-by %q (valid: owner)", lintGroupBy)
+	}
+	if lintGroupBy != "" && lintCompare != "" {
+		return fmt.Errorf("--group-by cannot be used with --compare")
+	}
+	if lintStore != "" && lintCompare != "" {
+		return fmt.Errorf("--store cannot be used with --compare")
+	}
+	if lintRedact && (lintOutput != "json" || lintGroupBy != "" || lintCompare != "") {
+		return fmt.Errorf("--redact requires -o json, without --group-by or --compare")
+	}
+	if err := checkNotifyFlags(); err != nil {
+		return err
 	}
 
-	switch lintPropertyCase {
-	case "none":
-		config.PropertyCase = linter.CaseNone
-	case "camelCase":
-		config.PropertyCase = linter.CaseCamel
-	case "snake_case":
-		config.PropertyCase = linter.CaseSnake
-	case "kebab-case":
-		config.PropertyCase = linter.CaseKebab
-	case "PascalCase":
-		config.PropertyCase = linter.CasePascal
-	default:
-		return fmt.Errorf("unknown property case: %s", lintPropertyCase)
+	config, err := loadLintConfig(cmd)
+	if err != nil {
+		return err
 	}
+	config.ProfileRules = lintProfileRules
 
 	l := linter.New(config)
-	result, err := l.LintFile(schemaPath)
+	if info, err := os.Stat(schemaPath); err == nil && info.IsDir() {
+		if lintCompare != "" {
+			return fmt.Errorf("--compare requires a schema file, not a directory")
+		}
+		if lintRedact {
+			return fmt.Errorf("--redact requires a schema file, not a directory")
+		}
+		if lintPointer != "" {
+			return fmt.Errorf("--pointer requires a schema file, not a directory")
+		}
+		return lintDir(l, schemaPath)
+	}
+	if lintRollup || lintRollupBaseline != "" {
+		return fmt.Errorf("--rollup and --rollup-baseline require a directory")
+	}
+
+	result, err := lintSchemaFile(l, schemaPath)
 	if err != nil {
 		return fmt.Errorf("failed to lint schema: %w", err)
 	}
 
-	switch lintOutput {
-	case "json":
-		data, err := result.JSON()
+	if lintCompare != "" {
+		previous, err := linter.LoadResult(lintCompare)
+		if err != nil {
+			return err
+		}
+		return printComparison(linter.Compare(previous, *result))
+	}
+
+	switch {
+	case lintOutput == "json" && lintGroupBy == "owner":
+		data, err := json.MarshalIndent(ownerResult{
+			SchemaPath: result.SchemaPath,
+			Owners:     result.GroupByOwner(),
+		}, "", "  ")
 		if err != nil {
 			return fmt.Errorf("failed to serialize result: %w", err)
 		}
 		fmt.Println(string(data))
-	case "github":
+	case lintOutput == "json":
+		output := result
+		if lintRedact {
+			if output, err = redactResult(*result, schemaPath, config); err != nil {
+				return err
+			}
+		}
+		data, err := output.JSON()
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(data))
+	case lintOutput == "github":
 		fmt.Print(result.GitHubAnnotations())
+	case lintOutput == "html":
+		if err := writeHTMLReport(os.Stdout, []*linter.Result{result}, nil); err != nil {
+			return fmt.Errorf("failed to write report: %w", err)
+		}
+	case lintGroupBy == "owner":
+		fmt.Print(result.StringByOwner())
 	default:
 		fmt.Print(result.String())
 	}
+	if err := record(*result); err != nil {
+		return err
+	}
+	notifyResults(*result)
+	checkSuppressions(*result)
+	printRuleProfile(os.Stderr, result)
 
 	if result.HasErrors() {
 		os.Exit(1)
@@ -136,6 +312,197 @@ func runLint(cmd *cobra.Command, args []string) error {
 	return nil
 }
 
+// printComparison prints the comparison in the lint output format and exits
+// based on new issues only.
+func printComparison(c linter.Comparison) error {
+	added := c.NewResult()
+
+	switch lintOutput {
+	case "json":
+		data, err := c.JSON()
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(data))
+	case "github":
+		fmt.Print(added.GitHubAnnotations())
+	default:
+		fmt.Print(c.String())
+	}
+	notifyResults(added)
+	checkSuppressions(added)
+
+	if added.HasErrors() {
+		os.Exit(1)
+	}
+	if added.WarningCount() > 0 {
+		os.Exit(2)
+	}
+	return nil
+}
+
+// ownerResult is the JSON output of lint --group-by owner.
+type ownerResult struct {
+	SchemaPath string              `json:"schema_path"`
+	Owners     []linter.OwnerGroup `json:"owners"`
+}
+
+// buildConfig returns the default linter configuration with the named
+// profile and property case convention applied.
+func buildConfig(profile, propertyCase string) (linter.Config, error) {
+	config := linter.DefaultConfig()
+	if err := setProfile(&config, profile); err != nil {
+		return config, err
+	}
+	if err := setPropertyCase(&config, propertyCase); err != nil {
+		return config, err
+	}
+	return config, nil
+}
+
+// loadLintConfig builds the lint configuration from the --config file, if
+// any, with explicitly set flags taking precedence over the file, and adds
+// rules from any --rule-plugin files.
+func loadLintConfig(cmd *cobra.Command) (linter.Config, error) {
+	config, err := loadBaseConfig(cmd)
+	if err != nil {
+		return config, err
+	}
+
+	for _, pluginPath := range lintRulePlugins {
+		rules, err := goplugin.Load(pluginPath)
+		if err != nil {
+			return config, err
+		}
+		config.Rules = append(config.Rules, rules...)
+	}
+	return config, nil
+}
+
+func loadBaseConfig(cmd *cobra.Command) (linter.Config, error) {
+	if lintConfigPath == "" {
+		config, err := buildConfig(lintProfile, lintPropertyCase)
+		if err == nil {
+			err = config.SetStrictness(linter.Strictness(lintStrictness))
+		}
+		if lintStrictUnresolved {
+			config.StrictUnresolved = true
+		}
+		if err == nil {
+			err = setThresholds(cmd, &config)
+		}
+		config.Roots = lintRoots
+		config.Categories = categories(lintCategories)
+		if err == nil {
+			err = config.Validate()
+		}
+		return config, err
+	}
+
+	config, err := linter.LoadConfig(lintConfigPath)
+	if err != nil {
+		return config, err
+	}
+	flags := cmd.Flags()
+	if flags.Changed("profile") {
+		if err := setProfile(&config, lintProfile); err != nil {
+			return config, err
+		}
+	}
+	if flags.Changed("property-case") {
+		if err := setPropertyCase(&config, lintPropertyCase); err != nil {
+			return config, err
+		}
+	}
+	if flags.Changed("strictness") {
+		if err := config.SetStrictness(linter.Strictness(lintStrictness)); err != nil {
+			return config, err
+		}
+	}
+	if flags.Changed("strict-unresolved") {
+		config.StrictUnresolved = lintStrictUnresolved
+	}
+	if err := setThresholds(cmd, &config); err != nil {
+		return config, err
+	}
+	if flags.Changed("root") {
+		config.Roots = lintRoots
+	}
+	if flags.Changed("category") {
+		config.Categories = categories(lintCategories)
+	}
+	return config, config.Validate()
+}
+
+// setThresholds applies the union threshold and discriminator field flags
+// that are set explicitly, over the strictness preset and config file.
+func setThresholds(cmd *cobra.Command, config *linter.Config) error {
+	flags := cmd.Flags()
+	if flags.Changed("max-union-variants") {
+		if lintMaxUnionVariants < 1 {
+			return fmt.Errorf("--max-union-variants must be at least 1")
+		}
+		config.MaxUnionVariants = lintMaxUnionVariants
+	}
+	if flags.Changed("max-union-nesting-depth") {
+		if lintMaxUnionNesting < 1 {
+			return fmt.Errorf("--max-union-nesting-depth must be at least 1")
+		}
+		config.MaxUnionNestingDepth = lintMaxUnionNesting
+	}
+	if flags.Changed("discriminator-fields") {
+		if len(lintDiscriminators) == 0 {
+			return fmt.Errorf("--discriminator-fields requires at least one field name")
+		}
+		config.DiscriminatorFields = lintDiscriminators
+	}
+	return nil
+}
+
+// categories converts --category values to rule categories; unknown
+// categories are reported by Config.Validate.
+func categories(names []string) []linter.Category {
+	out := make([]linter.Category, 0, len(names))
+	for _, name := range names {
+		out = append(out, linter.Category(name))
+	}
+	return out
+}
+
+func setProfile(config *linter.Config, profile string) error {
+	switch profile {
+	case "scale":
+		config.Profile = linter.ProfileScale
+	case "navigable":
+		config.Profile = linter.ProfileNavigable
+	case "default":
+		config.Profile = linter.ProfileDefault
+	default:
+		return fmt.Errorf("unknown profile: %s (use 'default', 'scale', or 'navigable')", profile)
+	}
+	return nil
+}
+
+func setPropertyCase(config *linter.Config, propertyCase string) error {
+	switch propertyCase {
+	case "none":
+		config.PropertyCase = linter.CaseNone
+	case "camelCase":
+		config.PropertyCase = linter.CaseCamel
+	case "snake_case":
+		config.PropertyCase = linter.CaseSnake
+	case "kebab-case":
+		config.PropertyCase = linter.CaseKebab
+	case "PascalCase":
+		config.PropertyCase = linter.CasePascal
+	case "auto":
+		config.PropertyCase = linter.CaseAuto
+	default:
+		return fmt.Errorf("unknown property case: %s", propertyCase)
+	}
+	return nil
+}
+
 var versionCmd = &cobra.Command{
 	Use:   "version",
 	Short: "Print version information",
@@ -0,0 +1,303 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+func init() {
+	rootCmd.AddCommand(mcpCmd)
+}
+
+var mcpCmd = &cobra.Command{
+	Use:   "mcp",
+	Short: "Run a Model Context Protocol server for AI coding assistants",
+	Long: `Run a Model Context Protocol (MCP) server over stdio.
+
+AI assistants editing schemas can call these tools:
+  lint           - Lint a JSON Schema and return the JSON result
+  explain        - Return documentation for a rule code (or list all rules)
+  suggest_fixes  - Return fix suggestions for issues at or under a path
+
+Example MCP client configuration:
+  {"mcpServers": {"schemakit": {"command": "schemakit", "args": ["mcp"]}}}`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return serveMCP(cmd.InOrStdin(), cmd.OutOrStdout())
+	},
+}
+
+// mcpProtocolVersion is the protocol version the server replies with when
+// the client asks for one it does not support.
+const mcpProtocolVersion = "2024-11-05"
+
+// mcpProtocolVersions are the protocol versions the server supports.
+var mcpProtocolVersions = []string{mcpProtocolVersion, "2025-03-26"}
+
+// JSON-RPC 2.0 error codes.
+const (
+	rpcParseError     = -32700
+	rpcMethodNotFound = -32601
+	rpcInvalidParams  = -32602
+)
+
+type rpcRequest struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type rpcResponse struct {
+	JSONRPC string          `json:"jsonrpc"`
+	ID      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+type mcpTool struct {
+	Name        string         `json:"name"`
+	Description string         `json:"description"`
+	InputSchema map[string]any `json:"inputSchema"`
+}
+
+type mcpContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+type mcpToolResult struct {
+	Content []mcpContent `json:"content"`
+	IsError bool         `json:"isError,omitempty"`
+}
+
+// mcpLintProperties are the input properties shared by tools that lint a schema.
+var mcpLintProperties = map[string]any{
+	"schema":        map[string]any{"type": "string", "description": "JSON Schema document content"},
+	"profile":       map[string]any{"type": "string", "enum": []string{"default", "scale", "navigable"}},
+	"property_case": map[string]any{"type": "string", "enum": []string{"none", "camelCase", "snake_case", "kebab-case", "PascalCase", "auto"}},
+}
+
+var mcpTools = []mcpTool{
+	{
+		Name:        "lint",
+		Description: "Lint a JSON Schema for static type compatibility and return the JSON result.",
+		InputSchema: map[string]any{
+			"type":       "object",
+			"properties": mcpLintProperties,
+			"required":   []string{"schema"},
+		},
+	},
+	{
+		Name:        "explain",
+		Description: "Explain a lint rule by issue code (e.g., union-no-discriminator). Lists all rules if no code is given.",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"code": map[string]any{"type": "string", "description": "Issue code"},
+			},
+		},
+	},
+	{
+		Name:        "suggest_fixes",
+		Description: "Lint a JSON Schema and return fix suggestions for issues at or under a path (e.g., $/$defs/Pet).",
+		InputSchema: map[string]any{
+			"type": "object",
+			"properties": mergeProps(mcpLintProperties, map[string]any{
+				"path": map[string]any{"type": "string", "description": "Issue path prefix; all issues if empty"},
+			}),
+			"required": []string{"schema"},
+		},
+	},
+}
+
+func mergeProps(a, b map[string]any) map[string]any {
+	out := make(map[string]any, len(a)+len(b))
+	for k, v := range a {
+		out[k] = v
+	}
+	for k, v := range b {
+		out[k] = v
+	}
+	return out
+}
+
+// serveMCP reads newline-delimited JSON-RPC messages from r and writes
+// responses to w until r is closed.
+func serveMCP(r io.Reader, w io.Writer) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 64<<20)
+	enc := json.NewEncoder(w)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+
+		var req rpcRequest
+		if err := json.Unmarshal([]byte(line), &req); err != nil {
+			if err := enc.Encode(rpcResponse{JSONRPC: "2.0", ID: json.RawMessage("null"),
+				Error: &rpcError{Code: rpcParseError, Message: err.Error()}}); err != nil {
+				return err
+			}
+			continue
+		}
+
+		// Notifications have no ID and receive no response.
+		if len(req.ID) == 0 {
+			continue
+		}
+
+		result, rpcErr := handleMCP(req)
+		if err := enc.Encode(rpcResponse{JSONRPC: "2.0", ID: req.ID, Result: result, Error: rpcErr}); err != nil {
+			return err
+		}
+	}
+
+	return scanner.Err()
+}
+
+func handleMCP(req rpcRequest) (any, *rpcError) {
+	switch req.Method {
+	case "initialize":
+		var params struct {
+			ProtocolVersion string `json:"protocolVersion"`
+		}
+		_ = json.Unmarshal(req.Params, &params)
+		protocolVersion := mcpProtocolVersion
+		if slices.Contains(mcpProtocolVersions, params.ProtocolVersion) {
+			protocolVersion = params.ProtocolVersion
+		}
+		return map[string]any{
+			"protocolVersion": protocolVersion,
+			"capabilities":    map[string]any{"tools": map[string]any{}},
+			"serverInfo":      map[string]any{"name": "schemakit", "version": version},
+		}, nil
+	case "ping":
+		return map[string]any{}, nil
+	case "tools/list":
+		return map[string]any{"tools": mcpTools}, nil
+	case "tools/call":
+		var params struct {
+			Name      string          `json:"name"`
+			Arguments json.RawMessage `json:"arguments"`
+		}
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+		return callMCPTool(params.Name, params.Arguments)
+	default:
+		return nil, &rpcError{Code: rpcMethodNotFound, Message: "method not found: " + req.Method}
+	}
+}
+
+type mcpToolArgs struct {
+	Schema       string `json:"schema"`
+	Profile      string `json:"profile"`
+	PropertyCase string `json:"property_case"`
+	Code         string `json:"code"`
+	Path         string `json:"path"`
+}
+
+func callMCPTool(name string, rawArgs json.RawMessage) (any, *rpcError) {
+	args := mcpToolArgs{Profile: "default", PropertyCase: "camelCase"}
+	if len(rawArgs) > 0 {
+		if err := json.Unmarshal(rawArgs, &args); err != nil {
+			return nil, &rpcError{Code: rpcInvalidParams, Message: err.Error()}
+		}
+	}
+
+	var text string
+	var err error
+	switch name {
+	case "lint":
+		text, err = mcpLint(args)
+	case "explain":
+		text, err = mcpExplain(args.Code)
+	case "suggest_fixes":
+		text, err = mcpSuggestFixes(args)
+	default:
+		return nil, &rpcError{Code: rpcInvalidParams, Message: "unknown tool: " + name}
+	}
+
+	if err != nil {
+		return mcpToolResult{Content: []mcpContent{{Type: "text", Text: err.Error()}}, IsError: true}, nil
+	}
+	return mcpToolResult{Content: []mcpContent{{Type: "text", Text: text}}}, nil
+}
+
+func mcpLintSchema(args mcpToolArgs) (*linter.Result, error) {
+	if args.Schema == "" {
+		return nil, fmt.Errorf("schema is required")
+	}
+	config, err := buildConfig(args.Profile, args.PropertyCase)
+	if err != nil {
+		return nil, err
+	}
+	return linter.New(config).Lint([]byte(args.Schema))
+}
+
+func mcpLint(args mcpToolArgs) (string, error) {
+	result, err := mcpLintSchema(args)
+	if err != nil {
+		return "", err
+	}
+	data, err := result.JSON()
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+func mcpExplain(code string) (string, error) {
+	if code == "" {
+		var sb strings.Builder
+		for _, r := range linter.Rules() {
+			fmt.Fprintf(&sb, "%s (%s, %s profile, %s): %s\n", r.Code, r.Severity, r.Profile, r.Category, r.Description)
+		}
+		return sb.String(), nil
+	}
+
+	r, ok := linter.LookupRule(linter.IssueCode(code))
+	if !ok {
+		return "", fmt.Errorf("unknown rule code: %s", code)
+	}
+	return fmt.Sprintf("%s\nSeverity: %s\nProfile: %s\nCategory: %s\n\n%s\n", r.Code, r.Severity, r.Profile, r.Category, r.Description), nil
+}
+
+func mcpSuggestFixes(args mcpToolArgs) (string, error) {
+	result, err := mcpLintSchema(args)
+	if err != nil {
+		return "", err
+	}
+
+	path := linter.ParsePath(args.Path)
+	var sb strings.Builder
+	for _, issue := range result.Issues {
+		if args.Path != "" && !issue.Path.HasPrefix(path) {
+			continue
+		}
+		fmt.Fprintf(&sb, "%s [%s] %s\n", issue.Path, issue.Code, issue.Message)
+		if issue.Suggestion != "" {
+			fmt.Fprintf(&sb, "  fix: %s\n", issue.Suggestion)
+		}
+	}
+	if sb.Len() == 0 {
+		return "No issues found.\n", nil
+	}
+	return sb.String(), nil
+}
@@ -0,0 +1,175 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+// mcpRequest builds a request for method with the given JSON params.
+func mcpRequest(method, params string) rpcRequest {
+	req := rpcRequest{JSONRPC: "2.0", ID: json.RawMessage("1"), Method: method}
+	if params != "" {
+		req.Params = json.RawMessage(params)
+	}
+	return req
+}
+
+func TestHandleMCP(t *testing.T) {
+	tests := []struct {
+		name     string
+		req      rpcRequest
+		wantCode int    // JSON-RPC error code, or 0 for a result
+		want     string // substring of the JSON result
+	}{
+		{
+			name: "initialize",
+			req:  mcpRequest("initialize", `{"protocolVersion": "2025-03-26"}`),
+			want: `"protocolVersion":"2025-03-26"`,
+		},
+		{
+			name: "initialize default version",
+			req:  mcpRequest("initialize", ""),
+			want: `"protocolVersion":"` + mcpProtocolVersion + `"`,
+		},
+		{
+			name: "initialize unsupported version",
+			req:  mcpRequest("initialize", `{"protocolVersion": "2099-01-01"}`),
+			want: `"protocolVersion":"` + mcpProtocolVersion + `"`,
+		},
+		{
+			name: "initialize capabilities",
+			req:  mcpRequest("initialize", `{}`),
+			want: `"capabilities":{"tools":{}}`,
+		},
+		{
+			name: "ping",
+			req:  mcpRequest("ping", ""),
+			want: `{}`,
+		},
+		{
+			name: "tools/list",
+			req:  mcpRequest("tools/list", ""),
+			want: `"name":"suggest_fixes"`,
+		},
+		{
+			name: "tools/call lint",
+			req:  mcpRequest("tools/call", `{"name": "lint", "arguments": {"schema": "{\"properties\": {\"order_id\": {\"type\": \"integer\"}}}"}}`),
+			want: `invalid-property-case`,
+		},
+		{
+			name: "tools/call explain",
+			req:  mcpRequest("tools/call", `{"name": "explain", "arguments": {"code": "union-no-discriminator"}}`),
+			want: `Severity: error`,
+		},
+		{
+			name: "tools/call suggest_fixes",
+			req:  mcpRequest("tools/call", `{"name": "suggest_fixes", "arguments": {"schema": "{\"properties\": {\"order_id\": {\"type\": \"integer\"}}}", "path": "$/properties"}}`),
+			want: `fix: Rename property to 'orderId'`,
+		},
+		{
+			name: "tool error",
+			req:  mcpRequest("tools/call", `{"name": "lint", "arguments": {}}`),
+			want: `"isError":true`,
+		},
+		{
+			name:     "unknown tool",
+			req:      mcpRequest("tools/call", `{"name": "format"}`),
+			wantCode: rpcInvalidParams,
+		},
+		{
+			name:     "malformed params",
+			req:      mcpRequest("tools/call", `["lint"]`),
+			wantCode: rpcInvalidParams,
+		},
+		{
+			name:     "malformed arguments",
+			req:      mcpRequest("tools/call", `{"name": "lint", "arguments": "schema"}`),
+			wantCode: rpcInvalidParams,
+		},
+		{
+			name:     "unknown method",
+			req:      mcpRequest("resources/list", ""),
+			wantCode: rpcMethodNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, rpcErr := handleMCP(tt.req)
+			if tt.wantCode != 0 {
+				if rpcErr == nil || rpcErr.Code != tt.wantCode {
+					t.Fatalf("error = %+v, want code %d", rpcErr, tt.wantCode)
+				}
+				if result != nil {
+					t.Errorf("result = %v, want none with an error", result)
+				}
+				return
+			}
+			if rpcErr != nil {
+				t.Fatalf("unexpected error: %+v", rpcErr)
+			}
+			data, err := json.Marshal(result)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !strings.Contains(string(data), tt.want) {
+				t.Errorf("result = %s, want it to contain %s", data, tt.want)
+			}
+		})
+	}
+}
+
+func TestServeMCP(t *testing.T) {
+	in := strings.Join([]string{
+		`{"jsonrpc": "2.0", "id": 1, "method": "ping"}`,
+		``,
+		`{"jsonrpc": "2.0", "method": "notifications/initialized"}`,
+		`{"jsonrpc": "2.0", "id": "a", "method": "tools/list"`,
+		`{"jsonrpc": "2.0", "id": "b", "method": "unknown"}`,
+	}, "\n")
+
+	var out bytes.Buffer
+	if err := serveMCP(strings.NewReader(in), &out); err != nil {
+		t.Fatal(err)
+	}
+
+	var responses []rpcResponse
+	dec := json.NewDecoder(&out)
+	for dec.More() {
+		var resp rpcResponse
+		if err := dec.Decode(&resp); err != nil {
+			t.Fatal(err)
+		}
+		responses = append(responses, resp)
+	}
+
+	// The blank line and the notification receive no response.
+	if len(responses) != 3 {
+		t.Fatalf("got %d responses, want 3: %+v", len(responses), responses)
+	}
+	if string(responses[0].ID) != "1" || responses[0].Error != nil {
+		t.Errorf("ping response = %+v", responses[0])
+	}
+	if string(responses[1].ID) != "null" || responses[1].Error == nil || responses[1].Error.Code != rpcParseError {
+		t.Errorf("malformed request response = %+v, want a parse error with a null id", responses[1])
+	}
+	if string(responses[2].ID) != `"b"` || responses[2].Error == nil || responses[2].Error.Code != rpcMethodNotFound {
+		t.Errorf("unknown method response = %+v, want method not found", responses[2])
+	}
+}
+
+func TestMCPSuggestFixesPath(t *testing.T) {
+	schema := `{"properties": {"order_id": {"type": "integer"}, "order_id_v2": {"type": "integer"}}}`
+	out, err := mcpSuggestFixes(mcpToolArgs{Schema: schema, Profile: "default", PropertyCase: "camelCase", Path: "$/properties/order_id"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out, "$/properties/order_id [") {
+		t.Errorf("output = %q, want the issue at the path", out)
+	}
+	if strings.Contains(out, "order_id_v2") {
+		t.Errorf("output = %q, want no issues of a sibling sharing the path prefix", out)
+	}
+}
@@ -0,0 +1,210 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// Transports label the series of each serve mode API.
+const (
+	transportHTTP = "http"
+	transportGRPC = "grpc"
+)
+
+// transports lists the transports in the order their series are rendered.
+var transports = []string{transportHTTP, transportGRPC}
+
+// latencyBuckets are the upper bounds (in seconds) of the lint latency histogram.
+var latencyBuckets = []float64{0.001, 0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5}
+
+// labelEscaper escapes label values in the text exposition format, which
+// only escapes backslashes, double quotes, and line feeds.
+var labelEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// requestKey identifies a request counter series. The status is the HTTP
+// status code or the gRPC status code name.
+type requestKey struct {
+	transport string
+	status    string
+}
+
+// outcomeKey identifies a result counter series.
+type outcomeKey struct {
+	transport string
+	outcome   string
+}
+
+// issueKey identifies an issue counter series.
+type issueKey struct {
+	transport string
+	code      linter.IssueCode
+	severity  linter.Severity
+}
+
+// latency is the lint latency histogram of a transport.
+type latency struct {
+	bucketCounts []uint64
+	sum          float64
+	count        uint64
+}
+
+// serveMetrics collects serve mode metrics of the HTTP and gRPC APIs and
+// renders them in the Prometheus text exposition format.
+type serveMetrics struct {
+	mu            sync.Mutex
+	requests      map[requestKey]uint64
+	issues        map[issueKey]uint64
+	latencies     map[string]*latency
+	schemaResults map[outcomeKey]uint64
+}
+
+func newServeMetrics() *serveMetrics {
+	m := &serveMetrics{
+		requests:      make(map[requestKey]uint64),
+		issues:        make(map[issueKey]uint64),
+		latencies:     make(map[string]*latency),
+		schemaResults: make(map[outcomeKey]uint64),
+	}
+	for _, transport := range transports {
+		m.latencies[transport] = &latency{bucketCounts: make([]uint64, len(latencyBuckets))}
+	}
+	return m
+}
+
+// observe records an HTTP lint request with its status, result, and latency.
+func (m *serveMetrics) observe(status int, result *linter.Result, elapsed time.Duration) {
+	m.observeRequest(transportHTTP, strconv.Itoa(status), elapsed)
+	if result != nil {
+		m.observeResult(transportHTTP, result)
+	}
+}
+
+// observeRequest records a lint request with its status and latency.
+func (m *serveMetrics) observeRequest(transport, status string, elapsed time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests[requestKey{transport: transport, status: status}]++
+
+	h := m.latencies[transport]
+	seconds := elapsed.Seconds()
+	h.sum += seconds
+	h.count++
+	for i, bound := range latencyBuckets {
+		if seconds <= bound {
+			h.bucketCounts[i]++
+		}
+	}
+}
+
+// observeResult records the outcome and issues of a linted schema.
+func (m *serveMetrics) observeResult(transport string, result *linter.Result) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, issue := range result.Issues {
+		m.issues[issueKey{transport: transport, code: issue.Code, severity: issue.Severity}]++
+	}
+	switch {
+	case result.HasErrors():
+		m.schemaResults[outcomeKey{transport, "error"}]++
+	case result.WarningCount() > 0:
+		m.schemaResults[outcomeKey{transport, "warning"}]++
+	default:
+		m.schemaResults[outcomeKey{transport, "pass"}]++
+	}
+}
+
+// streamInterceptor records the status code and latency of each streaming
+// gRPC call, which are the Lint and LintProject RPCs; the service records
+// the results.
+func (m *serveMetrics) streamInterceptor(srv any, ss grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	start := time.Now()
+	err := handler(srv, ss)
+	m.observeRequest(transportGRPC, status.Code(err).String(), time.Since(start))
+	return err
+}
+
+// handler serves the metrics in the Prometheus text exposition format.
+func (m *serveMetrics) handler(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	_, _ = w.Write([]byte(m.render()))
+}
+
+func (m *serveMetrics) render() string {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var sb strings.Builder
+
+	sb.WriteString("# HELP schemakit_lint_requests_total Total lint requests by transport and status (HTTP status code or gRPC code).\n")
+	sb.WriteString("# TYPE schemakit_lint_requests_total counter\n")
+	requests := make([]requestKey, 0, len(m.requests))
+	for k := range m.requests {
+		requests = append(requests, k)
+	}
+	sort.Slice(requests, func(i, j int) bool {
+		if requests[i].transport != requests[j].transport {
+			return slices.Index(transports, requests[i].transport) < slices.Index(transports, requests[j].transport)
+		}
+		return requests[i].status < requests[j].status
+	})
+	for _, k := range requests {
+		fmt.Fprintf(&sb, "schemakit_lint_requests_total{transport=%q,status=%q} %d\n", k.transport, k.status, m.requests[k])
+	}
+
+	sb.WriteString("# HELP schemakit_lint_results_total Linted schemas by transport and outcome (pass, warning, error).\n")
+	sb.WriteString("# TYPE schemakit_lint_results_total counter\n")
+	for _, transport := range transports {
+		for _, outcome := range []string{"pass", "warning", "error"} {
+			fmt.Fprintf(&sb, "schemakit_lint_results_total{transport=%q,outcome=%q} %d\n",
+				transport, outcome, m.schemaResults[outcomeKey{transport, outcome}])
+		}
+	}
+
+	sb.WriteString("# HELP schemakit_lint_issues_total Total issues reported by transport, code, and severity.\n")
+	sb.WriteString("# TYPE schemakit_lint_issues_total counter\n")
+	keys := make([]issueKey, 0, len(m.issues))
+	for k := range m.issues {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].transport != keys[j].transport {
+			return slices.Index(transports, keys[i].transport) < slices.Index(transports, keys[j].transport)
+		}
+		if keys[i].code != keys[j].code {
+			return keys[i].code < keys[j].code
+		}
+		return keys[i].severity < keys[j].severity
+	})
+	for _, k := range keys {
+		fmt.Fprintf(&sb, "schemakit_lint_issues_total{transport=%q,code=\"%s\",severity=\"%s\"} %d\n",
+			k.transport, labelEscaper.Replace(string(k.code)), labelEscaper.Replace(string(k.severity)), m.issues[k])
+	}
+
+	sb.WriteString("# HELP schemakit_lint_duration_seconds Lint request latency in seconds by transport.\n")
+	sb.WriteString("# TYPE schemakit_lint_duration_seconds histogram\n")
+	for _, transport := range transports {
+		h := m.latencies[transport]
+		for i, bound := range latencyBuckets {
+			fmt.Fprintf(&sb, "schemakit_lint_duration_seconds_bucket{transport=%q,le=%q} %d\n",
+				transport, strconv.FormatFloat(bound, 'g', -1, 64), h.bucketCounts[i])
+		}
+		fmt.Fprintf(&sb, "schemakit_lint_duration_seconds_bucket{transport=%q,le=\"+Inf\"} %d\n", transport, h.count)
+		fmt.Fprintf(&sb, "schemakit_lint_duration_seconds_sum{transport=%q} %s\n", transport, strconv.FormatFloat(h.sum, 'g', -1, 64))
+		fmt.Fprintf(&sb, "schemakit_lint_duration_seconds_count{transport=%q} %d\n", transport, h.count)
+	}
+
+	return sb.String()
+}
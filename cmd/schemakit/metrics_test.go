@@ -0,0 +1,166 @@
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+func TestServeMetricsRender(t *testing.T) {
+	tests := []struct {
+		name    string
+		observe func(m *serveMetrics)
+		want    []string
+	}{
+		{
+			name: "no requests",
+			want: []string{
+				"# HELP schemakit_lint_requests_total Total lint requests by transport and status (HTTP status code or gRPC code).",
+				"# TYPE schemakit_lint_requests_total counter",
+				"# HELP schemakit_lint_results_total Linted schemas by transport and outcome (pass, warning, error).",
+				"# TYPE schemakit_lint_results_total counter",
+				`schemakit_lint_results_total{transport="http",outcome="pass"} 0`,
+				`schemakit_lint_results_total{transport="http",outcome="warning"} 0`,
+				`schemakit_lint_results_total{transport="http",outcome="error"} 0`,
+				"# HELP schemakit_lint_issues_total Total issues reported by transport, code, and severity.",
+				"# TYPE schemakit_lint_issues_total counter",
+				"# HELP schemakit_lint_duration_seconds Lint request latency in seconds by transport.",
+				"# TYPE schemakit_lint_duration_seconds histogram",
+				`schemakit_lint_duration_seconds_bucket{transport="http",le="0.001"} 0`,
+				`schemakit_lint_duration_seconds_bucket{transport="http",le="+Inf"} 0`,
+				`schemakit_lint_duration_seconds_sum{transport="http"} 0`,
+				`schemakit_lint_duration_seconds_count{transport="http"} 0`,
+			},
+		},
+		{
+			name: "counters",
+			observe: func(m *serveMetrics) {
+				result := &linter.Result{Issues: []linter.Issue{
+					{Code: linter.CodeInvalidPropertyCase, Severity: linter.SeverityError},
+					{Code: linter.CodeInvalidPropertyCase, Severity: linter.SeverityError},
+					{Code: linter.CodeLargeEnum, Severity: linter.SeverityWarning},
+				}}
+				m.observe(200, result, 2*time.Millisecond)
+				m.observe(200, &linter.Result{}, 20*time.Millisecond)
+				m.observe(400, nil, 3*time.Second)
+			},
+			want: []string{
+				`schemakit_lint_requests_total{transport="http",status="200"} 2`,
+				`schemakit_lint_requests_total{transport="http",status="400"} 1`,
+				`schemakit_lint_results_total{transport="http",outcome="pass"} 1`,
+				`schemakit_lint_results_total{transport="http",outcome="warning"} 0`,
+				`schemakit_lint_results_total{transport="http",outcome="error"} 1`,
+				`schemakit_lint_issues_total{transport="http",code="invalid-property-case",severity="error"} 2`,
+				`schemakit_lint_issues_total{transport="http",code="large-enum",severity="warning"} 1`,
+				`schemakit_lint_duration_seconds_bucket{transport="http",le="0.001"} 0`,
+				`schemakit_lint_duration_seconds_bucket{transport="http",le="0.005"} 1`,
+				`schemakit_lint_duration_seconds_bucket{transport="http",le="0.025"} 2`,
+				`schemakit_lint_duration_seconds_bucket{transport="http",le="2.5"} 2`,
+				`schemakit_lint_duration_seconds_bucket{transport="http",le="5"} 3`,
+				`schemakit_lint_duration_seconds_bucket{transport="http",le="+Inf"} 3`,
+				`schemakit_lint_duration_seconds_sum{transport="http"} 3.022`,
+				`schemakit_lint_duration_seconds_count{transport="http"} 3`,
+			},
+		},
+		{
+			name: "transports",
+			observe: func(m *serveMetrics) {
+				m.observe(200, &linter.Result{}, time.Millisecond)
+				m.observeRequest(transportGRPC, "OK", 2*time.Second)
+				m.observeResult(transportGRPC, &linter.Result{Issues: []linter.Issue{
+					{Code: linter.CodeLargeEnum, Severity: linter.SeverityWarning},
+				}})
+				m.observeRequest(transportGRPC, "InvalidArgument", time.Millisecond)
+			},
+			want: []string{
+				`schemakit_lint_requests_total{transport="http",status="200"} 1`,
+				`schemakit_lint_requests_total{transport="grpc",status="InvalidArgument"} 1`,
+				`schemakit_lint_requests_total{transport="grpc",status="OK"} 1`,
+				`schemakit_lint_results_total{transport="http",outcome="pass"} 1`,
+				`schemakit_lint_results_total{transport="grpc",outcome="pass"} 0`,
+				`schemakit_lint_results_total{transport="grpc",outcome="warning"} 1`,
+				`schemakit_lint_issues_total{transport="grpc",code="large-enum",severity="warning"} 1`,
+				`schemakit_lint_duration_seconds_bucket{transport="http",le="0.001"} 1`,
+				`schemakit_lint_duration_seconds_bucket{transport="grpc",le="0.001"} 1`,
+				`schemakit_lint_duration_seconds_bucket{transport="grpc",le="1"} 1`,
+				`schemakit_lint_duration_seconds_bucket{transport="grpc",le="2.5"} 2`,
+				`schemakit_lint_duration_seconds_count{transport="http"} 1`,
+				`schemakit_lint_duration_seconds_count{transport="grpc"} 2`,
+			},
+		},
+		{
+			name: "label escaping",
+			observe: func(m *serveMetrics) {
+				result := &linter.Result{Issues: []linter.Issue{
+					{Code: "quote\"back\\slash\nline", Severity: linter.SeverityInfo},
+					{Code: "tab\tand é", Severity: linter.SeverityInfo},
+				}}
+				m.observe(200, result, time.Millisecond)
+			},
+			want: []string{
+				`schemakit_lint_issues_total{transport="http",code="quote\"back\\slash\nline",severity="info"} 1`,
+				"schemakit_lint_issues_total{transport=\"http\",code=\"tab\tand é\",severity=\"info\"} 1",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newServeMetrics()
+			if tt.observe != nil {
+				tt.observe(m)
+			}
+			out := m.render()
+			if !strings.HasSuffix(out, "\n") {
+				t.Error("output does not end with a newline")
+			}
+
+			lines := make(map[string]bool)
+			for _, line := range strings.Split(strings.TrimSuffix(out, "\n"), "\n") {
+				lines[line] = true
+			}
+			for _, want := range tt.want {
+				if !lines[want] {
+					t.Errorf("missing line %q in:\n%s", want, out)
+				}
+			}
+		})
+	}
+}
+
+func TestServeMetricsRenderOrder(t *testing.T) {
+	m := newServeMetrics()
+	m.observe(500, nil, time.Millisecond)
+	m.observe(200, &linter.Result{Issues: []linter.Issue{
+		{Code: "b-code", Severity: linter.SeverityWarning},
+		{Code: "a-code", Severity: linter.SeverityWarning},
+		{Code: "a-code", Severity: linter.SeverityError},
+	}}, time.Millisecond)
+
+	out := m.render()
+	order := []string{
+		"# HELP schemakit_lint_requests_total",
+		"# TYPE schemakit_lint_requests_total",
+		`schemakit_lint_requests_total{transport="http",status="200"}`,
+		`schemakit_lint_requests_total{transport="http",status="500"}`,
+		"# HELP schemakit_lint_issues_total",
+		"# TYPE schemakit_lint_issues_total",
+		`schemakit_lint_issues_total{transport="http",code="a-code",severity="error"}`,
+		`schemakit_lint_issues_total{transport="http",code="a-code",severity="warning"}`,
+		`schemakit_lint_issues_total{transport="http",code="b-code",severity="warning"}`,
+		"# HELP schemakit_lint_duration_seconds",
+	}
+	last := -1
+	for _, prefix := range order {
+		i := strings.Index(out, "\n"+prefix)
+		if strings.HasPrefix(out, prefix) {
+			i = 0
+		}
+		if i <= last {
+			t.Errorf("%q out of order in:\n%s", prefix, out)
+		}
+		last = i
+	}
+}
@@ -0,0 +1,41 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/grokify/schemakit/linter"
+	"github.com/grokify/schemakit/linter/notify"
+)
+
+var (
+	lintNotifyWebhook string
+	lintNotifyFormat  string
+)
+
+func init() {
+	lintCmd.Flags().StringVar(&lintNotifyWebhook, "notify-webhook", "", "Post a summary of the results to this webhook URL after linting")
+	lintCmd.Flags().StringVar(&lintNotifyFormat, "notify-format", "slack", "Webhook payload format: slack, json")
+}
+
+// checkNotifyFlags returns an error if the webhook format is unknown.
+func checkNotifyFlags() error {
+	switch notify.Format(lintNotifyFormat) {
+	case notify.FormatSlack, notify.FormatJSON:
+		return nil
+	}
+	return fmt.Errorf("unknown --notify-format %q (valid: slack, json)", lintNotifyFormat)
+}
+
+// notifyResults posts the results to the --notify-webhook URL, if set. A failed
+// notification is reported on stderr and does not change the exit code.
+func notifyResults(results ...linter.Result) {
+	if lintNotifyWebhook == "" {
+		return
+	}
+	n := &notify.Webhook{URL: lintNotifyWebhook, Format: notify.Format(lintNotifyFormat)}
+	if err := n.Notify(context.Background(), results); err != nil {
+		fmt.Fprintf(os.Stderr, "warning: %v\n", err)
+	}
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+)
+
+// progressWidth is the number of cells in the progress bar.
+const progressWidth = 30
+
+// progressInterval limits how often the progress line is redrawn.
+const progressInterval = 100 * time.Millisecond
+
+// progress draws a single-line progress bar with throughput and ETA. A nil
+// *progress is valid and draws nothing.
+type progress struct {
+	w     io.Writer
+	total int
+	done  int
+	start time.Time
+	drawn time.Time
+}
+
+// newProgress returns a progress bar for total items written to f, or nil
+// if disabled or f is not a terminal.
+func newProgress(f *os.File, total int, enabled bool) *progress {
+	if !enabled || total == 0 || !isTerminal(f) {
+		return nil
+	}
+	return &progress{w: f, total: total, start: time.Now()}
+}
+
+// isTerminal reports whether f is attached to a terminal.
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// Step records one completed item and redraws the bar if due.
+func (p *progress) Step() {
+	if p == nil {
+		return
+	}
+	p.done++
+	now := time.Now()
+	if p.done < p.total && now.Sub(p.drawn) < progressInterval {
+		return
+	}
+	p.drawn = now
+
+	filled := progressWidth * p.done / p.total
+	bar := strings.Repeat("=", filled) + strings.Repeat(" ", progressWidth-filled)
+	elapsed := now.Sub(p.start).Seconds()
+	rate := 0.0
+	if elapsed > 0 {
+		rate = float64(p.done) / elapsed
+	}
+	eta := "--"
+	if rate > 0 {
+		eta = time.Duration(float64(p.total-p.done) / rate * float64(time.Second)).Round(time.Second).String()
+	}
+	fmt.Fprintf(p.w, "\r[%s] %d/%d files  %.1f files/s  ETA %s\033[K", bar, p.done, p.total, rate, eta)
+}
+
+// Finish clears the progress line.
+func (p *progress) Finish() {
+	if p == nil {
+		return
+	}
+	fmt.Fprint(p.w, "\r\033[K")
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	protoOutput  string
+	protoPackage string
+)
+
+func init() {
+	generateCmd.AddCommand(protoCmd)
+
+	protoCmd.Flags().StringVarP(&protoOutput, "output", "o", "", "Output file (default: stdout)")
+	protoCmd.Flags().StringVar(&protoPackage, "package", "", "Proto package name")
+}
+
+var protoCmd = &cobra.Command{
+	Use:   "proto <schema.json>",
+	Short: "Preview a JSON Schema as proto3 messages",
+	Long: `Preview how a JSON Schema maps to Protocol Buffers (proto3).
+
+The root schema (named by its title) and each object definition become
+messages, string enums become enums, and discriminated unions become
+oneof blocks. Local $refs become message references.
+
+Constructs with no proto3 equivalent (undiscriminated unions, allOf,
+type arrays, nested arrays) are previewed as google.protobuf.Value and
+reported on stderr as proto-unrepresentable warnings.
+
+Fields are numbered in sorted property order; assign stable numbers
+before using the output as a real .proto file.
+
+Examples:
+  schemakit gen proto schema.json
+  schemakit gen proto schema.json --package events.v1 -o events.proto`,
+	Args: cobra.ExactArgs(1),
+	RunE: runProto,
+}
+
+func runProto(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read file: %w", err)
+	}
+
+	schema, err := linter.ParseSchema(data)
+	if err != nil {
+		return err
+	}
+
+	proto, issues := linter.GenerateProto(schema, protoPackage)
+
+	if protoOutput != "" {
+		if err := os.WriteFile(protoOutput, []byte(proto), 0600); err != nil {
+			return fmt.Errorf("failed to write output file: %w", err)
+		}
+		fmt.Fprintf(cmd.ErrOrStderr(), "Generated %s\n", protoOutput)
+	} else {
+		fmt.Print(proto)
+	}
+
+	if len(issues) > 0 {
+		result := linter.Result{SchemaPath: args[0], Issues: issues}
+		fmt.Fprint(cmd.ErrOrStderr(), result.String())
+	}
+	return nil
+}
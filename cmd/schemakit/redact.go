@@ -0,0 +1,92 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	redactOut  string
+	redactKeep []string
+)
+
+func init() {
+	rootCmd.AddCommand(redactCmd)
+
+	redactCmd.Flags().StringVar(&redactOut, "out", "", "Output file (default: stdout)")
+	redactCmd.Flags().StringSliceVar(&redactKeep, "keep", nil, "Property names to keep (default: the discriminator fields, component_type,type,kind)")
+}
+
+var redactCmd = &cobra.Command{
+	Use:   "redact <schema.json>",
+	Short: "Anonymize a schema for sharing in bug reports",
+	Long: `Replace the names and text of a schema with stable pseudonyms, keeping
+its structure, so that it can be shared to reproduce a problem without
+leaking proprietary field names:
+
+  - property names become f<hash>, in properties, required, and
+    dependentRequired; the discriminator fields are kept, so that union
+    checks behave the same
+  - definition names become T<hash>, with the local $refs to them
+  - string values of enum, const, default, and examples become v<hash>
+  - title, description, and $comment are replaced
+
+Pseudonyms are derived from a hash of the original, so a name is redacted
+the same way everywhere. Keywords, types, constraints, and patterns are
+kept; review patterns and $ids before sharing. Run lint with --redact
+-o json for a report that matches the redacted schema.
+
+Examples:
+  schemakit redact schema.json --out repro.json
+  schemakit lint schema.json -o json --redact > repro-lint.json`,
+	Args: cobra.ExactArgs(1),
+	RunE: runRedact,
+}
+
+func runRedact(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read schema: %w", err)
+	}
+	keep := redactKeep
+	if !cmd.Flags().Changed("keep") {
+		keep = linter.DefaultConfig().DiscriminatorFields
+	}
+	redacted, err := linter.NewRedactor(keep...).Redact(data)
+	if err != nil {
+		return err
+	}
+
+	if redactOut == "" {
+		_, err := os.Stdout.Write(redacted)
+		return err
+	}
+	if err := os.WriteFile(redactOut, redacted, 0o600); err != nil {
+		return fmt.Errorf("failed to write schema: %w", err)
+	}
+	return nil
+}
+
+// redactResult returns a copy of a lint result redacted with the
+// pseudonyms of its schema, keeping the discriminator fields.
+func redactResult(result linter.Result, schemaPath string, config linter.Config) (*linter.Result, error) {
+	data, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read schema: %w", err)
+	}
+	r := linter.NewRedactor(config.DiscriminatorFields...)
+	if _, err := r.Redact(data); err != nil {
+		return nil, err
+	}
+	result.Issues = slices.Clone(result.Issues)
+	result.Suppressed = slices.Clone(result.Suppressed)
+	r.RedactResult(&result)
+	result.SchemaPath = "redacted" + filepath.Ext(schemaPath)
+	return &result, nil
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	htmltemplate "html/template"
+	"io"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// rollupOutput is the JSON output of lint for a directory with --rollup.
+type rollupOutput struct {
+	Results any           `json:"results"`
+	Rollup  linter.Rollup `json:"rollup"`
+}
+
+// rollupResults returns the rollup of results, with the issue counts of
+// the --rollup-baseline results if given.
+func rollupResults(results []*linter.Result) (linter.Rollup, error) {
+	var baseline []linter.Result
+	if lintRollupBaseline != "" {
+		var err error
+		if baseline, err = linter.LoadResults(lintRollupBaseline); err != nil {
+			return linter.Rollup{}, err
+		}
+	}
+	return linter.NewRollup(results, baseline), nil
+}
+
+// writeHTMLReport writes lint results as a standalone HTML page, with the
+// metadata of the first result and the rollup first if given.
+func writeHTMLReport(w io.Writer, results []*linter.Result, rollup *linter.Rollup) error {
+	t := htmltemplate.Must(htmltemplate.New("report").Parse(lintHTMLTemplate))
+	var metadata *linter.Metadata
+	for _, result := range results {
+		if result.Metadata != nil {
+			metadata = result.Metadata
+			break
+		}
+	}
+	return t.Execute(w, struct {
+		Results  []*linter.Result
+		Rollup   *linter.Rollup
+		Metadata *linter.Metadata
+	}{results, rollup, metadata})
+}
+
+const lintHTMLTemplate = `<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>schemakit lint report</title>
+<style>
+body { font-family: sans-serif; max-width: 60rem; margin: 2rem auto; padding: 0 1rem; }
+table { border-collapse: collapse; margin-bottom: 1rem; }
+th, td { border: 1px solid #ccc; padding: 0.3rem 0.6rem; text-align: left; vertical-align: top; }
+code { background: #f4f4f4; padding: 0 0.2rem; }
+.error { color: #b00020; }
+.warning { color: #9a6700; }
+.metadata { color: #555; }
+</style>
+</head>
+<body>
+<h1>schemakit lint report</h1>
+{{with .Metadata}}<p class="metadata">{{.Tool}} {{.Version}}, {{.Profile}} profile, config <code>{{.ConfigHash}}</code>, {{.Timestamp.Format "2006-01-02T15:04:05Z07:00"}}</p>
+{{end}}{{with .Rollup}}{{$trend := ne .Total.Trend ""}}<h2>Rollup</h2>
+<p>{{.Total.Schemas}} schema(s), {{.Total.Errors}} error(s), {{.Total.Warnings}} warning(s), {{.Total.Info}} info{{with .Total.Trend}} ({{.}} since baseline){{end}}</p>
+<h3>By directory</h3>
+<table>
+<tr><th>Directory</th><th>Schemas</th><th>Errors</th><th>Warnings</th><th>Info</th>{{if $trend}}<th>Trend</th>{{end}}</tr>
+{{range .Directories}}<tr><td><code>{{.Name}}</code></td><td>{{.Schemas}}</td><td>{{.Errors}}</td><td>{{.Warnings}}</td><td>{{.Info}}</td>{{if $trend}}<td>{{.Trend}}</td>{{end}}</tr>
+{{end}}</table>
+<h3>By rule</h3>
+<table>
+<tr><th>Rule</th><th>Errors</th><th>Warnings</th><th>Info</th>{{if $trend}}<th>Trend</th>{{end}}</tr>
+{{range .Rules}}<tr><td><code>{{.Name}}</code></td><td>{{.Errors}}</td><td>{{.Warnings}}</td><td>{{.Info}}</td>{{if $trend}}<td>{{.Trend}}</td>{{end}}</tr>
+{{end}}</table>
+{{end}}<h2>Issues</h2>
+{{range .Results}}<h3><code>{{.SchemaPath}}</code></h3>
+{{if .Issues}}<table>
+<tr><th>Severity</th><th>Rule</th><th>Path</th><th>Message</th></tr>
+{{range .Issues}}<tr><td class="{{.Severity}}">{{.Severity}}</td><td><code>{{.Code}}</code></td><td><code>{{.Path}}</code></td><td>{{.Message}}{{with .Suggestion}}<br><em>{{.}}</em>{{end}}</td></tr>
+{{end}}</table>
+{{else}}<p>No issues found</p>
+{{end}}{{end}}</body>
+</html>
+`
@@ -0,0 +1,60 @@
+package main
+
+import (
+	"cmp"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// profileTop is the number of rules and files --profile-rules lists.
+const profileTop = 10
+
+// printRuleProfile writes the slowest rules, summed across results, and
+// the slowest files of a --profile-rules run.
+func printRuleProfile(w io.Writer, results ...*linter.Result) {
+	var total time.Duration
+	byRule := make(map[string]*linter.RuleTiming)
+	var files []*linter.Result
+	for _, result := range results {
+		if result.Timing == nil {
+			continue
+		}
+		total += result.Timing.Total
+		files = append(files, result)
+		for _, t := range result.Timing.Rules {
+			if byRule[t.Rule] == nil {
+				byRule[t.Rule] = &linter.RuleTiming{Rule: t.Rule}
+			}
+			byRule[t.Rule].Duration += t.Duration
+			byRule[t.Rule].Calls += t.Calls
+		}
+	}
+	if len(files) == 0 {
+		return
+	}
+
+	rules := make([]linter.RuleTiming, 0, len(byRule))
+	for _, t := range byRule {
+		rules = append(rules, *t)
+	}
+	slices.SortFunc(rules, func(a, b linter.RuleTiming) int {
+		return cmp.Or(cmp.Compare(b.Duration, a.Duration), cmp.Compare(a.Rule, b.Rule))
+	})
+	fmt.Fprintln(w, "\nSlowest rules:")
+	for _, t := range rules[:min(len(rules), profileTop)] {
+		fmt.Fprintf(w, "  %-28s %12s  %6d call(s)\n", t.Rule, t.Duration.Round(time.Microsecond), t.Calls)
+	}
+
+	if len(files) > 1 {
+		slices.SortStableFunc(files, func(a, b *linter.Result) int { return cmp.Compare(b.Timing.Total, a.Timing.Total) })
+		fmt.Fprintln(w, "\nSlowest files:")
+		for _, result := range files[:min(len(files), profileTop)] {
+			fmt.Fprintf(w, "  %12s  %s\n", result.Timing.Total.Round(time.Microsecond), result.SchemaPath)
+		}
+	}
+	fmt.Fprintf(w, "\nLint time: %s across %d file(s)\n", total.Round(time.Microsecond), len(files))
+}
@@ -0,0 +1,165 @@
+package main
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// testSuiteURL is the archive of the JSON Schema Test Suite downloaded when
+// no checkout is given.
+const testSuiteURL = "https://github.com/json-schema-org/JSON-Schema-Test-Suite/archive/refs/heads/main.tar.gz"
+
+var (
+	selftestDraft  string
+	selftestOutput string
+)
+
+func init() {
+	rootCmd.AddCommand(selftestCmd)
+
+	selftestCmd.Flags().StringVar(&selftestDraft, "draft", "draft2020-12", "Test suite draft directory: draft4, draft6, draft7, draft2019-09, draft2020-12")
+	selftestCmd.Flags().StringVarP(&selftestOutput, "output", "o", "text", "Output format: text, json")
+}
+
+var selftestCmd = &cobra.Command{
+	Use:   "selftest [suite-dir]",
+	Short: "Check the schema model against the JSON Schema Test Suite",
+	Long: `Run the schemas of the official JSON Schema Test Suite through the
+parser, $ref resolver, and linter, and report the keywords schemakit
+mishandles: cases whose schema fails to parse or lint, local $refs that
+do not resolve, and keywords the schema model ignores, which no rule can
+check.
+
+Give the directory of a checkout of
+https://github.com/json-schema-org/JSON-Schema-Test-Suite (e.g., a
+vendored copy), or omit it to download the latest suite.
+
+Exit codes:
+  0 - All cases passed
+  1 - One or more cases failed
+
+Examples:
+  schemakit selftest
+  schemakit selftest ./third_party/JSON-Schema-Test-Suite --draft draft7
+  schemakit selftest -o json`,
+	Args: cobra.MaximumNArgs(1),
+	RunE: runSelftest,
+}
+
+func runSelftest(cmd *cobra.Command, args []string) error {
+	dir := ""
+	if len(args) == 1 {
+		dir = args[0]
+	} else {
+		tmp, err := os.MkdirTemp("", "schemakit-selftest-")
+		if err != nil {
+			return fmt.Errorf("failed to create temporary directory: %w", err)
+		}
+		defer os.RemoveAll(tmp)
+		fmt.Fprintf(cmd.ErrOrStderr(), "Downloading %s\n", testSuiteURL)
+		if err := downloadTestSuite(cmd.Context(), testSuiteURL, tmp); err != nil {
+			return err
+		}
+		dir = tmp
+	}
+
+	report, err := linter.RunTestSuite(os.DirFS(dir), selftestDraft)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	switch selftestOutput {
+	case "json":
+		data, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to serialize report: %w", err)
+		}
+		fmt.Fprintln(out, string(data))
+	case "text":
+		for _, k := range report.Keywords {
+			status := "PASS"
+			if k.Passed < k.Cases {
+				status = "FAIL"
+			}
+			fmt.Fprintf(out, "%s %s (%d/%d cases)\n", status, k.Keyword, k.Passed, k.Cases)
+			for _, f := range k.Failures {
+				fmt.Fprintf(out, "  %s: %s\n", f.Case, f.Problem)
+			}
+			if len(k.Unmodeled) > 0 {
+				fmt.Fprintf(out, "  not modeled: %s\n", strings.Join(k.Unmodeled, ", "))
+			}
+		}
+		fmt.Fprintf(out, "\n%s: %d passed, %d failed\n", report.Draft, report.Cases()-report.Failed(), report.Failed())
+	default:
+		return fmt.Errorf("unknown output format %q", selftestOutput)
+	}
+
+	if report.Failed() > 0 {
+		os.Exit(1)
+	}
+	return nil
+}
+
+// downloadTestSuite downloads the test suite archive at url and extracts
+// its tests directory into dir.
+func downloadTestSuite(ctx context.Context, url, dir string) error {
+	ctx, cancel := context.WithTimeout(ctx, 2*time.Minute)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to download test suite: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("failed to download test suite: %s", resp.Status)
+	}
+
+	gz, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read test suite archive: %w", err)
+	}
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("failed to read test suite archive: %w", err)
+		}
+		// Entries are under a top-level directory named after the branch
+		_, name, _ := strings.Cut(hdr.Name, "/")
+		if hdr.Typeflag != tar.TypeReg || !strings.HasPrefix(name, "tests/") || !strings.HasSuffix(name, ".json") || !filepath.IsLocal(name) {
+			continue
+		}
+		target := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+			return fmt.Errorf("failed to extract test suite: %w", err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return fmt.Errorf("failed to read test suite archive: %w", err)
+		}
+		if err := os.WriteFile(target, data, 0o600); err != nil {
+			return fmt.Errorf("failed to extract test suite: %w", err)
+		}
+	}
+}
@@ -0,0 +1,166 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	serveAddr        string
+	serveGRPCAddr    string
+	serveMaxBodySize int64
+	serveTrace       bool
+)
+
+func init() {
+	rootCmd.AddCommand(serveCmd)
+
+	serveCmd.Flags().StringVar(&serveAddr, "addr", ":8080", "HTTP listen address")
+	serveCmd.Flags().StringVar(&serveGRPCAddr, "grpc", "", "Also serve the gRPC LintService on this address (e.g., :9090)")
+	serveCmd.Flags().Int64Var(&serveMaxBodySize, "max-body-size", 10<<20, "Maximum schema size in bytes")
+	serveCmd.Flags().BoolVar(&serveTrace, "trace", false, "Write the OpenTelemetry spans of each lint request to stderr")
+}
+
+var serveCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Run lint as an HTTP service",
+	Long: `Run an HTTP service that lints JSON Schemas.
+
+Endpoints:
+  POST /lint     - Lint the request body; returns the JSON result
+                   Query parameters: profile, property_case
+  GET  /metrics  - Prometheus metrics (requests, issues, latency) of the
+                   HTTP and gRPC APIs, labeled by transport
+  GET  /healthz  - Health check
+
+With --grpc, the schemakit.v1.LintService defined in
+proto/schemakit/v1/lint.proto is also served on the given address, with
+the Lint and LintProject RPCs streaming issues and ListRules returning the
+issue codes.
+
+With --trace, each lint request is recorded as OpenTelemetry spans, one
+for the request and one for each rule and phase (parse, resolve, ...),
+written to stderr as JSON. Embedders export spans to their own backend
+by setting TracerProvider in the linter Config.
+
+Examples:
+  schemakit serve --addr :8080
+  schemakit serve --addr :8080 --grpc :9090
+
+  curl --data-binary @schema.json 'http://localhost:8080/lint?profile=scale'`,
+	Args: cobra.NoArgs,
+	RunE: runServe,
+}
+
+func runServe(cmd *cobra.Command, args []string) error {
+	metrics := newServeMetrics()
+
+	var provider trace.TracerProvider
+	if serveTrace {
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(cmd.ErrOrStderr()))
+		if err != nil {
+			return fmt.Errorf("failed to create trace exporter: %w", err)
+		}
+		provider = sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/lint", lintHandler(metrics, provider))
+	mux.HandleFunc("/metrics", metrics.handler)
+	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		_, _ = io.WriteString(w, "ok\n")
+	})
+
+	server := &http.Server{
+		Addr:              serveAddr,
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errs := make(chan error, 2)
+	if serveGRPCAddr != "" {
+		lis, err := net.Listen("tcp", serveGRPCAddr)
+		if err != nil {
+			return fmt.Errorf("failed to listen on %s: %w", serveGRPCAddr, err)
+		}
+		grpcServer := newGRPCServer(provider, metrics, int(serveMaxBodySize))
+		defer grpcServer.Stop()
+		fmt.Fprintf(cmd.ErrOrStderr(), "gRPC listening on %s\n", serveGRPCAddr)
+		go func() { errs <- grpcServer.Serve(lis) }()
+	}
+
+	fmt.Fprintf(cmd.ErrOrStderr(), "Listening on %s\n", serveAddr)
+	go func() { errs <- server.ListenAndServe() }()
+	return <-errs
+}
+
+// lintHandler lints the request body and records metrics for each request,
+// and spans if provider is not nil.
+func lintHandler(metrics *serveMetrics, provider trace.TracerProvider) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		status := http.StatusOK
+		var result *linter.Result
+		defer func() {
+			metrics.observe(status, result, time.Since(start))
+		}()
+
+		if r.Method != http.MethodPost {
+			status = http.StatusMethodNotAllowed
+			http.Error(w, "method not allowed", status)
+			return
+		}
+
+		profile := queryDefault(r, "profile", "default")
+		propertyCase := queryDefault(r, "property_case", "camelCase")
+		config, err := buildConfig(profile, propertyCase)
+		if err != nil {
+			status = http.StatusBadRequest
+			http.Error(w, err.Error(), status)
+			return
+		}
+		config.TracerProvider = provider
+
+		data, err := io.ReadAll(http.MaxBytesReader(w, r.Body, serveMaxBodySize))
+		if err != nil {
+			status = http.StatusRequestEntityTooLarge
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		result, err = linter.New(config).LintContext(r.Context(), data)
+		if err != nil {
+			status = http.StatusUnprocessableEntity
+			http.Error(w, err.Error(), status)
+			return
+		}
+
+		out, err := result.JSON()
+		if err != nil {
+			status = http.StatusInternalServerError
+			http.Error(w, err.Error(), status)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(out)
+	}
+}
+
+// queryDefault returns the query parameter value or a default if unset.
+func queryDefault(r *http.Request, key, def string) string {
+	if v := r.URL.Query().Get(key); v != "" {
+		return v
+	}
+	return def
+}
@@ -0,0 +1,35 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	lintMaxSuppressions   int
+	lintSuppressionReport bool
+)
+
+func init() {
+	lintCmd.Flags().IntVar(&lintMaxSuppressions, "max-suppressions", -1, "Exit 1 if more than this many issues are suppressed; -1 for no limit")
+	lintCmd.Flags().BoolVar(&lintSuppressionReport, "suppression-report", false, "List the suppressed issues after the results")
+}
+
+// checkSuppressions prints the suppression report if --suppression-report
+// is set (on stdout for text output, otherwise on stderr) and exits 1 if
+// the results exceed --max-suppressions.
+func checkSuppressions(results ...linter.Result) {
+	if lintSuppressionReport {
+		w := os.Stdout
+		if lintOutput != "text" {
+			w = os.Stderr
+		}
+		fmt.Fprint(w, "\n"+linter.SuppressionReport(results...))
+	}
+	if n := linter.SuppressionCount(results...); lintMaxSuppressions >= 0 && n > lintMaxSuppressions {
+		fmt.Fprintf(os.Stderr, "%d suppressed issue(s) exceed --max-suppressions %d\n", n, lintMaxSuppressions)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,76 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+var (
+	trimOut        string
+	trimKeepTitles bool
+	trimRoots      []string
+	trimMinify     bool
+)
+
+func init() {
+	rootCmd.AddCommand(trimCmd)
+
+	trimCmd.Flags().StringVar(&trimOut, "out", "", "Output file (default: stdout)")
+	trimCmd.Flags().BoolVar(&trimKeepTitles, "keep-titles", false, "Keep title keywords")
+	trimCmd.Flags().StringArrayVar(&trimRoots, "root", nil, "Also keep definitions reachable from this entry schema (e.g., '#/$defs/PublicAPI'); repeatable")
+	trimCmd.Flags().BoolVar(&trimMinify, "minify", false, "Write the schema without whitespace")
+}
+
+var trimCmd = &cobra.Command{
+	Use:   "trim <schema.json>",
+	Short: "Strip annotations and unused definitions for runtime use",
+	Long: `Produce a minimal schema for runtime validation, e.g. to embed in a
+binary:
+
+  - annotation-only keywords are removed: title, description, examples,
+    and $comment (--keep-titles keeps titles)
+  - definitions that no $ref reaches from the document root or a --root
+    are removed; definitions with their own $id are kept
+
+A schema whose root only holds definitions (a schema library) keeps all
+of them unless --root is given. Key order and all other keywords are
+kept. What was removed and the size change are written to stderr.
+
+Examples:
+  schemakit trim schema.json --minify > schema.min.json
+  schemakit trim schema.json --keep-titles --out dist/schema.json
+  schemakit trim library.json --root '#/$defs/Order'`,
+	Args: cobra.ExactArgs(1),
+	RunE: runTrim,
+}
+
+func runTrim(cmd *cobra.Command, args []string) error {
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return fmt.Errorf("failed to read schema: %w", err)
+	}
+	trimmed, stats, err := linter.Trim(data, linter.TrimOptions{
+		KeepTitles: trimKeepTitles,
+		Roots:      trimRoots,
+		Minify:     trimMinify,
+	})
+	if err != nil {
+		return err
+	}
+
+	if trimOut == "" {
+		if _, err := os.Stdout.Write(trimmed); err != nil {
+			return err
+		}
+	} else if err := os.WriteFile(trimOut, trimmed, 0o600); err != nil {
+		return fmt.Errorf("failed to write schema: %w", err)
+	}
+
+	fmt.Fprintf(os.Stderr, "Removed %d annotation(s) and %d unused definition(s): %d -> %d bytes\n",
+		stats.Annotations, stats.Definitions, len(data), len(trimmed))
+	return nil
+}
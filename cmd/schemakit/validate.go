@@ -0,0 +1,94 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/grokify/schemakit/linter"
+	"github.com/grokify/schemakit/validate"
+)
+
+var (
+	validateSchema string
+	validateOutput string
+)
+
+func init() {
+	rootCmd.AddCommand(validateCmd)
+
+	validateCmd.Flags().StringVarP(&validateSchema, "schema", "s", "", "JSON Schema file to validate against (required)")
+	validateCmd.Flags().StringVarP(&validateOutput, "output", "o", "text", "Output format: text, json, github")
+	_ = validateCmd.MarkFlagRequired("schema")
+}
+
+var validateCmd = &cobra.Command{
+	Use:   "validate --schema <schema.json> <data.json>...",
+	Short: "Validate JSON documents against a JSON Schema",
+	Long: `Validate instance documents against a JSON Schema.
+
+Validation failures are reported as invalid-instance errors at their
+location within the document (e.g., $/pets/0/name), using the same output
+formats as lint. Relative $refs to other schema files are resolved against
+the schema's location.
+
+Exit codes:
+  0 - All documents are valid
+  1 - One or more documents are invalid
+
+Examples:
+  schemakit validate --schema schema.json data.json
+  schemakit validate -s schema.json fixtures/*.json -o github`,
+	Args: cobra.MinimumNArgs(1),
+	RunE: runValidate,
+}
+
+func runValidate(cmd *cobra.Command, args []string) error {
+	v, err := validate.NewFromFile(validateSchema)
+	if err != nil {
+		return err
+	}
+
+	results := make([]*linter.Result, 0, len(args))
+	for _, path := range args {
+		result, err := v.ValidateFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to validate %s: %w", path, err)
+		}
+		results = append(results, result)
+	}
+
+	switch validateOutput {
+	case "json":
+		var data []byte
+		if len(results) == 1 {
+			data, err = results[0].JSON()
+		} else {
+			data, err = json.MarshalIndent(results, "", "  ")
+		}
+		if err != nil {
+			return fmt.Errorf("failed to serialize result: %w", err)
+		}
+		fmt.Println(string(data))
+	case "github":
+		for _, result := range results {
+			fmt.Print(result.GitHubAnnotations())
+		}
+	default:
+		for _, result := range results {
+			if len(results) > 1 {
+				fmt.Printf("%s:\n", result.SchemaPath)
+			}
+			fmt.Print(result.String())
+		}
+	}
+
+	for _, result := range results {
+		if result.HasErrors() {
+			os.Exit(1)
+		}
+	}
+	return nil
+}
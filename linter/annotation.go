@@ -0,0 +1,164 @@
+package linter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Stability returns the x-stability annotation of the schema, or "" if it
+// has none. The error is for a value that is not a string.
+func (s *Schema) Stability() (string, error) {
+	return stringAnnotation(s.XStability)
+}
+
+// Owner returns the x-owner annotation of the schema, or "" if it has
+// none. The error is for a value that is not a string.
+func (s *Schema) Owner() (string, error) {
+	return stringAnnotation(s.XOwner)
+}
+
+// Annotation returns the x-schemalint annotation of the schema, or nil if
+// it has none. The error is for a value that is not an object with an
+// array of issue codes as "ignore" and a string as "reason".
+func (s *Schema) Annotation() (*LintAnnotation, error) {
+	if isAbsent(s.XSchemalint) {
+		return nil, nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(s.XSchemalint, &fields); err != nil {
+		return nil, fmt.Errorf("must be an object, got %s", jsonKind(s.XSchemalint))
+	}
+	var a LintAnnotation
+	if raw, ok := fields["ignore"]; ok {
+		if err := json.Unmarshal(raw, &a.Ignore); err != nil {
+			return nil, errors.New(`"ignore" must be an array of issue codes`)
+		}
+	}
+	if raw, ok := fields["reason"]; ok {
+		if err := json.Unmarshal(raw, &a.Reason); err != nil {
+			return nil, fmt.Errorf(`"reason" must be a string, got %s`, jsonKind(raw))
+		}
+	}
+	return &a, nil
+}
+
+// stringAnnotation decodes an annotation whose value is a string.
+func stringAnnotation(raw json.RawMessage) (string, error) {
+	if isAbsent(raw) {
+		return "", nil
+	}
+	var v string
+	if err := json.Unmarshal(raw, &v); err != nil {
+		return "", fmt.Errorf("must be a string, got %s", jsonKind(raw))
+	}
+	return v, nil
+}
+
+// isAbsent reports whether an annotation is missing or null.
+func isAbsent(raw json.RawMessage) bool {
+	return len(raw) == 0 || isJSONNull(raw)
+}
+
+// jsonKind returns the JSON type of a raw value, for messages.
+func jsonKind(raw json.RawMessage) string {
+	trimmed := strings.TrimSpace(string(raw))
+	switch {
+	case trimmed == "":
+		return "nothing"
+	case trimmed[0] == '{':
+		return "an object"
+	case trimmed[0] == '[':
+		return "an array"
+	case trimmed[0] == '"':
+		return "a string"
+	case trimmed == "true" || trimmed == "false":
+		return "a boolean"
+	case trimmed == "null":
+		return "null"
+	}
+	return "a number"
+}
+
+// lintAnnotations reports x-stability, x-owner, and x-schemalint values
+// that cannot be read, at the annotation. They are otherwise ignored, so
+// the schema is still linted.
+func lintAnnotations(schema *Schema, root Path, result *Result) {
+	report := func(path Path, key string, err error) {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeInvalidAnnotation,
+			Severity:   SeverityWarning,
+			Path:       path.Child(key),
+			Message:    fmt.Sprintf("%s %v, so it is ignored", key, err),
+			Suggestion: annotationSuggestions[key],
+		})
+	}
+	walkDocument(schema, root, func(s *Schema, path Path) {
+		if _, err := s.Stability(); err != nil {
+			report(path, "x-stability", err)
+		}
+		if _, err := s.Owner(); err != nil {
+			report(path, "x-owner", err)
+		}
+		if _, err := s.Annotation(); err != nil {
+			report(path, "x-schemalint", err)
+		}
+	})
+}
+
+// annotationSuggestions are the suggestions of invalid-annotation issues,
+// by annotation.
+var annotationSuggestions = map[string]string{
+	"x-stability":  `Set x-stability to a level such as "stable", "beta", or "experimental"`,
+	"x-owner":      `Set x-owner to the name of the owning team, e.g., "team-payments"`,
+	"x-schemalint": `Write x-schemalint as {"ignore": ["large-enum"], "reason": "..."}`,
+}
+
+// walkDocument calls fn for every schema in the document, as walkSchema
+// does, and in each of its definitions.
+func walkDocument(schema *Schema, root Path, fn func(s *Schema, path Path)) {
+	visit := func(s *Schema, path Path, _ bool) { fn(s, path) }
+	walkSchema(schema, root, false, visit)
+	for _, name := range sortedKeys(schema.Defs) {
+		walkSchema(schema.Defs[name], root.Child("$defs", name), false, visit)
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		walkSchema(schema.Definitions[name], root.Child("definitions", name), false, visit)
+	}
+}
+
+// annotationScope is a schema subtree carrying an extension annotation
+// (e.g., x-stability or x-owner) that applies to everything beneath it.
+type annotationScope struct {
+	path  Path
+	value string
+}
+
+// collectScopes returns the locations in the document, including its
+// definitions, where value returns a non-empty annotation. Malformed
+// annotations are skipped; lintAnnotations reports them.
+func collectScopes(schema *Schema, root Path, value func(*Schema) (string, error)) []annotationScope {
+	var scopes []annotationScope
+	walkDocument(schema, root, func(s *Schema, path Path) {
+		if v, err := value(s); err == nil && v != "" {
+			scopes = append(scopes, annotationScope{path: path, value: v})
+		}
+	})
+	return scopes
+}
+
+// nearestScope returns the annotation of the innermost scope containing
+// path, or "" if no scope contains it.
+func nearestScope(scopes []annotationScope, path Path) string {
+	best := -1
+	for i, scope := range scopes {
+		if path.HasPrefix(scope.path) && (best < 0 || len(scope.path.Segments) > len(scopes[best].path.Segments)) {
+			best = i
+		}
+	}
+	if best < 0 {
+		return ""
+	}
+	return scopes[best].value
+}
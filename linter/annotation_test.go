@@ -0,0 +1,81 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintInvalidAnnotations(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		path    string
+		message string
+	}{
+		{"owner array", `{"x-owner": [1]}`, "$/x-owner", "x-owner must be a string, got an array"},
+		{"stability number", `{"x-stability": 5}`, "$/x-stability", "x-stability must be a string, got a number"},
+		{"schemalint string", `{"x-schemalint": "off"}`, "$/x-schemalint", "x-schemalint must be an object, got a string"},
+		{"schemalint ignore", `{"x-schemalint": {"ignore": "large-enum"}}`, "$/x-schemalint", `"ignore" must be an array of issue codes`},
+		{"schemalint reason", `{"x-schemalint": {"ignore": ["large-enum"], "reason": false}}`, "$/x-schemalint", `"reason" must be a string, got a boolean`},
+		{"nested owner", `{"$defs": {"Pet": {"type": "object", "properties": {"name": {"type": "string", "x-owner": {"team": "pets"}}}}}}`,
+			"$/$defs/Pet/properties/name/x-owner", "x-owner must be a string, got an object"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := codeIssues(t, DefaultConfig(), tt.schema, CodeInvalidAnnotation)
+			if len(got) != 1 || got[0].Path.String() != tt.path || !strings.Contains(got[0].Message, tt.message) {
+				t.Errorf("Expected an invalid-annotation issue at %s containing %q, got %v", tt.path, tt.message, got)
+			}
+		})
+	}
+
+	for _, schema := range []string{
+		`{"x-owner": "team-pets", "x-stability": "beta", "x-schemalint": {"ignore": ["large-enum"], "reason": "ok"}}`,
+		`{"x-owner": null}`,
+	} {
+		if got := codeIssues(t, DefaultConfig(), schema, CodeInvalidAnnotation); len(got) != 0 {
+			t.Errorf("Expected no invalid-annotation issues for %s, got %v", schema, got)
+		}
+	}
+}
+
+func TestInvalidAnnotationIgnored(t *testing.T) {
+	schema := `{
+		"x-owner": "platform",
+		"x-stability": "stable",
+		"$defs": {
+			"Pet": {
+				"x-owner": 7,
+				"x-stability": ["beta"],
+				"x-schemalint": {"ignore": "dead-keyword"},
+				"type": "integer",
+				"minLength": 1
+			}
+		}
+	}`
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var found bool
+	invalid := 0
+	for _, issue := range result.Issues {
+		if issue.Code == CodeInvalidAnnotation {
+			invalid++
+		}
+		if issue.Path.String() != "$/$defs/Pet/minLength" {
+			continue
+		}
+		found = true
+		if issue.Owner != "platform" || issue.Severity != SeverityError {
+			t.Errorf("Expected the enclosing owner and stability to apply, got %+v", issue)
+		}
+	}
+	if !found || len(result.Suppressed) != 0 {
+		t.Errorf("Expected the dead-keyword issue to be reported, got %v (suppressed %v)", result.Issues, result.Suppressed)
+	}
+	if invalid != 3 {
+		t.Errorf("Expected 3 invalid-annotation issues, got %v", result.Issues)
+	}
+}
@@ -0,0 +1,299 @@
+package linter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+// Assertion is a config-defined rule written as a CEL expression
+// (https://cel.dev). The expression is evaluated at each schema node and
+// an issue is reported wherever it evaluates to true.
+//
+// The variables are schema (the current node) and path (its location, as
+// a string). Schema fields use JSON Schema keyword names (e.g.,
+// schema.properties, schema.required, schema.additionalProperties,
+// schema["$ref"]); absent keywords are absent fields, so test them with
+// has() first (or "$ref" in schema, as has() only takes field selections).
+//
+// Expressions that fail to parse or type-check, or whose result cannot be
+// a bool, and invalid literal regular expressions are compile errors. An
+// expression that fails to evaluate at a node is reported there as an
+// error, since a broken assertion would otherwise pass silently.
+type Assertion struct {
+	// Code is the issue code reported when the expression is true.
+	Code IssueCode `json:"code"`
+	// CEL is the expression to evaluate.
+	CEL string `json:"cel"`
+	// Message is the issue message.
+	Message string `json:"message"`
+	// Severity is the issue severity (default: warning).
+	Severity Severity `json:"severity,omitempty"`
+	// Suggestion is an optional fix suggestion.
+	Suggestion string `json:"suggestion,omitempty"`
+}
+
+// celEnv returns the CEL environment of assertions, created on first use.
+// Numbers compare across int and double, since JSON does not tell them
+// apart (e.g., schema.maximum > 100).
+var celEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("schema", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("path", cel.StringType),
+		cel.CrossTypeNumericComparisons(true),
+	)
+})
+
+// Rule compiles the assertion into a Rule.
+func (a Assertion) Rule() (Rule, error) {
+	c, err := a.compile()
+	if err != nil {
+		return nil, err
+	}
+	info := RuleInfo{Code: a.Code, Severity: c.severity, Profile: ProfileDefault, Description: a.Message}
+	return NewRule(info, func(schema *Schema, path Path) []Issue {
+		return c.check(schema, path, nil)
+	}), nil
+}
+
+// celAssertion is a compiled Assertion.
+type celAssertion struct {
+	Assertion
+	severity Severity
+	program  cel.Program
+}
+
+// compile parses and type-checks the assertion's expression. Literal
+// patterns of matches() are compiled with the program, so an invalid one
+// is a compile error.
+func (a Assertion) compile() (*celAssertion, error) {
+	if a.Code == "" {
+		return nil, errors.New("assertion requires a code")
+	}
+	env, err := celEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+	ast, iss := env.Compile(a.CEL)
+	if err := iss.Err(); err != nil {
+		return nil, fmt.Errorf("assertion %s: %w", a.Code, err)
+	}
+	if t := ast.OutputType(); !t.IsExactType(cel.BoolType) && !t.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("assertion %s: expression has type %s, not bool", a.Code, t)
+	}
+	program, err := env.Program(ast, cel.EvalOptions(cel.OptOptimize))
+	if err != nil {
+		return nil, fmt.Errorf("assertion %s: %w", a.Code, err)
+	}
+	severity := a.Severity
+	if severity == "" {
+		severity = SeverityWarning
+	}
+	return &celAssertion{Assertion: a, severity: severity, program: program}, nil
+}
+
+// check evaluates the assertion at a schema node. views caches the CEL
+// values of the schemas converted so far, if not nil.
+func (a *celAssertion) check(schema *Schema, path Path, views map[*Schema]map[string]any) []Issue {
+	vars := map[string]any{"schema": schemaView(schema, views), "path": path.String()}
+	val, _, err := a.program.Eval(vars)
+	if err != nil {
+		return []Issue{{
+			Code:       a.Code,
+			Severity:   SeverityError,
+			Path:       path,
+			Message:    fmt.Sprintf("Assertion %s failed to evaluate: %v", a.Code, err),
+			Suggestion: "Test optional keywords with has() before selecting them",
+		}}
+	}
+	matched, ok := val.Value().(bool)
+	if !ok {
+		return []Issue{{
+			Code:     a.Code,
+			Severity: SeverityError,
+			Path:     path,
+			Message:  fmt.Sprintf("Assertion %s evaluated to %s, not a bool", a.Code, val.Type().TypeName()),
+		}}
+	}
+	if !matched {
+		return nil
+	}
+	return []Issue{{
+		Code:       a.Code,
+		Severity:   a.severity,
+		Path:       path,
+		Message:    a.Message,
+		Suggestion: a.Suggestion,
+	}}
+}
+
+// lintAssertions evaluates the config assertions at a schema node, caching
+// the CEL values of the schemas in the result for the nodes below it.
+func (l *Linter) lintAssertions(schema *Schema, path Path, result *Result) {
+	if result.celViews == nil {
+		result.celViews = make(map[*Schema]map[string]any)
+	}
+	for _, a := range l.assertions {
+		l.run(result, string(a.Code), func() {
+			result.Issues = append(result.Issues, a.check(schema, path, result.celViews)...)
+		})
+	}
+}
+
+// schemaView converts a schema into the value tree seen by CEL expressions.
+// With a views cache, the values of the schema and its subschemas are
+// converted once and shared by the assertions at every node.
+func schemaView(s *Schema, views map[*Schema]map[string]any) map[string]any {
+	if v, ok := views[s]; ok {
+		return v
+	}
+	v := make(map[string]any)
+	if views != nil {
+		views[s] = v
+	}
+	if s == nil {
+		return v
+	}
+	if s.IsBooleanSchema {
+		v["boolean"] = s.BooleanValue
+		return v
+	}
+
+	setString := func(key, val string) {
+		if val != "" {
+			v[key] = val
+		}
+	}
+	setString("$schema", s.Schema)
+	setString("$id", s.ID)
+	setString("$ref", s.Ref)
+	setString("$anchor", s.Anchor)
+	setString("$dynamicAnchor", s.DynamicAnchor)
+	setString("$dynamicRef", s.DynamicRef)
+	setString("title", s.Title)
+	setString("description", s.Description)
+	setString("format", s.Format)
+	setString("pattern", s.Pattern)
+
+	if len(s.TypeList) > 0 {
+		v["type"] = stringsToAny(s.TypeList)
+	} else if s.Type != "" {
+		v["type"] = s.Type
+	}
+	if s.Properties != nil {
+		props := make(map[string]any, len(s.Properties))
+		for name, prop := range s.Properties {
+			props[name] = schemaView(prop, views)
+		}
+		v["properties"] = props
+	}
+	if s.Required != nil {
+		v["required"] = stringsToAny(s.Required)
+	}
+	if s.AdditionalPropertiesSchema != nil {
+		v["additionalProperties"] = schemaView(s.AdditionalPropertiesSchema, views)
+	} else if s.AdditionalProperties != nil {
+		v["additionalProperties"] = *s.AdditionalProperties
+	}
+	if s.Items != nil {
+		v["items"] = schemaView(s.Items, views)
+	}
+	if s.Contains != nil {
+		v["contains"] = schemaView(s.Contains, views)
+	}
+	for key, list := range map[string][]*Schema{"anyOf": s.AnyOf, "oneOf": s.OneOf, "allOf": s.AllOf} {
+		if list == nil {
+			continue
+		}
+		subs := make([]any, len(list))
+		for i, sub := range list {
+			subs[i] = schemaView(sub, views)
+		}
+		v[key] = subs
+	}
+	if s.Enum != nil {
+		v["enum"] = normalizeCELValue(s.Enum)
+	}
+	if s.Const != nil {
+		v["const"] = normalizeCELValue(s.Const)
+	}
+	if s.Default != nil {
+		v["default"] = normalizeCELValue(s.Default)
+	}
+	if s.Examples != nil {
+		v["examples"] = normalizeCELValue(s.Examples)
+	}
+
+	setInt := func(key string, val *int) {
+		if val != nil {
+			v[key] = int64(*val)
+		}
+	}
+	setInt("minProperties", s.MinProperties)
+	setInt("maxProperties", s.MaxProperties)
+	setInt("minItems", s.MinItems)
+	setInt("maxItems", s.MaxItems)
+	setInt("minContains", s.MinContains)
+	setInt("maxContains", s.MaxContains)
+	setInt("minLength", s.MinLength)
+	setInt("maxLength", s.MaxLength)
+
+	setFloat := func(key string, val *float64) {
+		if val != nil {
+			v[key] = *val
+		}
+	}
+	setFloat("minimum", s.Minimum)
+	setFloat("maximum", s.Maximum)
+	setFloat("exclusiveMinimum", s.ExclusiveMinimum)
+	setFloat("exclusiveMaximum", s.ExclusiveMaximum)
+	setFloat("multipleOf", s.MultipleOf)
+	if s.UniqueItems != nil {
+		v["uniqueItems"] = *s.UniqueItems
+	}
+
+	return v
+}
+
+func stringsToAny(ss []string) []any {
+	out := make([]any, len(ss))
+	for i, s := range ss {
+		out[i] = s
+	}
+	return out
+}
+
+// normalizeCELValue converts decoded JSON values to CEL values,
+// representing whole numbers as int64.
+func normalizeCELValue(v any) any {
+	switch val := v.(type) {
+	case json.Number:
+		if n, err := val.Int64(); err == nil {
+			return n
+		}
+		f, _ := val.Float64()
+		return normalizeCELValue(f)
+	case float64:
+		if val == float64(int64(val)) {
+			return int64(val)
+		}
+		return val
+	case []any:
+		out := make([]any, len(val))
+		for i, e := range val {
+			out[i] = normalizeCELValue(e)
+		}
+		return out
+	case map[string]any:
+		out := make(map[string]any, len(val))
+		for k, e := range val {
+			out[k] = normalizeCELValue(e)
+		}
+		return out
+	default:
+		return v
+	}
+}
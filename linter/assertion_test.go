@@ -0,0 +1,238 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestCELEval(t *testing.T) {
+	schema := `{
+		"$ref": "#/$defs/Base",
+		"type": "object",
+		"title": "Person",
+		"properties": {
+			"name": {"type": "string", "maxLength": 50},
+			"tags": {"type": "array", "items": {"type": "string"}}
+		},
+		"enum": [1, 2, 3]
+	}`
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	vars := map[string]any{"schema": schemaView(s, nil), "path": "$/$defs/Person"}
+
+	tests := []struct {
+		expr string
+		want any
+	}{
+		{`has(schema.properties) && !has(schema.required)`, true},
+		{`has(schema.required)`, false},
+		{`"$ref" in schema`, true},
+		{`schema["$ref"].startsWith("#/$defs/")`, true},
+		{`size(schema.properties) == 2`, true},
+		{`schema.properties.size() > 1`, true},
+		{`'name' in schema.properties`, true},
+		{`'age' in schema.properties`, false},
+		{`schema.properties.name.maxLength >= 50`, true},
+		{`schema.properties.tags.items.type == "string"`, true},
+		{`2 in schema.enum`, true},
+		{`schema.type in ["object", "array"]`, true},
+		{`schema.title.matches("^P[a-z]+$")`, true},
+		{`path.endsWith("/Person")`, true},
+		{`schema.required.size() > 0 || true`, true},
+		{`schema.properties.exists(name, name == "tags")`, true},
+		{`schema.properties.name.maxLength < 50.5`, true},
+	}
+
+	for _, tt := range tests {
+		a, err := Assertion{Code: "test", CEL: tt.expr}.compile()
+		if err != nil {
+			t.Errorf("compile(%q) error: %v", tt.expr, err)
+			continue
+		}
+		got, _, err := a.program.Eval(vars)
+		if err != nil {
+			t.Errorf("Eval(%q) error: %v", tt.expr, err)
+			continue
+		}
+		if got.Value() != tt.want {
+			t.Errorf("Eval(%q) = %v, want %v", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestLintAssertions(t *testing.T) {
+	config := DefaultConfig()
+	config.Assertions = []Assertion{{
+		Code:     "object-without-required",
+		CEL:      `has(schema.properties) && !has(schema.required)`,
+		Message:  "Object declares properties but none are required",
+		Severity: SeverityError,
+	}}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Validate() error: %v", err)
+	}
+
+	schema := `{
+		"$defs": {
+			"Open": {"type": "object", "properties": {"name": {"type": "string"}}},
+			"Strict": {"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]}
+		}
+	}`
+
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	if len(result.Issues) != 1 || result.Issues[0].Path.String() != "$/$defs/Open" || result.Issues[0].Severity != SeverityError {
+		t.Errorf("Expected one error at $/$defs/Open, got: %v", result.Issues)
+	}
+
+	config.Assertions = append(config.Assertions, Assertion{Code: "broken", CEL: "has("})
+	if err := config.Validate(); err == nil {
+		t.Error("Expected Validate() to reject an invalid expression")
+	}
+}
+
+func TestCELCompileErrors(t *testing.T) {
+	tests := []struct {
+		expr string
+		want string
+	}{
+		{``, "Syntax error"},
+		{`has(schema.properties`, "Syntax error"},
+		{`schema.title.lower() == "a"`, "undeclared reference to 'lower'"},
+		{`len(schema.required) > 0`, "undeclared reference to 'len'"},
+		{`has(schema.title) && title == "A"`, "undeclared reference to 'title'"},
+		{`path.endsWith(1)`, "found no matching overload for 'endsWith'"},
+		{`size(path)`, "expression has type int, not bool"},
+		{`schema.title.matches("[a-z")`, "missing closing ]"},
+	}
+
+	for _, tt := range tests {
+		_, err := Assertion{Code: "test", CEL: tt.expr}.compile()
+		if err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("compile(%q) error = %v, want %q", tt.expr, err, tt.want)
+		}
+	}
+}
+
+func TestCELDynamicPattern(t *testing.T) {
+	// Patterns computed at evaluation time are compiled then, and an
+	// invalid one is an evaluation error.
+	a, err := Assertion{Code: "test", CEL: `path.matches(schema.pattern)`}.compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	issues := a.check(&Schema{Pattern: "("}, rootPath, nil)
+	if len(issues) != 1 || issues[0].Severity != SeverityError || !strings.Contains(issues[0].Message, "failed to evaluate") {
+		t.Errorf("check() = %v, want an evaluation error", issues)
+	}
+}
+
+func TestSchemaViewCache(t *testing.T) {
+	s, err := ParseSchema([]byte(`{"type": "object", "properties": {"name": {"type": "string"}}, "anyOf": [{"required": ["name"]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	views := make(map[*Schema]map[string]any)
+	root := schemaView(s, views)
+	if len(views) != 3 {
+		t.Errorf("cached %d views, want 3", len(views))
+	}
+	same := func(a, b map[string]any) bool {
+		return reflect.ValueOf(a).UnsafePointer() == reflect.ValueOf(b).UnsafePointer()
+	}
+	if !same(schemaView(s, views), root) {
+		t.Error("root view not reused")
+	}
+	name := root["properties"].(map[string]any)["name"].(map[string]any)
+	if !same(schemaView(s.Properties["name"], views), name) {
+		t.Error("property view not reused")
+	}
+	if same(schemaView(s, nil), root) {
+		t.Error("view without a cache reused")
+	}
+}
+
+func TestLintAssertionErrors(t *testing.T) {
+	schema := `{"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]}`
+
+	tests := []struct {
+		name      string
+		assertion Assertion
+		want      []Issue
+	}{
+		{
+			name:      "evaluation error",
+			assertion: Assertion{Code: "few-required", CEL: `schema.required.size() < 2`, Message: "few required"},
+			want: []Issue{
+				{Code: "few-required", Severity: SeverityError, Path: ParsePath("$/properties/name")},
+				{Code: "few-required", Severity: SeverityWarning, Path: rootPath},
+			},
+		},
+		{
+			name:      "non-bool result",
+			assertion: Assertion{Code: "typed", CEL: `schema.type`},
+			want: []Issue{
+				{Code: "typed", Severity: SeverityError, Path: ParsePath("$/properties/name")},
+				{Code: "typed", Severity: SeverityError, Path: rootPath},
+			},
+		},
+		{
+			name:      "compile error",
+			assertion: Assertion{Code: "lower", CEL: `schema.title.lower() == "a"`},
+			want:      []Issue{{Code: CodeInvalidAssertion, Severity: SeverityError, Path: rootPath}},
+		},
+		{
+			name:      "missing code",
+			assertion: Assertion{CEL: `has(schema.title)`},
+			want:      []Issue{{Code: CodeInvalidAssertion, Severity: SeverityError, Path: rootPath}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Assertions = []Assertion{tt.assertion}
+			result, err := New(config).Lint([]byte(schema))
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []Issue
+			for _, issue := range result.Issues {
+				got = append(got, Issue{Code: issue.Code, Severity: issue.Severity, Path: issue.Path})
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("issues = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAssertionConfigErrors(t *testing.T) {
+	config := DefaultConfig()
+	config.Assertions = []Assertion{{Code: "lower", CEL: `schema.title.lower() == "a"`}}
+
+	if err := config.Validate(); err == nil || !strings.Contains(err.Error(), "undeclared reference to 'lower'") {
+		t.Errorf("Validate() error = %v, want undeclared reference", err)
+	}
+	if _, err := NewLinter(WithConfig(config)); err == nil {
+		t.Error("NewLinter() expected error")
+	}
+
+	path := filepath.Join(t.TempDir(), "schemakit.json")
+	data := `{"assertions": [{"code": "bad-pattern", "cel": "schema.title.matches(\"(\")"}]}`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadConfig(path); err == nil || !strings.Contains(err.Error(), "error parsing regexp") {
+		t.Errorf("LoadConfig() error = %v, want invalid pattern", err)
+	}
+}
@@ -0,0 +1,176 @@
+package linter
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// avroName matches valid Avro names, used for record fields and enum symbols.
+var avroName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
+// CheckAvro reports constructs that prevent the schema from being
+// represented as an Avro record: objects mixing fixed properties with an
+// open map, unions that are neither a single type with null nor named
+// records, values of any type, allOf, and names Avro does not accept.
+// Local $refs are resolved to classify union variants.
+func CheckAvro(schema *Schema) []Issue {
+	c := &avroChecker{doc: schema, issues: []Issue{}}
+	if !isDefinitionBundle(schema) {
+		c.check(schema, rootPath, false)
+	}
+	for _, name := range sortedKeys(schema.Defs) {
+		c.check(schema.Defs[name], rootPath.Child("$defs", name), false)
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		c.check(schema.Definitions[name], rootPath.Child("definitions", name), false)
+	}
+	return c.issues
+}
+
+type avroChecker struct {
+	doc    *Schema
+	issues []Issue
+}
+
+func (c *avroChecker) report(code IssueCode, severity Severity, path Path, message, suggestion string) {
+	c.issues = append(c.issues, Issue{
+		Code:       code,
+		Severity:   severity,
+		Path:       path,
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
+
+// check checks a schema and its subschemas. allOfPart is true for the parts
+// of an allOf, which are fragments rather than complete types.
+func (c *avroChecker) check(schema *Schema, path Path, allOfPart bool) {
+	if schema == nil || schema.IsRef() {
+		return
+	}
+
+	if !allOfPart && isUntyped(schema) {
+		if schema.IsBooleanSchema || !schema.HasType() {
+			c.report(CodeAvroUntyped, SeverityError, path,
+				"Schema accepts any value, which has no Avro type",
+				"Declare a type")
+		} else {
+			c.report(CodeAvroUntyped, SeverityError, path,
+				"Object accepts any properties, which is an Avro map without a value type",
+				"Declare properties for a record, or an additionalProperties schema for a map")
+		}
+	}
+
+	if len(schema.Properties) > 0 && schema.AdditionalProperties != nil && *schema.AdditionalProperties {
+		c.report(CodeAvroOpenMap, SeverityError, path.Child("additionalProperties"),
+			"Object mixes fixed properties with additionalProperties; an Avro record has a fixed set of fields",
+			"Move the open-ended entries into a map-typed property, or set additionalProperties: false")
+	}
+
+	c.checkUnion(schema, path)
+
+	if len(schema.AllOf) > 0 {
+		c.report(CodeAvroAllOf, SeverityError, path.Child("allOf"),
+			"allOf has no Avro equivalent",
+			"Flatten the parts into a single object schema")
+	}
+
+	c.checkEnum(schema, path)
+
+	for _, name := range sortedKeys(schema.Properties) {
+		propPath := path.Child("properties", name)
+		if !avroName.MatchString(name) {
+			c.report(CodeAvroInvalidName, SeverityError, propPath,
+				fmt.Sprintf("Property name %q is not a valid Avro field name", name),
+				"Rename the property to match [A-Za-z_][A-Za-z0-9_]*")
+		}
+		c.check(schema.Properties[name], propPath, false)
+	}
+	c.check(schema.Items, path.Child("items"), false)
+	c.check(schema.AdditionalPropertiesSchema, path.Child("additionalProperties"), false)
+	for i, v := range schema.AnyOf {
+		c.check(v, path.Child("anyOf").Index(i), false)
+	}
+	for i, v := range schema.OneOf {
+		c.check(v, path.Child("oneOf").Index(i), false)
+	}
+	for i, v := range schema.AllOf {
+		c.check(v, path.Child("allOf").Index(i), true)
+	}
+}
+
+// checkUnion reports type arrays and anyOf/oneOf unions with more than one
+// non-null variant, unless every variant is a record.
+func (c *avroChecker) checkUnion(schema *Schema, path Path) {
+	var kinds []string
+	for _, t := range schema.TypeList {
+		if t != "null" {
+			kinds = append(kinds, t)
+		}
+	}
+	if len(kinds) > 1 {
+		c.report(CodeAvroUnion, SeverityError, path.Child("type"),
+			fmt.Sprintf("Type union of %s cannot be represented in Avro", strings.Join(kinds, ", ")),
+			"Use a single type, optionally with null")
+	}
+
+	if !schema.IsUnion() {
+		return
+	}
+	keyword := "anyOf"
+	if len(schema.AnyOf) == 0 {
+		keyword = "oneOf"
+	}
+	kinds = kinds[:0]
+	records := true
+	for _, v := range schema.GetUnionVariants() {
+		if v == nil {
+			continue
+		}
+		target := v
+		if ref := v.RefTarget(); ref != "" {
+			resolved, _, ok := resolveLocalRef(c.doc, rootPath, ref)
+			if !ok {
+				// An unresolved $ref may name a record
+				kinds = append(kinds, ref)
+				continue
+			}
+			target = resolved
+		}
+		kind := schemaKind(target)
+		if kind == "null" {
+			continue
+		}
+		if kind == "" {
+			kind = "any"
+		}
+		kinds = append(kinds, kind)
+		records = records && kind == "object"
+	}
+	if len(kinds) > 1 && !records {
+		c.report(CodeAvroUnion, SeverityError, path.Child(keyword),
+			fmt.Sprintf("Union of %s cannot be represented in Avro; unions must be a single type with null, or named records", strings.Join(kinds, ", ")),
+			"Make every variant an object schema (a named record), or use a single type")
+	}
+}
+
+// checkEnum reports string enums with values that are not valid Avro enum
+// symbols.
+func (c *avroChecker) checkEnum(schema *Schema, path Path) {
+	var invalid []string
+	for _, v := range schema.Enum {
+		s, ok := v.(string)
+		if !ok {
+			return
+		}
+		if !avroName.MatchString(s) {
+			invalid = append(invalid, fmt.Sprintf("%q", s))
+		}
+	}
+	if len(invalid) > 0 {
+		c.report(CodeAvroEnumSymbol, SeverityWarning, path.Child("enum"),
+			fmt.Sprintf("Enum values %s are not valid Avro enum symbols, so the enum can only be a plain string", strings.Join(invalid, ", ")),
+			"Use values matching [A-Za-z_][A-Za-z0-9_]* to keep it an Avro enum")
+	}
+}
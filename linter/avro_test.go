@@ -0,0 +1,65 @@
+package linter
+
+import "testing"
+
+func TestCheckAvro(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"id": {"type": "string"},
+			"note": {"type": ["string", "null"]},
+			"amount": {"type": ["string", "number"]},
+			"payload": {},
+			"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+			"attrs": {"type": "object"},
+			"event": {"oneOf": [{"$ref": "#/$defs/Created"}, {"$ref": "#/$defs/Deleted"}]},
+			"value": {"anyOf": [{"type": "string"}, {"type": "integer"}, {"type": "null"}]},
+			"content-type": {"type": "string", "enum": ["text/plain", "json"]},
+			"mixed": {
+				"type": "object",
+				"properties": {"a": {"type": "string"}},
+				"additionalProperties": true
+			},
+			"merged": {"allOf": [{"$ref": "#/$defs/Created"}, {"required": ["at"]}]}
+		},
+		"$defs": {
+			"Created": {"type": "object", "properties": {"at": {"type": "string"}}},
+			"Deleted": {"type": "object", "properties": {"at": {"type": "string"}}}
+		}
+	}`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+
+	want := map[string]IssueCode{
+		"$/properties/amount/type":                CodeAvroUnion,
+		"$/properties/payload":                    CodeAvroUntyped,
+		"$/properties/attrs":                      CodeAvroUntyped,
+		"$/properties/value/anyOf":                CodeAvroUnion,
+		"$/properties/content-type":               CodeAvroInvalidName,
+		"$/properties/content-type/enum":          CodeAvroEnumSymbol,
+		"$/properties/mixed/additionalProperties": CodeAvroOpenMap,
+		"$/properties/merged/allOf":               CodeAvroAllOf,
+	}
+	issues := CheckAvro(s)
+	if len(issues) != len(want) {
+		t.Errorf("Expected %d issues, got %d: %v", len(want), len(issues), issues)
+	}
+	for _, issue := range issues {
+		if code, ok := want[issue.Path.String()]; !ok || issue.Code != code {
+			t.Errorf("Unexpected issue: %s", issue)
+		}
+	}
+}
+
+func TestCheckAvroDefinitionBundle(t *testing.T) {
+	s, err := ParseSchema([]byte(`{"$defs": {"Pet": {"type": "object", "properties": {"name": {"type": "string"}}}}}`))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	if issues := CheckAvro(s); len(issues) != 0 {
+		t.Errorf("Expected no issues, got: %v", issues)
+	}
+}
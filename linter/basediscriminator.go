@@ -0,0 +1,118 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+)
+
+// baseDiscriminator is a discriminator field declared by a base schema
+// that every variant of a union extends with allOf, such as a Pet base
+// with a required "kind" that the Cat and Dog variants narrow with const.
+type baseDiscriminator struct {
+	field string
+	// base is the path of the base schema
+	base Path
+}
+
+// baseDiscriminator returns the discriminator of a union whose variants
+// all extend a base schema with allOf $refs, or nil. The field is the
+// first of DiscriminatorFields the base declares, or else of the string
+// properties it requires, that a variant narrows with a const. $refs are
+// resolved in doc, and nil doc finds none.
+func (l *Linter) baseDiscriminator(doc *Schema, root Path, variants []*Schema) *baseDiscriminator {
+	if doc == nil || len(variants) < 2 {
+		return nil
+	}
+	var shared []Path
+	bases := make(map[string]*Schema)
+	for i, v := range variants {
+		if v != nil && v.IsRef() {
+			v, _, _ = l.resolveRef(doc, root, v.Ref)
+		}
+		if v == nil {
+			return nil
+		}
+		var paths []Path
+		for _, member := range v.AllOf {
+			if member == nil || !member.IsRef() {
+				continue
+			}
+			if target, path, ok := l.resolveRef(doc, root, member.Ref); ok && target != nil {
+				paths = append(paths, path)
+				bases[path.String()] = target
+			}
+		}
+		if i == 0 {
+			shared = paths
+		} else {
+			shared = slices.DeleteFunc(shared, func(path Path) bool { return !slices.ContainsFunc(paths, path.Equal) })
+		}
+	}
+
+	for _, path := range shared {
+		base := bases[path.String()]
+		var candidates []string
+		for _, field := range l.config.DiscriminatorFields {
+			if base.Properties[field] != nil {
+				candidates = append(candidates, field)
+			}
+		}
+		for _, field := range base.Required {
+			if isStringProperty(base.Properties[field]) && !slices.Contains(candidates, field) {
+				candidates = append(candidates, field)
+			}
+		}
+		for _, field := range candidates {
+			for _, v := range variants {
+				if _, ok := l.variantConst(doc, root, v, field); ok {
+					return &baseDiscriminator{field: field, base: path}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// variantConst returns the const a union variant, or a schema it extends,
+// narrows a discriminator field to.
+func (l *Linter) variantConst(doc *Schema, root Path, v *Schema, field string) (string, bool) {
+	value, ok := "", false
+	l.findProperty(doc, root, v, field, make(map[*Schema]bool), func(p *Schema) bool {
+		value, ok = discriminatorConst(p)
+		return ok
+	})
+	return value, ok
+}
+
+// verifyBaseDiscriminator checks that each variant narrows the field of
+// the base discriminator to a const of its own.
+func (l *Linter) verifyBaseDiscriminator(doc *Schema, root Path, variants []*Schema, disc *baseDiscriminator, path Path, result *Result) {
+	name := disc.base.String()
+	if len(disc.base.Segments) > 0 {
+		name = disc.base.Last()
+	}
+	seen := make(map[string]bool)
+	for i, v := range variants {
+		value, ok := l.variantConst(doc, root, v, disc.field)
+		if !ok {
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeMissingConst,
+				Severity:   SeverityError,
+				Path:       path.Index(i),
+				Message:    fmt.Sprintf("Variant does not narrow discriminator '%s' of its base '%s' with a const", disc.field, name),
+				Suggestion: fmt.Sprintf("Add '%s' with a unique const value to this variant, alongside the allOf $ref to '%s'", disc.field, name),
+			})
+			continue
+		}
+		if seen[value] {
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeDuplicateConstValue,
+				Severity:   SeverityError,
+				Path:       path.Index(i),
+				Message:    fmt.Sprintf("Duplicate discriminator value '%s'", value),
+				Suggestion: "Each variant must have a unique const value for the discriminator",
+			})
+		}
+		seen[value] = true
+	}
+}
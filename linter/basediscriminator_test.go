@@ -0,0 +1,62 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBaseDiscriminator(t *testing.T) {
+	base := `"Pet": {"type": "object", "properties": {"kind": {"type": "string"}, "name": {"type": "string"}}, "required": ["kind", "name"]}`
+	codes := func(schema string) map[IssueCode][]Issue {
+		result, err := NewWithDefaults().Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		found := make(map[IssueCode][]Issue)
+		for _, issue := range result.Issues {
+			found[issue.Code] = append(found[issue.Code], issue)
+		}
+		return found
+	}
+
+	// Inline variants narrowing the base field
+	inline := `{"oneOf": [
+		{"allOf": [{"$ref": "#/$defs/Pet"}, {"properties": {"kind": {"const": "cat"}}}]},
+		{"allOf": [{"$ref": "#/$defs/Pet"}], "properties": {"kind": {"const": "dog"}}}
+	], "$defs": {` + base + `}}`
+	got := codes(inline)
+	for _, code := range []IssueCode{CodeUnionNoDiscriminator, CodeMissingConst, CodeDuplicateConstValue} {
+		if len(got[code]) != 0 {
+			t.Errorf("Expected no %s for variants narrowing the base, got %v", code, got[code])
+		}
+	}
+
+	// Definition variants, one not narrowing the field
+	refs := `{"oneOf": [{"$ref": "#/$defs/Cat"}, {"$ref": "#/$defs/Dog"}], "$defs": {` + base + `,
+		"Cat": {"allOf": [{"$ref": "#/$defs/Pet"}, {"properties": {"kind": {"const": "cat"}}}]},
+		"Dog": {"allOf": [{"$ref": "#/$defs/Pet"}]}
+	}}`
+	got = codes(refs)
+	if len(got[CodeUnresolvedUnion]) != 0 {
+		t.Errorf("Expected the $ref variants to be resolved, got %v", got[CodeUnresolvedUnion])
+	}
+	if missing := got[CodeMissingConst]; len(missing) != 1 || missing[0].Path.String() != "$/oneOf/1" ||
+		!strings.Contains(missing[0].Message, "discriminator 'kind' of its base 'Pet'") {
+		t.Errorf("Expected the Dog variant to be reported, got %v", missing)
+	}
+
+	// Duplicate values
+	duplicate := strings.Replace(inline, `"dog"`, `"cat"`, 1)
+	if dups := codes(duplicate)[CodeDuplicateConstValue]; len(dups) != 1 || dups[0].Path.String() != "$/oneOf/1" {
+		t.Errorf("Expected a duplicate discriminator value, got %v", dups)
+	}
+
+	// A base whose field no variant narrows is not a discriminator
+	unnarrowed := `{"oneOf": [
+		{"allOf": [{"$ref": "#/$defs/Pet"}], "properties": {"meow": {"type": "boolean"}}},
+		{"allOf": [{"$ref": "#/$defs/Pet"}], "properties": {"bark": {"type": "boolean"}}}
+	], "$defs": {` + base + `}}`
+	if got := codes(unnarrowed); len(got[CodeUnionNoDiscriminator]) != 1 {
+		t.Errorf("Expected no discriminator, got %v", got)
+	}
+}
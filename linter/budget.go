@@ -0,0 +1,44 @@
+package linter
+
+import "fmt"
+
+// lintBudgets reports documents with more definitions than
+// MaxDefinitions and objects with more properties than
+// MaxObjectProperties, so that monolithic schemas are decomposed.
+func (l *Linter) lintBudgets(schema *Schema, root Path, result *Result) {
+	if limit := l.config.MaxDefinitions; limit > 0 {
+		if n := len(schema.Defs) + len(schema.Definitions); n > limit {
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeTooManyDefinitions,
+				Severity:   SeverityError,
+				Path:       root,
+				Message:    fmt.Sprintf("Document has %d definitions (budget: %d)", n, limit),
+				Suggestion: "Move groups of related definitions into separate schema files",
+			})
+			l.lintDefinitionSplits(schema, root, result)
+		}
+	}
+
+	limit := l.config.MaxObjectProperties
+	if limit <= 0 {
+		return
+	}
+	check := func(s *Schema, path Path, _ bool) {
+		if n := len(s.Properties); n > limit {
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeTooManyProperties,
+				Severity:   SeverityError,
+				Path:       path,
+				Message:    fmt.Sprintf("Object has %d properties (budget: %d)", n, limit),
+				Suggestion: "Group related properties into nested object definitions",
+			})
+		}
+	}
+	walkSchema(schema, root, false, check)
+	for _, name := range sortedKeys(schema.Defs) {
+		walkSchema(schema.Defs[name], root.Child("$defs", name), false, check)
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		walkSchema(schema.Definitions[name], root.Child("definitions", name), false, check)
+	}
+}
@@ -0,0 +1,46 @@
+package linter
+
+import (
+	"testing"
+)
+
+func TestBudgets(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"A": {"type": "object", "properties": {"a": {"type": "string"}, "b": {"type": "string"}, "c": {"type": "string"}}},
+			"B": {"type": "object", "properties": {"a": {"type": "string"}}},
+			"C": {"type": "string"}
+		}
+	}`
+
+	config := DefaultConfig()
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if len(result.Issues) != 0 {
+		t.Errorf("Expected no issues without budgets, got: %v", result.Issues)
+	}
+
+	config.MaxDefinitions = 2
+	config.MaxObjectProperties = 2
+	config.MaxSchemaBytes = 100
+	result, err = New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	want := map[IssueCode]string{
+		CodeSchemaTooLarge:     "$",
+		CodeTooManyDefinitions: "$",
+		CodeTooManyProperties:  "$/$defs/A",
+	}
+	if len(result.Issues) != len(want) {
+		t.Fatalf("Expected %d issues, got: %v", len(want), result.Issues)
+	}
+	for _, issue := range result.Issues {
+		if path, ok := want[issue.Code]; !ok || issue.Path.String() != path || issue.Severity != SeverityError {
+			t.Errorf("Unexpected issue: %+v", issue)
+		}
+	}
+}
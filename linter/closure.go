@@ -0,0 +1,173 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// lintDiscriminatorClosure reports unions whose discriminator declares an
+// enum of allowed values, on the union schema or on a base definition the
+// variants extend with allOf, when the variants' const values do not match
+// the enum exactly: an enum value without a variant cannot be decoded, and
+// a variant outside the enum is not a valid instance. $refs are resolved,
+// so variants may be definitions.
+func (l *Linter) lintDiscriminatorClosure(schema *Schema, root Path, result *Result) {
+	check := func(s *Schema, path Path, _ bool) {
+		if len(s.OneOf) > 1 {
+			l.checkDiscriminatorClosure(schema, root, s, s.OneOf, path.Child("oneOf"), result)
+		}
+		if len(s.AnyOf) > 1 {
+			l.checkDiscriminatorClosure(schema, root, s, s.AnyOf, path.Child("anyOf"), result)
+		}
+	}
+	walkSchema(schema, root, false, check)
+	for _, name := range sortedKeys(schema.Defs) {
+		walkSchema(schema.Defs[name], root.Child("$defs", name), false, check)
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		walkSchema(schema.Definitions[name], root.Child("definitions", name), false, check)
+	}
+}
+
+// checkDiscriminatorClosure checks one union against the enum of the first
+// configured discriminator field that every variant sets to a const.
+func (l *Linter) checkDiscriminatorClosure(doc *Schema, root Path, union *Schema, variants []*Schema, path Path, result *Result) {
+	for _, field := range l.config.DiscriminatorFields {
+		values := make([]string, 0, len(variants))
+		for _, v := range variants {
+			value, ok := "", false
+			l.findProperty(doc, root, v, field, make(map[*Schema]bool), func(p *Schema) bool {
+				value, ok = discriminatorConst(p)
+				return ok
+			})
+			if !ok {
+				values = nil
+				break
+			}
+			values = append(values, value)
+		}
+		if values == nil {
+			continue
+		}
+
+		enum := l.discriminatorEnum(doc, root, union.Properties[field])
+		for _, v := range variants {
+			for _, base := range l.variantBases(doc, root, v) {
+				if enum == nil {
+					enum = l.discriminatorEnum(doc, root, base.Properties[field])
+				}
+			}
+		}
+		if enum == nil {
+			return
+		}
+
+		var missing, extra []string
+		for _, value := range enum {
+			if !slices.Contains(values, value) {
+				missing = append(missing, value)
+			}
+		}
+		for _, value := range values {
+			if !slices.Contains(enum, value) && !slices.Contains(extra, value) {
+				extra = append(extra, value)
+			}
+		}
+		if len(missing) == 0 && len(extra) == 0 {
+			return
+		}
+
+		var problems []string
+		if len(missing) > 0 {
+			problems = append(problems, fmt.Sprintf("no variant for %s", strings.Join(missing, ", ")))
+		}
+		if len(extra) > 0 {
+			problems = append(problems, fmt.Sprintf("variants for %s, which the enum does not allow", strings.Join(extra, ", ")))
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:     CodeDiscriminatorEnumMismatch,
+			Severity: SeverityError,
+			Path:     path,
+			Message: fmt.Sprintf("Discriminator '%s' declares enum [%s], but the union has %s",
+				field, strings.Join(enum, ", "), strings.Join(problems, " and ")),
+			Suggestion: fmt.Sprintf("Add or remove variants, or update the '%s' enum, so that each enum value has exactly one variant", field),
+		})
+		return
+	}
+}
+
+// findProperty calls match with the field's property in s, then in the
+// schemas s extends with allOf, resolving $refs, until match returns true.
+func (l *Linter) findProperty(doc *Schema, root Path, s *Schema, field string, seen map[*Schema]bool, match func(*Schema) bool) bool {
+	if s == nil || seen[s] {
+		return false
+	}
+	seen[s] = true
+	if s.IsRef() {
+		target, _, ok := l.resolveRef(doc, root, s.Ref)
+		return ok && l.findProperty(doc, root, target, field, seen, match)
+	}
+	if p := s.Properties[field]; p != nil && match(p) {
+		return true
+	}
+	for _, member := range s.AllOf {
+		if l.findProperty(doc, root, member, field, seen, match) {
+			return true
+		}
+	}
+	return false
+}
+
+// variantBases returns the schemas a union variant extends with allOf,
+// with $refs resolved.
+func (l *Linter) variantBases(doc *Schema, root Path, v *Schema) []*Schema {
+	if v != nil && v.IsRef() {
+		v, _, _ = l.resolveRef(doc, root, v.Ref)
+	}
+	if v == nil {
+		return nil
+	}
+	bases := make([]*Schema, 0, len(v.AllOf))
+	for _, member := range v.AllOf {
+		if member != nil && member.IsRef() {
+			member, _, _ = l.resolveRef(doc, root, member.Ref)
+		}
+		if member != nil {
+			bases = append(bases, member)
+		}
+	}
+	return bases
+}
+
+// discriminatorConst returns the string const of a discriminator property,
+// or its only enum value.
+func discriminatorConst(p *Schema) (string, bool) {
+	if s, ok := p.Const.(string); ok {
+		return s, true
+	}
+	if len(p.Enum) == 1 {
+		s, ok := p.Enum[0].(string)
+		return s, ok
+	}
+	return "", false
+}
+
+// discriminatorEnum returns the string values of a discriminator property
+// that declares an enum of two or more values, following a $ref to a shared
+// enum definition, or nil.
+func (l *Linter) discriminatorEnum(doc *Schema, root Path, p *Schema) []string {
+	if p != nil && p.IsRef() {
+		p, _, _ = l.resolveRef(doc, root, p.Ref)
+	}
+	if p == nil || len(p.Enum) < 2 {
+		return nil
+	}
+	values := make([]string, 0, len(p.Enum))
+	for _, v := range p.Enum {
+		if s, ok := v.(string); ok {
+			values = append(values, s)
+		}
+	}
+	return values
+}
@@ -0,0 +1,78 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintDiscriminatorClosure(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   []string
+	}{
+		{
+			name: "base definition enum with a missing and an extra variant",
+			schema: `{
+				"$defs": {
+					"Kind": {"type": "string", "enum": ["circle", "square", "triangle"]},
+					"Base": {"type": "object", "properties": {"kind": {"$ref": "#/$defs/Kind"}}, "required": ["kind"]},
+					"Circle": {"allOf": [{"$ref": "#/$defs/Base"}, {"properties": {"kind": {"const": "circle"}}}]},
+					"Square": {"allOf": [{"$ref": "#/$defs/Base"}, {"properties": {"kind": {"const": "square"}}}]},
+					"Hexagon": {"allOf": [{"$ref": "#/$defs/Base"}, {"properties": {"kind": {"const": "hexagon"}}}]},
+					"Shape": {"oneOf": [{"$ref": "#/$defs/Circle"}, {"$ref": "#/$defs/Square"}, {"$ref": "#/$defs/Hexagon"}]}
+				}
+			}`,
+			want: []string{"$/$defs/Shape/oneOf", "no variant for triangle", "variants for hexagon"},
+		},
+		{
+			name: "union schema enum matching the variants",
+			schema: `{
+				"type": "object",
+				"properties": {"type": {"type": "string", "enum": ["a", "b"]}},
+				"oneOf": [
+					{"properties": {"type": {"const": "a"}}},
+					{"properties": {"type": {"const": "b"}}}
+				]
+			}`,
+		},
+		{
+			name: "no enum",
+			schema: `{
+				"oneOf": [
+					{"properties": {"type": {"const": "a"}}},
+					{"properties": {"type": {"const": "b"}}}
+				]
+			}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewWithDefaults().Lint([]byte(tt.schema))
+			if err != nil {
+				t.Fatalf("Failed to lint: %v", err)
+			}
+			var found []Issue
+			for _, issue := range result.Issues {
+				if issue.Code == CodeDiscriminatorEnumMismatch {
+					found = append(found, issue)
+				}
+			}
+			if tt.want == nil {
+				if len(found) != 0 {
+					t.Errorf("Did not expect discriminator-enum-mismatch, got %v", found)
+				}
+				return
+			}
+			if len(found) != 1 || found[0].Path.String() != tt.want[0] {
+				t.Fatalf("Expected one discriminator-enum-mismatch at %s, got %v", tt.want[0], found)
+			}
+			for _, part := range tt.want[1:] {
+				if !strings.Contains(found[0].Message, part) {
+					t.Errorf("Expected message to contain %q, got %q", part, found[0].Message)
+				}
+			}
+		})
+	}
+}
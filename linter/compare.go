@@ -0,0 +1,108 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Comparison is the difference between a previous lint result and the
+// current one for the same schema.
+type Comparison struct {
+	SchemaPath string `json:"schema_path"`
+	// New are issues in the current result that were not reported before.
+	New []Issue `json:"new"`
+	// Fixed are issues in the previous result that are no longer reported.
+	Fixed []Issue `json:"fixed"`
+	// Persisting are issues reported in both results, as currently reported.
+	Persisting []Issue `json:"persisting"`
+}
+
+// issueKey identifies an issue across runs. Messages and severities are not
+// part of the key, so rewording a rule or changing its severity does not
+// turn existing findings into new ones.
+type issueKey struct {
+	code IssueCode
+	path string
+}
+
+// Compare classifies the issues of the current result as new or persisting
+// relative to the previous result, and reports previous issues that are no
+// longer present as fixed. Issues are matched by code and path; repeated
+// issues at the same location are matched by count.
+func Compare(previous, current Result) Comparison {
+	c := Comparison{
+		SchemaPath: current.SchemaPath,
+		New:        []Issue{},
+		Fixed:      []Issue{},
+		Persisting: []Issue{},
+	}
+
+	counts := make(map[issueKey]int, len(previous.Issues))
+	for _, issue := range previous.Issues {
+		counts[issueKey{issue.Code, issue.Path.String()}]++
+	}
+	for _, issue := range current.Issues {
+		key := issueKey{issue.Code, issue.Path.String()}
+		if counts[key] > 0 {
+			counts[key]--
+			c.Persisting = append(c.Persisting, issue)
+		} else {
+			c.New = append(c.New, issue)
+		}
+	}
+	for _, issue := range previous.Issues {
+		key := issueKey{issue.Code, issue.Path.String()}
+		if counts[key] > 0 {
+			counts[key]--
+			c.Fixed = append(c.Fixed, issue)
+		}
+	}
+	return c
+}
+
+// NewResult returns the new issues as a result, for exit-code policies and
+// output formats that consider only regressions. Persisting issues are
+// recorded as suppressed by the baseline.
+func (c Comparison) NewResult() Result {
+	r := Result{SchemaPath: c.SchemaPath, Issues: c.New}
+	for _, issue := range c.Persisting {
+		r.Suppressed = append(r.Suppressed, Suppression{Issue: issue, Source: SuppressedBaseline})
+	}
+	return r
+}
+
+// JSON returns the comparison as JSON.
+func (c Comparison) JSON() ([]byte, error) {
+	return json.MarshalIndent(c, "", "  ")
+}
+
+// String returns a human-readable summary of new, fixed, and persisting
+// issues.
+func (c Comparison) String() string {
+	var sb strings.Builder
+	sections := []struct {
+		title  string
+		issues []Issue
+	}{
+		{"New", c.New},
+		{"Fixed", c.Fixed},
+		{"Persisting", c.Persisting},
+	}
+	for _, section := range sections {
+		if len(section.issues) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "## %s (%d)\n", section.title, len(section.issues))
+		for _, issue := range section.issues {
+			sb.WriteString(issue.String())
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+
+	added := c.NewResult()
+	fmt.Fprintf(&sb, "Summary: %d new (%d error(s), %d warning(s)), %d fixed, %d persisting\n",
+		len(c.New), added.ErrorCount(), added.WarningCount(), len(c.Fixed), len(c.Persisting))
+	return sb.String()
+}
@@ -0,0 +1,70 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestCompare(t *testing.T) {
+	previous := Result{
+		Issues: []Issue{
+			{Code: CodeDeadKeyword, Severity: SeverityWarning, Path: ParsePath("$/a/pattern"), Message: "old wording"},
+			{Code: CodeDeadKeyword, Severity: SeverityWarning, Path: ParsePath("$/b/pattern")},
+			{Code: CodeLargeEnum, Severity: SeverityWarning, Path: ParsePath("$/c")},
+		},
+	}
+	current := Result{
+		SchemaPath: "schema.json",
+		Issues: []Issue{
+			{Code: CodeDeadKeyword, Severity: SeverityError, Path: ParsePath("$/a/pattern"), Message: "new wording"},
+			{Code: CodeLargeEnum, Severity: SeverityWarning, Path: ParsePath("$/c")},
+			{Code: CodeLargeEnum, Severity: SeverityWarning, Path: ParsePath("$/c")},
+			{Code: CodeUnionNoDiscriminator, Severity: SeverityError, Path: ParsePath("$/d/anyOf")},
+		},
+	}
+
+	c := Compare(previous, current)
+	if c.SchemaPath != "schema.json" {
+		t.Errorf("Expected current schema path, got %q", c.SchemaPath)
+	}
+	if len(c.Persisting) != 2 || c.Persisting[0].Message != "new wording" {
+		t.Errorf("Expected 2 persisting issues as currently reported, got: %v", c.Persisting)
+	}
+	if len(c.Fixed) != 1 || c.Fixed[0].Path.String() != "$/b/pattern" {
+		t.Errorf("Expected $/b/pattern to be fixed, got: %v", c.Fixed)
+	}
+	if len(c.New) != 2 || c.New[0].Path.String() != "$/c" || c.New[1].Path.String() != "$/d/anyOf" {
+		t.Errorf("Expected the repeated $/c and $/d/anyOf to be new, got: %v", c.New)
+	}
+	if added := c.NewResult(); added.ErrorCount() != 1 || added.WarningCount() != 1 {
+		t.Errorf("Expected 1 new error and 1 new warning, got: %v", added.Issues)
+	}
+}
+
+func TestLoadResult(t *testing.T) {
+	result, err := NewWithDefaults().Lint([]byte(`{"type": "integer", "pattern": "a"}`))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	data, err := result.JSON()
+	if err != nil {
+		t.Fatalf("Failed to serialize: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "result.json")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadResult(path)
+	if err != nil {
+		t.Fatalf("Failed to load result: %v", err)
+	}
+	if c := Compare(loaded, *result); len(c.New) != 0 || len(c.Fixed) != 0 || len(c.Persisting) != 1 {
+		t.Errorf("Expected the saved result to match itself, got: %+v", c)
+	}
+
+	if _, err := LoadResult(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("Expected error for missing file")
+	}
+}
@@ -0,0 +1,79 @@
+package linter
+
+import (
+	"os"
+	"sync"
+	"testing"
+)
+
+// These tests are most useful under the race detector: go test -race ./linter
+
+func TestLinterConcurrentUse(t *testing.T) {
+	data, err := os.ReadFile("../testdata/bad_schema.json")
+	if err != nil {
+		t.Fatalf("Failed to read schema: %v", err)
+	}
+
+	config := DefaultConfig()
+	config.Assertions = []Assertion{{Code: "no-title", CEL: "has(schema.properties) && !has(schema.title)", Message: "missing title"}}
+	l := New(config)
+
+	want, err := l.Lint(data)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan string, 32)
+	for i := 0; i < 32; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			result, err := l.Lint(data)
+			if err != nil {
+				errs <- err.Error()
+				return
+			}
+			if len(result.Issues) != len(want.Issues) {
+				errs <- "issue count differs between concurrent runs"
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+	for e := range errs {
+		t.Error(e)
+	}
+}
+
+func TestCheckConcurrentSharedConfig(t *testing.T) {
+	config := DefaultConfig()
+	config.Profile = ProfileScale
+	schemas := []string{
+		`{"type": "object", "properties": {"a": {"type": "string"}}, "additionalProperties": true}`,
+		`{"$defs": {"U": {"anyOf": [{"type": "string"}, {"type": "integer"}]}}}`,
+		`{"type": ["string", "number"]}`,
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 30; i++ {
+		wg.Add(1)
+		go func(schema string) {
+			defer wg.Done()
+			if _, err := Check([]byte(schema), config); err != nil {
+				t.Errorf("Check() error: %v", err)
+			}
+		}(schemas[i%len(schemas)])
+	}
+	wg.Wait()
+}
+
+func TestNewCopiesConfigSlices(t *testing.T) {
+	config := DefaultConfig()
+	l := New(config)
+	config.DiscriminatorFields[0] = "mutated"
+
+	if l.config.DiscriminatorFields[0] == "mutated" {
+		t.Error("Expected New to copy DiscriminatorFields")
+	}
+}
@@ -0,0 +1,151 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// lintConstUnion reports an anyOf or oneOf union whose variants are all
+// single scalar constants, which generators turn into a wrapper type or an
+// interface rather than the enum it describes. It reports whether the
+// union is such an enum, so that the discriminator checks are skipped.
+func (l *Linter) lintConstUnion(variants []*Schema, path Path, unionType string, result *Result) bool {
+	if len(variants) < 2 {
+		return false
+	}
+	values := make([]string, 0, len(variants))
+	documented := false
+	for _, v := range variants {
+		value, ok := constVariant(v)
+		if !ok {
+			return false
+		}
+		data, _ := json.Marshal(value)
+		values = append(values, string(data))
+		documented = documented || v.Title != "" || v.Description != ""
+	}
+	suggestion := fmt.Sprintf("Replace the %s with \"enum\": [%s]", unionType, strings.Join(values, ", "))
+	if documented {
+		suggestion += ", and move the descriptions of the values to the schema's description"
+	}
+	result.Issues = append(result.Issues, Issue{
+		Code:       CodeConstUnion,
+		Severity:   SeverityWarning,
+		Path:       path,
+		Message:    fmt.Sprintf("%s union of %d constants is an enum", unionType, len(variants)),
+		Suggestion: suggestion,
+	})
+	return true
+}
+
+// constVariant returns the value of a union variant that only declares a
+// scalar const, or an enum of one scalar, besides its type and annotations.
+func constVariant(v *Schema) (any, bool) {
+	if v == nil || v.IsBooleanSchema || v.IsRef() || len(v.Properties) > 0 || v.Items != nil ||
+		len(v.AnyOf) > 0 || len(v.OneOf) > 0 || len(v.AllOf) > 0 {
+		return nil, false
+	}
+	value := v.Const
+	if value == nil && len(v.Enum) == 1 {
+		value = v.Enum[0]
+	}
+	switch value.(type) {
+	case string, bool, json.Number:
+		return value, true
+	}
+	return nil, false
+}
+
+// constUnionEnum returns the values of a union of constants in a decoded
+// document, and their type if they share one, for fix to replace the union
+// with an enum. Variants with keys other than const and type, such as
+// descriptions, are not replaced, since the enum would lose them.
+func constUnionEnum(v any) (values []any, typ string, ok bool) {
+	variants, isArray := v.([]any)
+	if !isArray || len(variants) < 2 {
+		return nil, "", false
+	}
+	for i, variant := range variants {
+		obj, isObject := variant.(*jsonObject)
+		if !isObject {
+			return nil, "", false
+		}
+		value, hasConst := obj.get("const")
+		declared, _ := obj.get("type")
+		if !hasConst || len(obj.members) != 1 && (len(obj.members) != 2 || declared == nil) {
+			return nil, "", false
+		}
+		t := scalarType(value)
+		if t == "" || declared != nil && declared != t && (declared != "number" || t != "integer") {
+			return nil, "", false
+		}
+		switch {
+		case i == 0:
+			typ = t
+		case typ != t && (typ == "number" || typ == "integer") && (t == "number" || t == "integer"):
+			typ = "number"
+		case typ != t:
+			typ = "mixed"
+		}
+		values = append(values, value)
+	}
+	if typ == "mixed" {
+		typ = ""
+	}
+	return values, typ, true
+}
+
+// scalarType returns the JSON Schema type of a scalar decoded value, or ""
+// for objects, arrays, and null.
+func scalarType(v any) string {
+	switch v := v.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	}
+	return ""
+}
+
+// lintMixedEnum reports an enum that mixes scalar values with objects or
+// arrays, which no generated enum type can hold, and enum values that are
+// objects with a $ref, which are literal values rather than references.
+func (l *Linter) lintMixedEnum(schema *Schema, path Path, result *Result) {
+	scalars, composites := 0, 0
+	for _, value := range schema.Enum {
+		switch value := value.(type) {
+		case map[string]any:
+			if _, ok := value["$ref"]; ok {
+				result.Issues = append(result.Issues, Issue{
+					Code:       CodeMixedEnum,
+					Severity:   SeverityWarning,
+					Path:       path.Child("enum"),
+					Message:    fmt.Sprintf("Enum value {\"$ref\": %q} is a literal object, not a reference", value["$ref"]),
+					Suggestion: "Use anyOf with the enum of scalar values and a $ref variant for the referenced schema",
+				})
+				return
+			}
+			composites++
+		case []any:
+			composites++
+		case nil:
+		default:
+			scalars++
+		}
+	}
+	if scalars > 0 && composites > 0 {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeMixedEnum,
+			Severity:   SeverityWarning,
+			Path:       path.Child("enum"),
+			Message:    fmt.Sprintf("Enum mixes %d scalar value(s) with %d object or array value(s)", scalars, composites),
+			Suggestion: "Keep only scalar values in the enum, and model the structured values as separate anyOf variants",
+		})
+	}
+}
@@ -0,0 +1,91 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConstUnion(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   int
+	}{
+		{"string consts", `{"oneOf": [{"const": "asc"}, {"const": "desc"}]}`, 1},
+		{"single-value enums", `{"anyOf": [{"type": "integer", "enum": [1]}, {"type": "integer", "enum": [2]}]}`, 1},
+		{"documented consts", `{"oneOf": [{"const": "asc", "description": "Ascending"}, {"const": "desc", "description": "Descending"}]}`, 1},
+		{"object variant", `{"oneOf": [{"const": "none"}, {"type": "object", "properties": {"size": {"type": "integer"}}}]}`, 0},
+		{"ref variant", `{"oneOf": [{"const": "none"}, {"$ref": "#/$defs/Size"}], "$defs": {"Size": {"type": "integer"}}}`, 0},
+		{"single variant", `{"oneOf": [{"const": "asc"}]}`, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := codeIssues(t, DefaultConfig(), tt.schema, CodeConstUnion); len(got) != tt.want {
+				t.Errorf("Expected %d issues, got %v", tt.want, got)
+			}
+		})
+	}
+
+	// The union is not also reported for lacking a discriminator
+	schema := `{"oneOf": [{"const": "asc"}, {"const": "desc"}]}`
+	if got := codeIssues(t, DefaultConfig(), schema, CodeUnionNoDiscriminator); len(got) != 0 {
+		t.Errorf("Expected no union-no-discriminator issue, got %v", got)
+	}
+	issues := codeIssues(t, DefaultConfig(), schema, CodeConstUnion)
+	if len(issues) != 1 || issues[0].Path.String() != "$/oneOf" || !strings.Contains(issues[0].Suggestion, `"enum": ["asc", "desc"]`) {
+		t.Errorf("Expected the enum in the suggestion, got %v", issues)
+	}
+}
+
+func TestFixConstUnion(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   string
+	}{
+		{"strings", `{"properties": {"order": {"oneOf": [{"const": "asc"}, {"const": "desc"}]}}}`,
+			`{"properties":{"order":{"type":"string","enum":["asc","desc"]}}}`},
+		{"numbers", `{"properties": {"level": {"description": "Level", "anyOf": [{"type": "integer", "const": 1}, {"const": 2.5}]}}}`,
+			`{"properties":{"level":{"description":"Level","type":"number","enum":[1,2.5]}}}`},
+		{"mixed types", `{"properties": {"v": {"oneOf": [{"const": "auto"}, {"const": 3}]}}}`,
+			`{"properties":{"v":{"enum":["auto",3]}}}`},
+		{"documented variants are kept", `{"properties": {"order": {"oneOf": [{"const": "asc", "title": "Ascending"}, {"const": "desc"}]}}}`,
+			`{"properties":{"order":{"oneOf":[{"const":"asc","title":"Ascending"},{"const":"desc"}]}}}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixed, _, err := NewWithDefaults().Fix([]byte(tt.schema), CodeConstUnion)
+			if err != nil {
+				t.Fatalf("Failed to fix: %v", err)
+			}
+			if got := strings.Join(strings.Fields(string(fixed)), ""); got != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMixedEnum(t *testing.T) {
+	tests := []struct {
+		name    string
+		schema  string
+		want    int
+		message string
+	}{
+		{"scalars", `{"enum": ["a", 1, true, null]}`, 0, ""},
+		{"objects", `{"enum": [{"a": 1}, {"b": 2}]}`, 0, ""},
+		{"scalars and objects", `{"enum": ["a", {"b": 2}]}`, 1, "mixes 1 scalar value(s) with 1 object or array value(s)"},
+		{"ref value", `{"enum": ["none", {"$ref": "#/$defs/Size"}]}`, 1, "is a literal object"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := codeIssues(t, DefaultConfig(), tt.schema, CodeMixedEnum)
+			if len(got) != tt.want {
+				t.Fatalf("Expected %d issues, got %v", tt.want, got)
+			}
+			if tt.want > 0 && !strings.Contains(got[0].Message, tt.message) {
+				t.Errorf("Expected a message containing %q, got %q", tt.message, got[0].Message)
+			}
+		})
+	}
+}
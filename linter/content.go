@@ -0,0 +1,61 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// lintContentEncoding flags properties that hold base64-encoded binary
+// data (format: byte, as in OpenAPI, or a name or description mentioning
+// base64) without contentEncoding, so generators map them to string
+// instead of []byte, and contentEncoding or contentMediaType on a schema
+// whose declared type is not string, where they have no effect.
+func (l *Linter) lintContentEncoding(schema *Schema, path Path, result *Result) {
+	types := schema.TypeList
+	if len(types) == 0 && schema.Type != "" {
+		types = []string{schema.Type}
+	}
+	if len(types) > 0 && !slices.Contains(types, "string") {
+		for _, kw := range []struct{ keyword, value string }{
+			{"contentEncoding", schema.ContentEncoding},
+			{"contentMediaType", schema.ContentMediaType},
+		} {
+			if kw.value == "" {
+				continue
+			}
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeContentEncodingNotString,
+				Severity:   SeverityWarning,
+				Path:       path.Child(kw.keyword),
+				Message:    fmt.Sprintf("Keyword '%s' only applies to strings, but the type is %s", kw.keyword, formatTypes(types)),
+				Suggestion: "Change the type to string, or remove the keyword",
+			})
+		}
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		prop := schema.Properties[name]
+		if prop == nil || prop.IsBooleanSchema || prop.IsRef() || prop.ContentEncoding != "" || !isBase64(name, prop) {
+			continue
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeMissingContentEncoding,
+			Severity:   SeverityWarning,
+			Path:       path.Child("properties", name),
+			Message:    fmt.Sprintf("Property '%s' holds base64-encoded data but has no contentEncoding, so it is generated as a string instead of bytes", name),
+			Suggestion: "Add contentEncoding: base64, and contentMediaType if the decoded content has one (run 'schemakit fix')",
+		})
+	}
+}
+
+// isBase64 reports whether a string property holds base64-encoded data:
+// it has format byte, or its name or description mentions base64.
+func isBase64(name string, s *Schema) bool {
+	if schemaKind(s) != "string" {
+		return false
+	}
+	return s.Format == "byte" ||
+		slices.Contains(lowerWords(name), "base64") ||
+		strings.Contains(strings.ToLower(s.Description), "base64")
+}
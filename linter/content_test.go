@@ -0,0 +1,46 @@
+package linter
+
+import (
+	"testing"
+)
+
+func TestLintContentEncoding(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"avatar": {"type": "string", "format": "byte"},
+			"imageBase64": {"type": "string"},
+			"signature": {"type": "string", "description": "Base64-encoded signature"},
+			"thumbnail": {"type": "string", "format": "byte", "contentEncoding": "base64", "contentMediaType": "image/png"},
+			"name": {"type": "string"},
+			"size": {"type": "integer", "contentMediaType": "image/png"},
+			"payload": {"type": ["object", "null"], "contentEncoding": "base64"}
+		}
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	got := map[string]IssueCode{}
+	for _, issue := range result.Issues {
+		if issue.Code == CodeMissingContentEncoding || issue.Code == CodeContentEncodingNotString {
+			got[issue.Path.String()] = issue.Code
+		}
+	}
+	want := map[string]IssueCode{
+		"$/properties/avatar":                  CodeMissingContentEncoding,
+		"$/properties/imageBase64":             CodeMissingContentEncoding,
+		"$/properties/signature":               CodeMissingContentEncoding,
+		"$/properties/size/contentMediaType":   CodeContentEncodingNotString,
+		"$/properties/payload/contentEncoding": CodeContentEncodingNotString,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for path, code := range want {
+		if got[path] != code {
+			t.Errorf("Expected %s at %s, got %v", code, path, got)
+		}
+	}
+}
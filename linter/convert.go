@@ -0,0 +1,346 @@
+package linter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Draft202012 is the $schema URI of JSON Schema draft 2020-12.
+const Draft202012 = "https://json-schema.org/draft/2020-12/schema"
+
+// ConvertTargets lists the drafts Convert can rewrite a schema for.
+var ConvertTargets = []string{"2020-12"}
+
+// Convert rewrites a draft-07 (or earlier) schema for the target draft,
+// which must be one of ConvertTargets. For 2020-12 it renames definitions
+// to $defs (rewriting local $refs to match), tuple items to prefixItems
+// with additionalItems becoming items, splits dependencies into
+// dependentRequired and dependentSchemas, and sets $schema. Key order and
+// all other keywords are preserved. A JSON array of schemas is converted
+// element by element.
+func Convert(data []byte, to string) ([]byte, error) {
+	if to != "2020-12" {
+		return nil, fmt.Errorf("unsupported target draft %q (supported: %s)", to, strings.Join(ConvertTargets, ", "))
+	}
+
+	doc, err := decodeDocument(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if docs, ok := doc.([]any); ok {
+		for i, d := range docs {
+			if err := convertDocument(d, documentPath(i, true)); err != nil {
+				return nil, err
+			}
+		}
+	} else if err := convertDocument(doc, rootPath); err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to serialize schema: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// convertDocument converts a root schema and sets its $schema.
+func convertDocument(v any, path Path) error {
+	obj, ok := v.(*jsonObject)
+	if !ok {
+		return nil
+	}
+	if obj.index("$schema") < 0 {
+		obj.members = append([]jsonMember{{Key: "$schema"}}, obj.members...)
+	}
+	return convertSchema(obj, path)
+}
+
+// Keywords whose values are a schema, a map of schemas, or an array of
+// schemas, after conversion to 2020-12.
+var (
+	subschemaKeywords = []string{
+		"items", "additionalProperties", "contains", "not", "if", "then", "else",
+		"propertyNames", "unevaluatedItems", "unevaluatedProperties",
+	}
+	subschemaMapKeywords   = []string{"properties", "patternProperties", "$defs", "dependentSchemas"}
+	subschemaArrayKeywords = []string{"allOf", "anyOf", "oneOf", "prefixItems"}
+)
+
+// convertSchema rewrites the draft-07 keywords of a schema and its
+// subschemas. Values of other keywords, such as enum and default, are data
+// and are left alone.
+func convertSchema(v any, path Path) error {
+	obj, ok := v.(*jsonObject)
+	if !ok {
+		return nil
+	}
+
+	if obj.index("$schema") >= 0 {
+		obj.set("$schema", Draft202012)
+	}
+
+	if ref, ok := obj.get("$ref"); ok {
+		if s, ok := ref.(string); ok {
+			if name, ok := strings.CutPrefix(s, "#/definitions/"); ok {
+				obj.set("$ref", "#/$defs/"+name)
+			}
+		}
+	}
+
+	if defs, ok := obj.get("definitions"); ok {
+		if err := convertDefinitions(obj, defs, path); err != nil {
+			return err
+		}
+	}
+
+	if items, ok := obj.get("items"); ok {
+		if _, tuple := items.([]any); tuple {
+			obj.rename("items", "prefixItems")
+			obj.rename("additionalItems", "items")
+		} else {
+			// additionalItems has no effect unless items is an array
+			obj.replace("additionalItems")
+		}
+	}
+
+	if deps, ok := obj.get("dependencies"); ok {
+		if err := convertDependencies(obj, deps, path); err != nil {
+			return err
+		}
+	}
+
+	for _, kw := range subschemaKeywords {
+		if sub, ok := obj.get(kw); ok {
+			if err := convertSchema(sub, path.Child(kw)); err != nil {
+				return err
+			}
+		}
+	}
+	for _, kw := range subschemaMapKeywords {
+		m, ok := obj.get(kw)
+		if !ok {
+			continue
+		}
+		if m, ok := m.(*jsonObject); ok {
+			for _, member := range m.members {
+				if err := convertSchema(member.Value, path.Child(kw, member.Key)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	for _, kw := range subschemaArrayKeywords {
+		a, ok := obj.get(kw)
+		if !ok {
+			continue
+		}
+		if a, ok := a.([]any); ok {
+			for i, sub := range a {
+				if err := convertSchema(sub, path.Child(kw).Index(i)); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// convertDefinitions renames definitions to $defs, merging into an existing
+// $defs unless a name is defined in both.
+func convertDefinitions(obj *jsonObject, defs any, path Path) error {
+	existing, ok := obj.get("$defs")
+	if !ok {
+		obj.rename("definitions", "$defs")
+		return nil
+	}
+	from, fromOK := defs.(*jsonObject)
+	into, intoOK := existing.(*jsonObject)
+	if !fromOK || !intoOK {
+		return fmt.Errorf("%s: cannot merge definitions into $defs: not objects", path)
+	}
+	for _, member := range from.members {
+		if into.index(member.Key) >= 0 {
+			return fmt.Errorf("%s: definition %q is in both definitions and $defs", path, member.Key)
+		}
+		into.members = append(into.members, member)
+	}
+	obj.replace("definitions")
+	return nil
+}
+
+// convertDependencies splits dependencies into dependentRequired (property
+// arrays) and dependentSchemas (schemas), in place of the original keyword.
+func convertDependencies(obj *jsonObject, deps any, path Path) error {
+	m, ok := deps.(*jsonObject)
+	if !ok {
+		return fmt.Errorf("%s/dependencies: expected an object", path)
+	}
+	required, schemas := &jsonObject{}, &jsonObject{}
+	for _, member := range m.members {
+		if _, ok := member.Value.([]any); ok {
+			required.members = append(required.members, member)
+		} else {
+			schemas.members = append(schemas.members, member)
+		}
+	}
+	var with []jsonMember
+	if len(required.members) > 0 {
+		with = append(with, jsonMember{Key: "dependentRequired", Value: required})
+	}
+	if len(schemas.members) > 0 {
+		with = append(with, jsonMember{Key: "dependentSchemas", Value: schemas})
+	}
+	for _, member := range with {
+		if obj.index(member.Key) >= 0 {
+			return fmt.Errorf("%s: cannot convert dependencies: %s is already set", path, member.Key)
+		}
+	}
+	obj.replace("dependencies", with...)
+	return nil
+}
+
+// jsonObject is a JSON object that keeps its keys in document order, so a
+// converted schema diffs cleanly against the original.
+type jsonObject struct {
+	members []jsonMember
+}
+
+type jsonMember struct {
+	Key   string
+	Value any
+}
+
+func (o *jsonObject) index(key string) int {
+	for i, member := range o.members {
+		if member.Key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+func (o *jsonObject) get(key string) (any, bool) {
+	if i := o.index(key); i >= 0 {
+		return o.members[i].Value, true
+	}
+	return nil, false
+}
+
+// set replaces the value of key, or appends it if absent.
+func (o *jsonObject) set(key string, value any) {
+	if i := o.index(key); i >= 0 {
+		o.members[i].Value = value
+		return
+	}
+	o.members = append(o.members, jsonMember{Key: key, Value: value})
+}
+
+// rename renames key in place, if present.
+func (o *jsonObject) rename(key, to string) {
+	if i := o.index(key); i >= 0 {
+		o.members[i].Key = to
+	}
+}
+
+// replace replaces key, if present, with zero or more members in its place.
+func (o *jsonObject) replace(key string, with ...jsonMember) {
+	i := o.index(key)
+	if i < 0 {
+		return
+	}
+	o.members = append(o.members[:i], append(with, o.members[i+1:]...)...)
+}
+
+// MarshalJSON implements json.Marshaler, writing members in order.
+func (o *jsonObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	buf.WriteByte('{')
+	for i, member := range o.members {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		if err := enc.Encode(member.Key); err != nil {
+			return nil, err
+		}
+		buf.Truncate(buf.Len() - 1) // Encode appends a newline
+		buf.WriteByte(':')
+		if err := enc.Encode(member.Value); err != nil {
+			return nil, err
+		}
+		buf.Truncate(buf.Len() - 1)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// decodeDocument decodes schema data as an ordered value (see
+// decodeOrdered), after NormalizeJSON.
+func decodeDocument(data []byte) (any, error) {
+	data, err := NormalizeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	doc, err := decodeOrdered(dec)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JSON Schema: %w", err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		return nil, errors.New("failed to parse JSON Schema: unexpected data after the schema")
+	}
+	return doc, nil
+}
+
+// decodeOrdered decodes the next JSON value, with objects as *jsonObject,
+// arrays as []any, and numbers as json.Number.
+func decodeOrdered(dec *json.Decoder) (any, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	switch tok {
+	case json.Delim('{'):
+		obj := &jsonObject{}
+		for dec.More() {
+			key, err := dec.Token()
+			if err != nil {
+				return nil, err
+			}
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			obj.set(key.(string), value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return obj, nil
+	case json.Delim('['):
+		arr := []any{}
+		for dec.More() {
+			value, err := decodeOrdered(dec)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		if _, err := dec.Token(); err != nil {
+			return nil, err
+		}
+		return arr, nil
+	}
+	return tok, nil
+}
@@ -0,0 +1,100 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	schema := `{
+  "$schema": "http://json-schema.org/draft-07/schema#",
+  "type": "array",
+  "items": [{"$ref": "#/definitions/Point"}],
+  "additionalItems": false,
+  "definitions": {
+    "Point": {
+      "type": "object",
+      "properties": {"definitions": {"type": "string", "default": "<none>"}},
+      "dependencies": {"x": ["y"], "z": {"required": ["w"]}}
+    }
+  }
+}`
+	want := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "array",
+  "prefixItems": [
+    {
+      "$ref": "#/$defs/Point"
+    }
+  ],
+  "items": false,
+  "$defs": {
+    "Point": {
+      "type": "object",
+      "properties": {
+        "definitions": {
+          "type": "string",
+          "default": "<none>"
+        }
+      },
+      "dependentRequired": {
+        "x": [
+          "y"
+        ]
+      },
+      "dependentSchemas": {
+        "z": {
+          "required": [
+            "w"
+          ]
+        }
+      }
+    }
+  }
+}
+`
+
+	got, err := Convert([]byte(schema), "2020-12")
+	if err != nil {
+		t.Fatalf("Failed to convert: %v", err)
+	}
+	if string(got) != want {
+		t.Errorf("Unexpected conversion:\n%s", got)
+	}
+}
+
+func TestConvertAddsSchema(t *testing.T) {
+	got, err := Convert([]byte(`{"type": "object", "items": {}, "additionalItems": {"type": "string"}}`), "2020-12")
+	if err != nil {
+		t.Fatalf("Failed to convert: %v", err)
+	}
+	if !strings.HasPrefix(string(got), "{\n  \"$schema\": \""+Draft202012+"\",") {
+		t.Errorf("Expected $schema first, got:\n%s", got)
+	}
+	if strings.Contains(string(got), "additionalItems") {
+		t.Errorf("Expected ineffective additionalItems to be dropped, got:\n%s", got)
+	}
+}
+
+func TestConvertErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		to     string
+		want   string
+	}{
+		{"target", `{}`, "draft-04", "unsupported target draft"},
+		{"definition clash", `{"definitions": {"A": {}}, "$defs": {"A": {}}}`, "2020-12", `definition "A" is in both`},
+		{"dependencies clash", `{"dependencies": {"a": ["b"]}, "dependentRequired": {}}`, "2020-12", "dependentRequired is already set"},
+		{"trailing data", `{} {}`, "2020-12", "unexpected data"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Convert([]byte(tt.schema), tt.to)
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got: %v", tt.want, err)
+			}
+		})
+	}
+}
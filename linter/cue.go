@@ -0,0 +1,352 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// cueIdentifier matches names usable as CUE identifiers without quoting.
+var cueIdentifier = regexp.MustCompile(`^[\pL_$][\pL\p{Nd}_$]*$`)
+
+// GenerateCUE returns CUE definitions for the schema: a definition for the
+// root schema (named by its title) and one per $defs/definitions entry.
+// Required properties are regular fields and others are optional; objects
+// are open unless additionalProperties is false. Keywords CUE cannot
+// express are reported as cue-unsupported info issues and left out.
+func GenerateCUE(schema *Schema, pkg string) (string, []Issue) {
+	g := &cueGen{doc: schema, issues: []Issue{}, imports: make(map[string]bool)}
+
+	var body strings.Builder
+	if !isDefinitionBundle(schema) {
+		fmt.Fprintf(&body, "\n%s: %s\n", g.rootName(), g.expr(schema, rootPath, ""))
+	}
+	for _, defs := range []struct {
+		keyword string
+		schemas map[string]*Schema
+	}{{"$defs", schema.Defs}, {"definitions", schema.Definitions}} {
+		for _, name := range sortedKeys(defs.schemas) {
+			path := rootPath.Child(defs.keyword, name)
+			fmt.Fprintf(&body, "\n%s: %s\n", cueDefinition(name), g.expr(defs.schemas[name], path, ""))
+		}
+	}
+
+	var sb strings.Builder
+	if pkg != "" {
+		fmt.Fprintf(&sb, "package %s\n", pkg)
+	}
+	if len(g.imports) > 0 {
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		imports := make([]string, 0, len(g.imports))
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		sb.WriteString("import (\n")
+		for _, imp := range imports {
+			fmt.Fprintf(&sb, "\t%q\n", imp)
+		}
+		sb.WriteString(")\n")
+	}
+	out := sb.String() + body.String()
+	return strings.TrimPrefix(out, "\n"), g.issues
+}
+
+type cueGen struct {
+	doc     *Schema
+	issues  []Issue
+	imports map[string]bool
+}
+
+func (g *cueGen) unsupported(path Path, keyword, reason string) {
+	g.issues = append(g.issues, Issue{
+		Code:       CodeCUEUnsupported,
+		Severity:   SeverityInfo,
+		Path:       path.Child(keyword),
+		Message:    fmt.Sprintf("Keyword '%s' is not expressed in CUE: %s", keyword, reason),
+		Suggestion: "Enforce the constraint outside CUE, or add it to the CUE definition by hand",
+	})
+}
+
+func (g *cueGen) rootName() string {
+	if g.doc.Title != "" {
+		return "#" + protoPascal(g.doc.Title)
+	}
+	return "#Root"
+}
+
+// expr returns the CUE expression for a schema. indent is the indentation
+// of the line the expression starts on.
+func (g *cueGen) expr(s *Schema, path Path, indent string) string {
+	if s == nil {
+		return "_"
+	}
+	if s.IsBooleanSchema {
+		if s.BooleanValue {
+			return "_"
+		}
+		return "_|_"
+	}
+
+	// Each term is a disjunction of alternatives; the terms are unified.
+	var terms [][]string
+	if s.DynamicRef != "" {
+		g.unsupported(path, "$dynamicRef", "dynamic scope is not modeled")
+	}
+	if s.Ref != "" {
+		terms = append(terms, []string{g.refName(s.Ref, path)})
+	}
+
+	switch {
+	case s.Const != nil:
+		terms = append(terms, []string{cueLiteral(s.Const)})
+	case len(s.Enum) > 0:
+		values := make([]string, len(s.Enum))
+		for i, v := range s.Enum {
+			values[i] = cueLiteral(v)
+		}
+		terms = append(terms, values)
+	default:
+		if alts := g.typeAlternatives(s, path, indent); len(alts) > 0 {
+			terms = append(terms, alts)
+		}
+	}
+
+	if variants := s.GetUnionVariants(); len(variants) > 0 {
+		keyword := "anyOf"
+		if len(s.AnyOf) == 0 {
+			keyword = "oneOf"
+			g.unsupported(path, "oneOf", "CUE disjunctions accept values matching more than one variant")
+		}
+		alts := make([]string, len(variants))
+		for i, v := range variants {
+			alts[i] = cueParen(g.expr(v, path.Child(keyword).Index(i), indent))
+		}
+		terms = append(terms, alts)
+	}
+	for i, v := range s.AllOf {
+		terms = append(terms, []string{cueParen(g.expr(v, path.Child("allOf").Index(i), indent))})
+	}
+
+	if len(terms) == 0 {
+		return "_"
+	}
+	var expr string
+	if len(terms) == 1 {
+		expr = strings.Join(terms[0], " | ")
+	} else {
+		var unified []string
+		for _, alts := range terms {
+			// _ is the identity of unification
+			if term := cueParen(strings.Join(alts, " | ")); term != "_" {
+				unified = append(unified, term)
+			}
+		}
+		expr = strings.Join(unified, " & ")
+		if expr == "" {
+			expr = "_"
+		}
+	}
+	if s.Default != nil && !strings.Contains(expr, "\n") {
+		expr = fmt.Sprintf("*%s | %s", cueLiteral(s.Default), expr)
+	}
+	return expr
+}
+
+// typeAlternatives returns an expression for each of the schema's types,
+// with its type-specific constraints.
+func (g *cueGen) typeAlternatives(s *Schema, path Path, indent string) []string {
+	types := s.TypeList
+	if len(types) == 0 {
+		switch {
+		case s.Type != "":
+			types = []string{s.Type}
+		case len(s.Properties) > 0 || len(s.Required) > 0 || s.AdditionalProperties != nil:
+			types = []string{"object"}
+		case s.Items != nil:
+			types = []string{"array"}
+		}
+	}
+
+	var alts []string
+	for _, t := range types {
+		switch t {
+		case "string":
+			alts = append(alts, g.stringExpr(s, path))
+		case "integer":
+			alts = append(alts, g.numberExpr("int", s, path))
+		case "number":
+			alts = append(alts, g.numberExpr("number", s, path))
+		case "boolean":
+			alts = append(alts, "bool")
+		case "null":
+			alts = append(alts, "null")
+		case "array":
+			alts = append(alts, g.arrayExpr(s, path, indent))
+		case "object":
+			alts = append(alts, g.objectExpr(s, path, indent))
+		}
+	}
+	return alts
+}
+
+func (g *cueGen) stringExpr(s *Schema, path Path) string {
+	terms := []string{"string"}
+	if s.MinLength != nil {
+		g.imports["strings"] = true
+		terms = append(terms, fmt.Sprintf("strings.MinRunes(%d)", *s.MinLength))
+	}
+	if s.MaxLength != nil {
+		g.imports["strings"] = true
+		terms = append(terms, fmt.Sprintf("strings.MaxRunes(%d)", *s.MaxLength))
+	}
+	if s.Pattern != "" {
+		terms = append(terms, "=~"+cueLiteral(s.Pattern))
+	}
+	switch s.Format {
+	case "":
+	case "date-time":
+		g.imports["time"] = true
+		terms = append(terms, "time.Time()")
+	default:
+		g.unsupported(path, "format", fmt.Sprintf("format %q has no CUE equivalent", s.Format))
+	}
+	return strings.Join(terms, " & ")
+}
+
+func (g *cueGen) numberExpr(kind string, s *Schema, path Path) string {
+	terms := []string{kind}
+	bound := func(op string, v *float64) {
+		if v != nil {
+			terms = append(terms, op+cueLiteral(*v))
+		}
+	}
+	bound(">=", s.Minimum)
+	bound("<=", s.Maximum)
+	bound(">", s.ExclusiveMinimum)
+	bound("<", s.ExclusiveMaximum)
+	if s.MultipleOf != nil {
+		g.unsupported(path, "multipleOf", "there is no multiple-of constraint")
+	}
+	return strings.Join(terms, " & ")
+}
+
+func (g *cueGen) arrayExpr(s *Schema, path Path, indent string) string {
+	terms := []string{"[...]"}
+	if s.Items != nil {
+		terms[0] = fmt.Sprintf("[...%s]", cueParen(g.expr(s.Items, path.Child("items"), indent)))
+	}
+	if s.MinItems != nil {
+		g.imports["list"] = true
+		terms = append(terms, fmt.Sprintf("list.MinItems(%d)", *s.MinItems))
+	}
+	if s.MaxItems != nil {
+		g.imports["list"] = true
+		terms = append(terms, fmt.Sprintf("list.MaxItems(%d)", *s.MaxItems))
+	}
+	if s.UniqueItems != nil && *s.UniqueItems {
+		g.imports["list"] = true
+		terms = append(terms, "list.UniqueItems()")
+	}
+	if s.Contains != nil {
+		g.unsupported(path, "contains", "there is no list containment constraint")
+	}
+	return strings.Join(terms, " & ")
+}
+
+func (g *cueGen) objectExpr(s *Schema, path Path, indent string) string {
+	if len(s.Properties) == 0 && len(s.Required) == 0 && s.AdditionalPropertiesSchema == nil {
+		if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+			return "close({})"
+		}
+		return "{...}"
+	}
+
+	required := make(map[string]bool, len(s.Required))
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	// Required names without a property schema (e.g., in an allOf part)
+	// are fields of any value
+	names := sortedKeys(s.Properties)
+	for _, name := range s.Required {
+		if _, ok := s.Properties[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	inner := indent + "\t"
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for _, name := range names {
+		label := name
+		if !cueIdentifier.MatchString(label) {
+			label = cueLiteral(name)
+		}
+		if !required[name] {
+			label += "?"
+		}
+		fmt.Fprintf(&sb, "%s%s: %s\n", inner, label, g.expr(s.Properties[name], path.Child("properties", name), inner))
+	}
+	switch {
+	case s.AdditionalPropertiesSchema != nil:
+		fmt.Fprintf(&sb, "%s[string]: %s\n", inner, g.expr(s.AdditionalPropertiesSchema, path.Child("additionalProperties"), inner))
+	case s.AdditionalProperties == nil || *s.AdditionalProperties:
+		fmt.Fprintf(&sb, "%s...\n", inner)
+	}
+	if s.MinProperties != nil || s.MaxProperties != nil {
+		keyword := "minProperties"
+		if s.MinProperties == nil {
+			keyword = "maxProperties"
+		}
+		g.unsupported(path, keyword, "field counts are not constrained")
+	}
+	fmt.Fprintf(&sb, "%s}", indent)
+	return sb.String()
+}
+
+// refName returns the CUE definition a $ref refers to.
+func (g *cueGen) refName(ref string, path Path) string {
+	if ref == "#" {
+		return g.rootName()
+	}
+	if _, target, ok := resolveLocalRef(g.doc, NewPath(graphRootID), ref); ok {
+		ref = target.String()
+	}
+	id := definitionID(ref)
+	if id == graphRootID || id != ref {
+		g.unsupported(path, "$ref", fmt.Sprintf("%q is not a local definition", ref))
+		return "_"
+	}
+	return cueDefinition(id[strings.LastIndex(id, "/")+1:])
+}
+
+// cueDefinition returns the CUE definition name for a schema definition.
+func cueDefinition(name string) string {
+	if cueIdentifier.MatchString(name) {
+		return "#" + name
+	}
+	return "#" + protoPascal(name)
+}
+
+// cueLiteral returns a JSON value as a CUE literal; JSON is valid CUE.
+func cueLiteral(v any) string {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "_"
+	}
+	return string(data)
+}
+
+// cueParen parenthesizes a disjunction so it can be unified with other
+// terms; & binds more tightly than | in CUE.
+func cueParen(expr string) string {
+	if strings.Contains(expr, " | ") {
+		return "(" + expr + ")"
+	}
+	return expr
+}
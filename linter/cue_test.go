@@ -0,0 +1,99 @@
+package linter
+
+import "testing"
+
+func TestGenerateCUE(t *testing.T) {
+	schema := `{
+		"title": "order",
+		"type": "object",
+		"required": ["id"],
+		"properties": {
+			"id": {"type": "string", "minLength": 1, "pattern": "^o_\\d+$"},
+			"created_at": {"type": "string", "format": "date-time"},
+			"email": {"type": "string", "format": "email"},
+			"total": {"type": ["number", "null"], "minimum": 0},
+			"qty": {"type": "integer", "multipleOf": 2, "default": 2},
+			"status": {"enum": ["pending", "shipped"]},
+			"tags": {"type": "array", "items": {"type": ["string", "null"]}, "uniqueItems": true},
+			"attrs": {"type": "object", "additionalProperties": {"type": "integer"}},
+			"content-type": {"type": "string"},
+			"payment": {"oneOf": [
+				{"$ref": "#/$defs/Card"},
+				{"type": "object", "properties": {"kind": {"const": "bank"}}, "additionalProperties": false}
+			]},
+			"card": {"allOf": [{"$ref": "#/$defs/Card"}, {"required": ["kind"]}]}
+		},
+		"additionalProperties": false,
+		"$defs": {
+			"Card": {"type": "object", "properties": {"kind": {"const": "card"}}}
+		}
+	}`
+	want := `package orders
+
+import (
+	"list"
+	"strings"
+	"time"
+)
+
+#Order: {
+	attrs?: {
+		[string]: int
+	}
+	card?: #Card & {
+		kind: _
+		...
+	}
+	"content-type"?: string
+	created_at?: string & time.Time()
+	email?: string
+	id: string & strings.MinRunes(1) & =~"^o_\\d+$"
+	payment?: #Card | {
+		kind?: "bank"
+	}
+	qty?: *2 | int
+	status?: "pending" | "shipped"
+	tags?: [...(string | null)] & list.UniqueItems()
+	total?: number & >=0 | null
+}
+
+#Card: {
+	kind?: "card"
+	...
+}
+`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	cue, issues := GenerateCUE(s, "orders")
+	if cue != want {
+		t.Errorf("Unexpected CUE:\n%s", cue)
+	}
+
+	paths := []string{
+		"$/properties/email/format",
+		"$/properties/payment/oneOf",
+		"$/properties/qty/multipleOf",
+	}
+	if len(issues) != len(paths) {
+		t.Fatalf("Expected %d issues, got: %v", len(paths), issues)
+	}
+	for i, issue := range issues {
+		if issue.Code != CodeCUEUnsupported || issue.Severity != SeverityInfo || issue.Path.String() != paths[i] {
+			t.Errorf("Expected cue-unsupported info at %s, got: %s", paths[i], issue)
+		}
+	}
+}
+
+func TestGenerateCUEDefinitionBundle(t *testing.T) {
+	s, err := ParseSchema([]byte(`{"$defs": {"user-id": {"type": "string"}}}`))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	cue, _ := GenerateCUE(s, "")
+	if want := "#UserId: string\n"; cue != want {
+		t.Errorf("Expected %q, got %q", want, cue)
+	}
+}
@@ -0,0 +1,417 @@
+package linter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ddlMaxRefDepth bounds $ref resolution when mapping a column, so recursive
+// definitions terminate.
+const ddlMaxRefDepth = 32
+
+// DDLColumn is the Postgres column a schema property maps to.
+type DDLColumn struct {
+	Name     string `json:"name"`
+	Property string `json:"property"`
+	Type     string `json:"type"`
+	NotNull  bool   `json:"not_null,omitempty"`
+}
+
+// DDLTable is the Postgres table a top-level object definition maps to.
+type DDLTable struct {
+	Name    string      `json:"name"`
+	Path    string      `json:"path"`
+	Columns []DDLColumn `json:"columns"`
+}
+
+// DDLReport maps the top-level object definitions of a schema to Postgres
+// tables, with issues for constructs that have no clean column mapping.
+type DDLReport struct {
+	Tables []DDLTable `json:"tables"`
+	Issues []Issue    `json:"issues"`
+}
+
+// MapDDL maps the root schema (named by its title) and each $defs or
+// definitions entry with properties to a table. Strings map to text (or
+// timestamptz, date, time, and uuid by format), integers to bigint,
+// numbers to double precision, arrays of scalars to arrays, and nested
+// objects to jsonb. Values of mixed or unknown type also become jsonb and
+// are reported, as are additional properties, which have no columns.
+func MapDDL(schema *Schema) *DDLReport {
+	m := &ddlMapper{doc: schema, report: &DDLReport{Tables: []DDLTable{}, Issues: []Issue{}}, tables: make(map[string]string)}
+	if !isDefinitionBundle(schema) {
+		name := "root"
+		if schema.Title != "" {
+			name = protoSnake(schema.Title)
+		}
+		m.table(name, schema, rootPath)
+	}
+	for _, defs := range []struct {
+		keyword string
+		schemas map[string]*Schema
+	}{{"$defs", schema.Defs}, {"definitions", schema.Definitions}} {
+		for _, name := range sortedKeys(defs.schemas) {
+			m.table(protoSnake(name), defs.schemas[name], rootPath.Child(defs.keyword, name))
+		}
+	}
+	return m.report
+}
+
+// SQL returns a CREATE TABLE statement for each table.
+func (r *DDLReport) SQL() string {
+	var sb strings.Builder
+	for i, t := range r.Tables {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+		fmt.Fprintf(&sb, "-- %s\nCREATE TABLE %s (\n", t.Path, ddlIdentifier(t.Name))
+		for j, c := range t.Columns {
+			fmt.Fprintf(&sb, "    %s %s", ddlIdentifier(c.Name), c.Type)
+			if c.NotNull {
+				sb.WriteString(" NOT NULL")
+			}
+			if j < len(t.Columns)-1 {
+				sb.WriteString(",")
+			}
+			sb.WriteString("\n")
+		}
+		sb.WriteString(");\n")
+	}
+	return sb.String()
+}
+
+type ddlMapper struct {
+	doc    *Schema
+	report *DDLReport
+	// tables maps table names to the path of the definition using them.
+	tables map[string]string
+}
+
+func (m *ddlMapper) unmappable(path Path, message, suggestion string) {
+	m.report.Issues = append(m.report.Issues, Issue{
+		Code:       CodeDDLUnmappable,
+		Severity:   SeverityWarning,
+		Path:       path,
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
+
+// table maps a top-level definition to a table if it is an object with
+// properties. Unions of objects have no single table and are reported.
+func (m *ddlMapper) table(name string, s *Schema, path Path) {
+	if s == nil || s.IsBooleanSchema {
+		return
+	}
+	if s.IsUnion() && len(s.Properties) == 0 {
+		if m.objectUnion(s) {
+			keyword := "anyOf"
+			if len(s.AnyOf) == 0 {
+				keyword = "oneOf"
+			}
+			m.unmappable(path.Child(keyword),
+				"Union of objects has no single table",
+				"Map each variant to its own table, or store the payload in a single jsonb column")
+		}
+		return
+	}
+
+	properties, required := m.fields(s, 0)
+	if len(properties) == 0 {
+		return
+	}
+	if other, ok := m.tables[name]; ok {
+		m.unmappable(path,
+			fmt.Sprintf("Definition maps to table %q, which is already used by %s", name, other),
+			"Rename one of the definitions")
+		return
+	}
+	m.tables[name] = path.String()
+
+	if s.AdditionalPropertiesSchema != nil || (s.AdditionalProperties != nil && *s.AdditionalProperties) {
+		m.unmappable(path.Child("additionalProperties"),
+			"Additional properties have no columns and are dropped unless stored separately",
+			"Add a jsonb column for the extra properties, or set additionalProperties: false")
+	}
+
+	table := DDLTable{Name: name, Path: path.String(), Columns: []DDLColumn{}}
+	columns := make(map[string]string)
+	for _, prop := range sortedKeys(properties) {
+		propPath := path.Child("properties", prop)
+		column := protoSnake(prop)
+		if other, ok := columns[column]; ok {
+			m.unmappable(propPath,
+				fmt.Sprintf("Properties '%s' and '%s' both map to column %q", other, prop, column),
+				"Rename one of the properties")
+			continue
+		}
+		columns[column] = prop
+
+		typ, nullable, reason := m.columnType(properties[prop], 0)
+		if reason != "" {
+			m.unmappable(propPath,
+				fmt.Sprintf("Property '%s' has no clean column type and is stored as jsonb: %s", prop, reason),
+				"Give the property a single type, or keep the jsonb column and validate it in the application")
+		}
+		table.Columns = append(table.Columns, DDLColumn{
+			Name:     column,
+			Property: prop,
+			Type:     typ,
+			NotNull:  required[prop] && !nullable,
+		})
+	}
+	m.report.Tables = append(m.report.Tables, table)
+}
+
+// fields returns the properties and required names of an object, merged
+// with those of its allOf parts.
+func (m *ddlMapper) fields(s *Schema, depth int) (map[string]*Schema, map[string]bool) {
+	properties := make(map[string]*Schema)
+	required := make(map[string]bool)
+	for name, prop := range s.Properties {
+		properties[name] = prop
+	}
+	for _, name := range s.Required {
+		required[name] = true
+	}
+	if depth >= ddlMaxRefDepth {
+		return properties, required
+	}
+	for _, part := range s.AllOf {
+		target, ok := m.resolve(part)
+		if !ok || target == nil {
+			continue
+		}
+		partProps, partRequired := m.fields(target, depth+1)
+		for name, prop := range partProps {
+			if _, ok := properties[name]; !ok {
+				properties[name] = prop
+			}
+		}
+		for name := range partRequired {
+			required[name] = true
+		}
+	}
+	return properties, required
+}
+
+// objectUnion reports whether every non-null variant of a union is an
+// object.
+func (m *ddlMapper) objectUnion(s *Schema) bool {
+	variants := nonNullVariants(s)
+	for _, v := range variants {
+		target, ok := m.resolve(v)
+		if !ok || target == nil || schemaKind(target) != "object" {
+			return false
+		}
+	}
+	return len(variants) > 0
+}
+
+// resolve returns the schema a local $ref refers to, or the schema itself.
+func (m *ddlMapper) resolve(s *Schema) (*Schema, bool) {
+	if s == nil {
+		return nil, false
+	}
+	ref := s.RefTarget()
+	if ref == "" {
+		return s, true
+	}
+	target, _, ok := resolveLocalRef(m.doc, rootPath, ref)
+	return target, ok
+}
+
+// columnType returns the column type of a property schema and whether it
+// allows null. A reason is returned when the value has no clean column
+// type and is stored as jsonb.
+func (m *ddlMapper) columnType(s *Schema, depth int) (string, bool, string) {
+	if s == nil || s.IsBooleanSchema {
+		return "jsonb", true, "it accepts any JSON value"
+	}
+	if ref := s.RefTarget(); ref != "" {
+		target, _, ok := resolveLocalRef(m.doc, rootPath, ref)
+		if !ok || target == nil {
+			return "jsonb", true, fmt.Sprintf("$ref %q is not a local definition", ref)
+		}
+		if depth >= ddlMaxRefDepth {
+			// Recursive definitions are objects or arrays of them
+			return "jsonb", true, ""
+		}
+		return m.columnType(target, depth+1)
+	}
+
+	if s.Const != nil {
+		return ddlScalar(constKind(s.Const), s.Format), false, ""
+	}
+	if len(s.Enum) > 0 {
+		return m.enumType(s)
+	}
+	if s.IsUnion() {
+		return m.unionType(s, depth)
+	}
+
+	kind := schemaKind(s)
+	nullable := false
+	for _, t := range s.TypeList {
+		if t == "null" {
+			nullable = true
+		}
+	}
+	if kind == "" {
+		var kinds []string
+		for _, t := range s.TypeList {
+			if t != "null" {
+				kinds = append(kinds, t)
+			}
+		}
+		switch {
+		case len(kinds) > 1:
+			return "jsonb", true, fmt.Sprintf("its type is one of %s", strings.Join(kinds, ", "))
+		case s.Items != nil:
+			kind = "array"
+		case len(s.AllOf) > 0:
+			for _, part := range s.AllOf {
+				if target, ok := m.resolve(part); ok && target != nil {
+					if kind = schemaKind(target); kind != "" {
+						break
+					}
+				}
+			}
+		}
+	}
+
+	switch kind {
+	case "":
+		return "jsonb", true, "it accepts any JSON value"
+	case "null":
+		return "jsonb", true, "it only accepts null"
+	case "array":
+		if s.Items == nil {
+			return "jsonb", nullable, "its items accept any JSON value"
+		}
+		items, _, reason := m.columnType(s.Items, depth)
+		if reason != "" {
+			return "jsonb", nullable, "its items have no clean column type"
+		}
+		if items == "jsonb" || strings.HasSuffix(items, "[]") {
+			return "jsonb", nullable, ""
+		}
+		return items + "[]", nullable, ""
+	}
+	return ddlScalar(kind, s.Format), nullable, ""
+}
+
+// enumType returns the column type of an enum, whose values must share a
+// type.
+func (m *ddlMapper) enumType(s *Schema) (string, bool, string) {
+	kinds := make(map[string]bool)
+	nullable := false
+	for _, v := range s.Enum {
+		if v == nil {
+			nullable = true
+			continue
+		}
+		kind := constKind(v)
+		if kind == "integer" && kinds["number"] {
+			continue
+		}
+		if kind == "number" {
+			delete(kinds, "integer")
+		}
+		if kind == "" {
+			kind = "object"
+		}
+		kinds[kind] = true
+	}
+	if len(kinds) != 1 {
+		return "jsonb", true, "its enum values have different types"
+	}
+	for kind := range kinds {
+		return ddlScalar(kind, s.Format), nullable, ""
+	}
+	return "jsonb", true, ""
+}
+
+// unionType returns the column type of an anyOf/oneOf union, which is
+// clean when every non-null variant maps to the same type.
+func (m *ddlMapper) unionType(s *Schema, depth int) (string, bool, string) {
+	variants := nonNullVariants(s)
+	nullable := len(variants) < len(s.GetUnionVariants())
+	var types []string
+	seen := make(map[string]bool)
+	for _, v := range variants {
+		typ, n, reason := m.columnType(v, depth)
+		if reason != "" {
+			return "jsonb", true, "a union variant has no clean column type"
+		}
+		nullable = nullable || n
+		if !seen[typ] {
+			seen[typ] = true
+			types = append(types, typ)
+		}
+	}
+	switch len(types) {
+	case 0:
+		return "jsonb", true, "it only accepts null"
+	case 1:
+		return types[0], nullable, ""
+	}
+	return "jsonb", true, fmt.Sprintf("it is a union of %s values", strings.Join(types, ", "))
+}
+
+// ddlFormats are the Postgres types of string formats with a column type
+// of their own.
+var ddlFormats = map[string]string{
+	"date-time": "timestamptz",
+	"date":      "date",
+	"time":      "time",
+	"uuid":      "uuid",
+}
+
+// ddlScalar returns the Postgres type of a JSON type.
+func ddlScalar(kind, format string) string {
+	switch kind {
+	case "string":
+		if t, ok := ddlFormats[format]; ok {
+			return t
+		}
+		return "text"
+	case "integer":
+		return "bigint"
+	case "number":
+		return "double precision"
+	case "boolean":
+		return "boolean"
+	}
+	return "jsonb"
+}
+
+// ddlReserved are Postgres reserved words, which must be quoted as
+// identifiers.
+var ddlReserved = map[string]bool{
+	"all": true, "analyse": true, "analyze": true, "and": true, "any": true,
+	"array": true, "as": true, "asc": true, "asymmetric": true, "both": true,
+	"case": true, "cast": true, "check": true, "collate": true, "column": true,
+	"constraint": true, "create": true, "current_catalog": true, "current_date": true,
+	"current_role": true, "current_time": true, "current_timestamp": true,
+	"current_user": true, "default": true, "deferrable": true, "desc": true,
+	"distinct": true, "do": true, "else": true, "end": true, "except": true,
+	"false": true, "fetch": true, "for": true, "foreign": true, "from": true,
+	"grant": true, "group": true, "having": true, "in": true, "initially": true,
+	"intersect": true, "into": true, "lateral": true, "leading": true, "limit": true,
+	"localtime": true, "localtimestamp": true, "not": true, "null": true,
+	"offset": true, "on": true, "only": true, "or": true, "order": true,
+	"placing": true, "primary": true, "references": true, "returning": true,
+	"select": true, "session_user": true, "some": true, "symmetric": true,
+	"table": true, "then": true, "to": true, "trailing": true, "true": true,
+	"union": true, "unique": true, "user": true, "using": true, "variadic": true,
+	"when": true, "where": true, "window": true, "with": true,
+}
+
+// ddlIdentifier quotes a snake_case name if it is a reserved word.
+func ddlIdentifier(name string) string {
+	if ddlReserved[name] {
+		return `"` + name + `"`
+	}
+	return name
+}
@@ -0,0 +1,124 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestMapDDL(t *testing.T) {
+	schema := `{
+		"title": "User",
+		"type": "object",
+		"required": ["id", "email", "nickname"],
+		"properties": {
+			"id": {"type": "string", "format": "uuid"},
+			"email": {"type": "string"},
+			"nickname": {"type": ["string", "null"]},
+			"age": {"type": "integer"},
+			"score": {"type": "number"},
+			"active": {"type": "boolean"},
+			"createdAt": {"type": "string", "format": "date-time"},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"address": {"$ref": "#/$defs/Address"},
+			"status": {"$ref": "#/$defs/Status"},
+			"amount": {"type": ["string", "number"]},
+			"payload": {},
+			"value": {"anyOf": [{"type": "string"}, {"type": "integer"}]}
+		},
+		"additionalProperties": true,
+		"$defs": {
+			"Address": {
+				"type": "object",
+				"properties": {"street": {"type": "string"}}
+			},
+			"Status": {"type": "string", "enum": ["active", "disabled"]},
+			"Event": {"oneOf": [{"$ref": "#/$defs/Address"}, {"$ref": "#/$defs/Order"}]},
+			"Order": {
+				"allOf": [{"$ref": "#/$defs/Address"}],
+				"required": ["street"],
+				"properties": {"total": {"type": "number"}}
+			}
+		}
+	}`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	report := MapDDL(s)
+
+	if len(report.Tables) != 3 {
+		t.Fatalf("Expected 3 tables, got %d: %v", len(report.Tables), report.Tables)
+	}
+	columns := make(map[string]DDLColumn)
+	for _, c := range report.Tables[0].Columns {
+		columns[c.Name] = c
+	}
+	want := map[string]DDLColumn{
+		"id":         {Type: "uuid", NotNull: true},
+		"email":      {Type: "text", NotNull: true},
+		"nickname":   {Type: "text"},
+		"age":        {Type: "bigint"},
+		"score":      {Type: "double precision"},
+		"active":     {Type: "boolean"},
+		"created_at": {Type: "timestamptz"},
+		"tags":       {Type: "text[]"},
+		"address":    {Type: "jsonb"},
+		"status":     {Type: "text"},
+		"amount":     {Type: "jsonb"},
+		"payload":    {Type: "jsonb"},
+		"value":      {Type: "jsonb"},
+	}
+	for name, w := range want {
+		c, ok := columns[name]
+		if !ok {
+			t.Errorf("Missing column %s", name)
+			continue
+		}
+		if c.Type != w.Type || c.NotNull != w.NotNull {
+			t.Errorf("Column %s: got %s (not null %v), want %s (not null %v)", name, c.Type, c.NotNull, w.Type, w.NotNull)
+		}
+	}
+
+	order := report.Tables[2]
+	if order.Name != "order" || len(order.Columns) != 2 || !order.Columns[0].NotNull {
+		t.Errorf("Expected order table with allOf columns, got %v", order)
+	}
+
+	wantIssues := map[string]bool{
+		"$/additionalProperties": true,
+		"$/properties/amount":    true,
+		"$/properties/payload":   true,
+		"$/properties/value":     true,
+		"$/$defs/Event/oneOf":    true,
+	}
+	if len(report.Issues) != len(wantIssues) {
+		t.Errorf("Expected %d issues, got %d: %v", len(wantIssues), len(report.Issues), report.Issues)
+	}
+	for _, issue := range report.Issues {
+		if !wantIssues[issue.Path.String()] || issue.Code != CodeDDLUnmappable {
+			t.Errorf("Unexpected issue: %s", issue)
+		}
+	}
+
+	sql := report.SQL()
+	for _, fragment := range []string{"CREATE TABLE \"user\" (", "    id uuid NOT NULL,", "CREATE TABLE \"order\" ("} {
+		if !strings.Contains(sql, fragment) {
+			t.Errorf("Expected SQL to contain %q, got:\n%s", fragment, sql)
+		}
+	}
+}
+
+func TestMapDDLColumnCollision(t *testing.T) {
+	s, err := ParseSchema([]byte(`{"$defs": {"Pet": {"type": "object", "properties": {"petName": {"type": "string"}, "pet_name": {"type": "string"}}}}}`))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	report := MapDDL(s)
+	if len(report.Tables) != 1 || len(report.Tables[0].Columns) != 1 {
+		t.Errorf("Expected one table with one column, got %v", report.Tables)
+	}
+	if len(report.Issues) != 1 || report.Issues[0].Path.String() != "$/$defs/Pet/properties/pet_name" {
+		t.Errorf("Expected a collision issue, got %v", report.Issues)
+	}
+}
@@ -0,0 +1,48 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// lintSchemaDeclaration checks that a root schema declares $schema, with
+// RequireSchemaDeclaration, and that it declares one of AllowedDrafts. Tools
+// interpret keywords such as items, dependencies, and exclusiveMinimum
+// differently by draft, and fall back to their own default without one.
+func (l *Linter) lintSchemaDeclaration(schema *Schema, root Path, result *Result) {
+	if schema.IsBooleanSchema {
+		return
+	}
+	path := root.Child("$schema")
+	switch {
+	case schema.Schema == "" && l.config.RequireSchemaDeclaration:
+		suggestion := "Declare the draft the schema is written for with $schema"
+		if l.config.DefaultDraft != "" {
+			suggestion = fmt.Sprintf("Declare \"$schema\": %q, or the draft the schema is written for", l.config.DefaultDraft)
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeMissingSchemaDeclaration,
+			Severity:   SeverityWarning,
+			Path:       path,
+			Message:    "Schema does not declare $schema, so each tool interprets its keywords by its own default draft",
+			Suggestion: suggestion,
+		})
+	case schema.Schema != "" && len(l.config.AllowedDrafts) > 0 && !draftAllowed(schema.Schema, l.config.AllowedDrafts):
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeDisallowedDraft,
+			Severity:   SeverityWarning,
+			Path:       path,
+			Message:    fmt.Sprintf("Schema declares %s, which is not an allowed draft", schema.Schema),
+			Suggestion: fmt.Sprintf("Declare one of the allowed drafts: %s", strings.Join(l.config.AllowedDrafts, ", ")),
+		})
+	}
+}
+
+// draftAllowed reports whether a $schema URI is one of allowed, ignoring an
+// empty fragment (e.g., "http://json-schema.org/draft-07/schema#").
+func draftAllowed(uri string, allowed []string) bool {
+	return slices.ContainsFunc(allowed, func(a string) bool {
+		return strings.TrimSuffix(a, "#") == strings.TrimSuffix(uri, "#")
+	})
+}
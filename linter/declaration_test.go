@@ -0,0 +1,68 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintSchemaDeclaration(t *testing.T) {
+	codes := func(config Config, schema string) []IssueCode {
+		t.Helper()
+		result, err := New(config).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		var found []IssueCode
+		for _, issue := range result.Issues {
+			if issue.Code == CodeMissingSchemaDeclaration || issue.Code == CodeDisallowedDraft {
+				found = append(found, issue.Code)
+			}
+		}
+		return found
+	}
+
+	undeclared := `{"type": "object"}`
+	draft7 := `{"$schema": "http://json-schema.org/draft-07/schema#", "type": "object"}`
+
+	config := DefaultConfig()
+	if got := codes(config, undeclared); len(got) != 0 {
+		t.Errorf("Expected no issues by default, got %v", got)
+	}
+	config.RequireSchemaDeclaration = true
+	if got := codes(config, undeclared); len(got) != 1 || got[0] != CodeMissingSchemaDeclaration {
+		t.Errorf("Expected missing-schema-declaration, got %v", got)
+	}
+	if got := codes(config, draft7); len(got) != 0 {
+		t.Errorf("Expected no issues for a declared draft, got %v", got)
+	}
+
+	config.AllowedDrafts = []string{"https://json-schema.org/draft/2020-12/schema"}
+	if got := codes(config, draft7); len(got) != 1 || got[0] != CodeDisallowedDraft {
+		t.Errorf("Expected disallowed-draft, got %v", got)
+	}
+	config.AllowedDrafts = append(config.AllowedDrafts, "http://json-schema.org/draft-07/schema")
+	if got := codes(config, draft7); len(got) != 0 {
+		t.Errorf("Expected the draft to be allowed without its empty fragment, got %v", got)
+	}
+
+	config.AllowedDrafts = []string{"http://json-schema.org/draft-07/schema#"}
+	if err := config.Validate(); err == nil {
+		t.Error("Expected an error for a default draft outside the allowed drafts")
+	}
+}
+
+func TestFixSchemaDeclaration(t *testing.T) {
+	config := DefaultConfig()
+	config.RequireSchemaDeclaration = true
+	config.DefaultDraft = "http://json-schema.org/draft-07/schema#"
+	fixed, issues, err := New(config).Fix([]byte(`{"type": "object", "title": "Pet"}`), CodeMissingSchemaDeclaration)
+	if err != nil {
+		t.Fatalf("Failed to fix: %v", err)
+	}
+	if len(issues) != 1 {
+		t.Fatalf("Expected one fix, got %v", issues)
+	}
+	if !strings.HasPrefix(strings.TrimSpace(string(fixed)), "{\n  \"$schema\": \"http://json-schema.org/draft-07/schema#\",\n  \"type\"") {
+		t.Errorf("Expected $schema as the first key, got %s", fixed)
+	}
+}
@@ -0,0 +1,51 @@
+package linter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// lintDefinitionCase flags $defs and definitions keys that do not follow
+// DefinitionCase. Generators name types after definitions, so the
+// convention of the type names is set here rather than by PropertyCase.
+func (l *Linter) lintDefinitionCase(schema *Schema, root Path, ignored map[string]bool, result *Result) {
+	convention := l.config.DefinitionCase
+	check := func(defs map[string]*Schema, keyword string) {
+		for _, name := range sortedKeys(defs) {
+			path := root.Child(keyword, name)
+			if ignored[path.String()] || !l.config.UnicodeNames && !isASCII(name) || matchesCase(name, convention) {
+				continue
+			}
+			suggestion := fmt.Sprintf("Rename the definition to follow the %s convention", convention)
+			if to := toCase(name, convention, l.config.Acronyms); matchesCase(to, convention) {
+				suggestion = fmt.Sprintf("Rename the definition to '%s' and update the $refs to it", to)
+			}
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeDefinitionNameCase,
+				Severity:   SeverityWarning,
+				Path:       path,
+				Message:    fmt.Sprintf("Definition '%s' is not in %s", name, convention),
+				Suggestion: suggestion,
+			})
+		}
+	}
+	check(schema.Defs, "$defs")
+	check(schema.Definitions, "definitions")
+}
+
+// renameRefs rewrites the local $refs of a document to the definition at
+// pointer from (e.g., "#/$defs/user") and into it to point to to instead.
+func renameRefs(doc any, from, to string) {
+	forEachSubschema(doc, func(obj *jsonObject) {
+		v, _ := obj.get("$ref")
+		ref, ok := v.(string)
+		if !ok {
+			return
+		}
+		if ref == from {
+			obj.set("$ref", to)
+		} else if rest, ok := strings.CutPrefix(ref, from+"/"); ok {
+			obj.set("$ref", to+"/"+rest)
+		}
+	})
+}
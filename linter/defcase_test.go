@@ -0,0 +1,59 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefinitionCase(t *testing.T) {
+	schema := `{
+  "type": "object",
+  "properties": {
+    "owner": {"$ref": "#/$defs/user_account"},
+    "city": {"$ref": "#/$defs/user_account/properties/city"}
+  },
+  "$defs": {
+    "user_account": {"type": "object", "properties": {"city": {"type": "string"}}},
+    "Address": {"type": "object", "properties": {"owner": {"$ref": "#/$defs/user_account"}}}
+  }
+}`
+	if issues := codeIssues(t, DefaultConfig(), schema, CodeDefinitionNameCase); len(issues) != 0 {
+		t.Errorf("Expected the rule to be opt-in, got %v", issues)
+	}
+
+	config := DefaultConfig()
+	config.DefinitionCase = CasePascal
+	issues := codeIssues(t, config, schema, CodeDefinitionNameCase)
+	if len(issues) != 1 || issues[0].Path.String() != "$/$defs/user_account" || !strings.Contains(issues[0].Suggestion, "'UserAccount'") {
+		t.Fatalf("Expected the snake_case definition, got %v", issues)
+	}
+
+	fixed, fixedIssues, err := New(config).Fix([]byte(schema), CodeDefinitionNameCase)
+	if err != nil || len(fixedIssues) != 1 {
+		t.Fatalf("Expected the definition to be renamed, got %v, %v", fixedIssues, err)
+	}
+	out := string(fixed)
+	if strings.Contains(out, "user_account") || strings.Count(out, `"$ref": "#/$defs/UserAccount"`) != 2 ||
+		!strings.Contains(out, `"$ref": "#/$defs/UserAccount/properties/city"`) {
+		t.Errorf("Expected the definition and its $refs to be renamed, got:\n%s", out)
+	}
+	if issues := codeIssues(t, config, out, CodeDefinitionNameCase); len(issues) != 0 {
+		t.Errorf("Expected no definition-name-case after fixing, got %v", issues)
+	}
+}
+
+// codeIssues lints schema and returns its issues with code.
+func codeIssues(t *testing.T, config Config, schema string, code IssueCode) []Issue {
+	t.Helper()
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var issues []Issue
+	for _, issue := range result.Issues {
+		if issue.Code == code {
+			issues = append(issues, issue)
+		}
+	}
+	return issues
+}
@@ -0,0 +1,308 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// DefinitionDoc documents the root schema or a definition.
+type DefinitionDoc struct {
+	// Name is the definition name, or the title (or "root") of the root schema.
+	Name string `json:"name"`
+	// Path is the location of the definition (e.g., "$/$defs/Pet").
+	Path        string `json:"path"`
+	Title       string `json:"title,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Type summarizes the accepted values (e.g., "object" or "string").
+	Type   string     `json:"type"`
+	Fields []FieldDoc `json:"fields,omitempty"`
+	// Enum lists the allowed values as JSON literals.
+	Enum  []string  `json:"enum,omitempty"`
+	Union *UnionDoc `json:"union,omitempty"`
+	// ReferencedBy lists the names of the definitions that use this one.
+	ReferencedBy []string `json:"referenced_by,omitempty"`
+}
+
+// FieldDoc documents an object property.
+type FieldDoc struct {
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Required    bool     `json:"required,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Enum        []string `json:"enum,omitempty"`
+	// Ref is the name of the definition the type refers to, if any.
+	Ref string `json:"ref,omitempty"`
+}
+
+// UnionDoc documents the variants of an anyOf/oneOf union.
+type UnionDoc struct {
+	Keyword string `json:"keyword"`
+	// Discriminator is the property that selects the variant, if any.
+	Discriminator string       `json:"discriminator,omitempty"`
+	Variants      []VariantDoc `json:"variants"`
+}
+
+// VariantDoc documents a union variant.
+type VariantDoc struct {
+	Type string `json:"type"`
+	Ref  string `json:"ref,omitempty"`
+	// Value is the variant's discriminator value.
+	Value string `json:"value,omitempty"`
+}
+
+// Document describes the root schema (unless it only bundles definitions)
+// and each definition for reference documentation: fields with their types
+// and required status, enum values, and union variants with the
+// discriminator values found with the linter's discriminator fields. Local
+// $refs are resolved to name the referenced definitions.
+func (l *Linter) Document(schema *Schema) []DefinitionDoc {
+	names := map[string]string{graphRootID: docRootName(schema)}
+	for _, name := range sortedKeys(schema.Defs) {
+		names["#/$defs/"+escapePointer(name)] = name
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		names["#/definitions/"+escapePointer(name)] = name
+	}
+	referencedBy := make(map[string][]string)
+	for _, edge := range BuildGraph(schema).Edges {
+		to, from := names[definitionID(edge.To)], names[edge.From]
+		if to != "" && to != from && !slices.Contains(referencedBy[to], from) {
+			referencedBy[to] = append(referencedBy[to], from)
+		}
+	}
+
+	d := &documenter{linter: l, doc: schema, names: names}
+	var docs []DefinitionDoc
+	add := func(id string, path Path, s *Schema) {
+		if s == nil {
+			return
+		}
+		def := d.definition(names[id], path, s)
+		def.ReferencedBy = referencedBy[names[id]]
+		sort.Strings(def.ReferencedBy)
+		docs = append(docs, def)
+	}
+	if !isDefinitionBundle(schema) {
+		add(graphRootID, rootPath, schema)
+	}
+	for _, name := range sortedKeys(schema.Defs) {
+		add("#/$defs/"+escapePointer(name), rootPath.Child("$defs", name), schema.Defs[name])
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		add("#/definitions/"+escapePointer(name), rootPath.Child("definitions", name), schema.Definitions[name])
+	}
+	return docs
+}
+
+// docRootName returns the documentation name of the root schema.
+func docRootName(schema *Schema) string {
+	if schema.Title != "" {
+		return schema.Title
+	}
+	return "root"
+}
+
+type documenter struct {
+	linter *Linter
+	doc    *Schema
+	// names maps definition IDs (e.g., "#/$defs/Pet") to their names.
+	names map[string]string
+}
+
+func (d *documenter) definition(name string, path Path, s *Schema) DefinitionDoc {
+	def := DefinitionDoc{
+		Name:        name,
+		Path:        path.String(),
+		Title:       s.Title,
+		Description: s.Description,
+		Type:        d.typeName(s),
+		Enum:        docEnum(s),
+	}
+
+	properties, required := s.Properties, make(map[string]bool)
+	for _, req := range s.Required {
+		required[req] = true
+	}
+	// Fields of allOf parts are listed with the definition's own
+	for _, part := range s.AllOf {
+		target := d.resolve(part)
+		if target == nil || len(target.Properties) == 0 {
+			continue
+		}
+		merged := make(map[string]*Schema, len(properties)+len(target.Properties))
+		for k, v := range target.Properties {
+			merged[k] = v
+		}
+		for k, v := range properties {
+			merged[k] = v
+		}
+		properties = merged
+		for _, req := range target.Required {
+			required[req] = true
+		}
+	}
+	for _, prop := range sortedKeys(properties) {
+		p := properties[prop]
+		field := FieldDoc{Name: prop, Required: required[prop]}
+		if p != nil {
+			field.Type = d.typeName(p)
+			field.Description = p.Description
+			field.Enum = docEnum(p)
+			field.Ref = d.refName(p)
+		} else {
+			field.Type = "any"
+		}
+		def.Fields = append(def.Fields, field)
+	}
+
+	if s.IsUnion() {
+		def.Union = d.union(s)
+	}
+	return def
+}
+
+// union documents the variants of a union, with their discriminator values.
+func (d *documenter) union(s *Schema) *UnionDoc {
+	u := &UnionDoc{Keyword: "anyOf"}
+	variants := s.AnyOf
+	if len(variants) == 0 {
+		u.Keyword, variants = "oneOf", s.OneOf
+	}
+
+	resolved := make([]*Schema, 0, len(variants))
+	for _, v := range variants {
+		if target := d.resolve(v); target != nil {
+			resolved = append(resolved, target)
+		}
+	}
+	var disc *discriminatorInfo
+	if len(resolved) == len(variants) {
+		disc = d.linter.findDiscriminator(resolved)
+	}
+	if disc != nil {
+		u.Discriminator = disc.fieldName
+	}
+
+	for i, v := range variants {
+		variant := VariantDoc{Type: "any"}
+		if v != nil {
+			variant.Type = d.typeName(v)
+			variant.Ref = d.refName(v)
+		}
+		if disc != nil {
+			if prop := resolved[i].Properties[disc.fieldName]; prop != nil {
+				variant.Value, _ = prop.Const.(string)
+			}
+		}
+		u.Variants = append(u.Variants, variant)
+	}
+	return u
+}
+
+// resolve returns the schema a local $ref refers to, or the schema itself.
+func (d *documenter) resolve(s *Schema) *Schema {
+	if s == nil {
+		return nil
+	}
+	ref := s.RefTarget()
+	if ref == "" {
+		return s
+	}
+	target, _, ok := resolveLocalRef(d.doc, rootPath, ref)
+	if !ok {
+		return nil
+	}
+	return target
+}
+
+// refName returns the name of the definition a schema or its array items
+// refer to, or "".
+func (d *documenter) refName(s *Schema) string {
+	if s.RefTarget() == "" && s.Items != nil {
+		s = s.Items
+	}
+	ref := s.RefTarget()
+	if ref == "" {
+		return ""
+	}
+	if _, target, ok := resolveLocalRef(d.doc, NewPath(graphRootID), ref); ok {
+		return d.names[definitionID(target.String())]
+	}
+	return ""
+}
+
+// typeName summarizes the values a schema accepts (e.g., "string
+// (date-time)", "array of Pet", or "string | null").
+func (d *documenter) typeName(s *Schema) string {
+	if s.IsBooleanSchema {
+		if s.BooleanValue {
+			return "any"
+		}
+		return "never"
+	}
+	if ref := s.RefTarget(); ref != "" {
+		if name := d.refName(s); name != "" {
+			return name
+		}
+		return ref
+	}
+	if s.Const != nil {
+		return "const " + enumLiteral(s.Const)
+	}
+	if s.IsUnion() {
+		parts := make([]string, 0, len(s.GetUnionVariants()))
+		for _, v := range s.GetUnionVariants() {
+			if v == nil {
+				continue
+			}
+			parts = append(parts, d.typeName(v))
+		}
+		return strings.Join(parts, " | ")
+	}
+
+	types := s.TypeList
+	if len(types) == 0 {
+		switch kind := schemaKind(s); {
+		case kind != "":
+			types = []string{kind}
+		case s.Items != nil:
+			types = []string{"array"}
+		case len(s.Enum) > 0:
+			types = []string{"enum"}
+		case len(s.AllOf) == 1:
+			return d.typeName(s.AllOf[0])
+		case len(s.AllOf) > 1:
+			types = []string{"object"}
+		default:
+			return "any"
+		}
+	}
+	parts := make([]string, len(types))
+	for i, t := range types {
+		switch {
+		case t == "array" && s.Items != nil:
+			parts[i] = "array of " + d.typeName(s.Items)
+		case t == "object" && len(s.Properties) == 0 && s.AdditionalPropertiesSchema != nil:
+			parts[i] = "map of " + d.typeName(s.AdditionalPropertiesSchema)
+		case t == "string" && s.Format != "":
+			parts[i] = fmt.Sprintf("string (%s)", s.Format)
+		default:
+			parts[i] = t
+		}
+	}
+	return strings.Join(parts, " | ")
+}
+
+// docEnum returns the enum values of a schema as JSON literals.
+func docEnum(s *Schema) []string {
+	if len(s.Enum) == 0 {
+		return nil
+	}
+	values := make([]string, len(s.Enum))
+	for i, v := range s.Enum {
+		values[i] = enumLiteral(v)
+	}
+	return values
+}
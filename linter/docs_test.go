@@ -0,0 +1,105 @@
+package linter
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestDocument(t *testing.T) {
+	schema := `{
+		"title": "Store",
+		"type": "object",
+		"required": ["pet"],
+		"properties": {
+			"pet": {"$ref": "#/$defs/Pet"},
+			"owners": {"type": "array", "items": {"$ref": "#/$defs/Owner"}}
+		},
+		"$defs": {
+			"Pet": {"oneOf": [{"$ref": "#/$defs/Cat"}, {"$ref": "#/$defs/Dog"}]},
+			"Cat": {
+				"type": "object",
+				"required": ["kind"],
+				"properties": {"kind": {"const": "cat"}, "lives": {"type": "integer"}}
+			},
+			"Dog": {
+				"type": "object",
+				"required": ["kind"],
+				"properties": {"kind": {"const": "dog"}, "size": {"type": "string", "enum": ["small", "large"]}}
+			},
+			"Owner": {
+				"type": "object",
+				"properties": {"since": {"type": ["string", "null"], "format": "date"}}
+			}
+		}
+	}`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	docs := NewWithDefaults().Document(s)
+
+	byName := make(map[string]DefinitionDoc)
+	var names []string
+	for _, d := range docs {
+		byName[d.Name] = d
+		names = append(names, d.Name)
+	}
+	if want := []string{"Store", "Cat", "Dog", "Owner", "Pet"}; !reflect.DeepEqual(names, want) {
+		t.Fatalf("Expected definitions %v, got %v", want, names)
+	}
+
+	store := byName["Store"]
+	wantFields := []FieldDoc{
+		{Name: "owners", Type: "array of Owner", Ref: "Owner"},
+		{Name: "pet", Type: "Pet", Required: true, Ref: "Pet"},
+	}
+	if !reflect.DeepEqual(store.Fields, wantFields) {
+		t.Errorf("Expected root fields %v, got %v", wantFields, store.Fields)
+	}
+
+	pet := byName["Pet"]
+	wantUnion := &UnionDoc{
+		Keyword:       "oneOf",
+		Discriminator: "kind",
+		Variants: []VariantDoc{
+			{Type: "Cat", Ref: "Cat", Value: "cat"},
+			{Type: "Dog", Ref: "Dog", Value: "dog"},
+		},
+	}
+	if !reflect.DeepEqual(pet.Union, wantUnion) {
+		t.Errorf("Expected union %+v, got %+v", wantUnion, pet.Union)
+	}
+	if !reflect.DeepEqual(pet.ReferencedBy, []string{"Store"}) {
+		t.Errorf("Expected Pet to be referenced by Store, got %v", pet.ReferencedBy)
+	}
+
+	if size := byName["Dog"].Fields[1]; !reflect.DeepEqual(size.Enum, []string{`"small"`, `"large"`}) {
+		t.Errorf("Expected enum values, got %v", size.Enum)
+	}
+	if since := byName["Owner"].Fields[0]; since.Type != "string (date) | null" {
+		t.Errorf("Expected nullable date type, got %q", since.Type)
+	}
+}
+
+func TestDocumentEscapedNames(t *testing.T) {
+	schema := `{
+		"properties": {"tag": {"$ref": "#/$defs/a~1b"}},
+		"$defs": {"a/b": {"type": "object"}}
+	}`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	docs := NewWithDefaults().Document(s)
+	if len(docs) != 2 {
+		t.Fatalf("Expected 2 definitions, got %d", len(docs))
+	}
+	if ref := docs[0].Fields[0].Ref; ref != "a/b" {
+		t.Errorf("Expected tag to refer to a/b, got %q", ref)
+	}
+	if def := docs[1]; def.Path != "$/$defs/a~1b" || !reflect.DeepEqual(def.ReferencedBy, []string{"root"}) {
+		t.Errorf("Expected a/b at $/$defs/a~1b referenced by root, got %s referenced by %v", def.Path, def.ReferencedBy)
+	}
+}
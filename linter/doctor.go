@@ -0,0 +1,271 @@
+package linter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"unicode/utf8"
+)
+
+// MaxJSONDepth is the JSON nesting depth above which Diagnose reports
+// deep-json-nesting.
+const MaxJSONDepth = 64
+
+// utf8BOM is the UTF-8 byte order mark.
+var utf8BOM = []byte{0xEF, 0xBB, 0xBF}
+
+// Diagnose checks schema file data for problems outside the scope of lint
+// rules that cause confusing behavior: a byte order mark, invalid UTF-8,
+// duplicate object keys, extremely deep nesting, and definitions that
+// cannot be reached from the root schema. It returns an error only if the
+// data is not valid JSON.
+func Diagnose(data []byte) (*Result, error) {
+	result := &Result{Issues: []Issue{}}
+
+	if bytes.HasPrefix(data, utf8BOM) {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeByteOrderMark,
+			Severity:   SeverityError,
+			Path:       rootPath,
+			Message:    "File starts with a UTF-8 byte order mark, which schemakit skips but encoding/json and many other JSON tools reject",
+			Suggestion: "Save the file as UTF-8 without a BOM",
+		})
+		data = data[len(utf8BOM):]
+	}
+	data, err := NormalizeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if offset := invalidUTF8Offset(data); offset >= 0 {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeInvalidUTF8,
+			Severity:   SeverityWarning,
+			Path:       rootPath,
+			Message:    fmt.Sprintf("Invalid UTF-8 at byte offset %d; encoding/json silently replaces it with U+FFFD", offset),
+			Suggestion: "Re-encode the file as UTF-8",
+		})
+	}
+
+	schemas, composite, err := ParseDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+
+	issues, err := scanJSON(data, composite)
+	if err != nil {
+		return nil, err
+	}
+	result.Issues = append(result.Issues, issues...)
+
+	for i, schema := range schemas {
+		root := documentPath(i, composite)
+		result.Issues = append(result.Issues, unreachableDefinitions(schema, root)...)
+	}
+	return result, nil
+}
+
+// DiagnoseFile checks a schema file; see Diagnose.
+func DiagnoseFile(path string) (*Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	result, err := Diagnose(data)
+	if err != nil {
+		return nil, err
+	}
+	result.SchemaPath = path
+	return result, nil
+}
+
+// invalidUTF8Offset returns the byte offset of the first invalid UTF-8
+// sequence in data, or -1 if data is valid UTF-8.
+func invalidUTF8Offset(data []byte) int {
+	if utf8.Valid(data) {
+		return -1
+	}
+	for i := 0; i < len(data); {
+		r, size := utf8.DecodeRune(data[i:])
+		if r == utf8.RuneError && size == 1 {
+			return i
+		}
+		i += size
+	}
+	return -1
+}
+
+// jsonFrame is an object or array being scanned.
+type jsonFrame struct {
+	path      Path
+	object    bool
+	keys      map[string]bool
+	key       string
+	expectKey bool
+	index     int
+	// documents is true for the top-level array of a composite document,
+	// whose elements are addressed as [i].
+	documents bool
+}
+
+// scanJSON reads data token by token to find duplicate object keys, which
+// encoding/json collapses silently (the last value wins), and nesting
+// deeper than MaxJSONDepth. Paths use the lint conventions, with [i] for
+// the documents of a composite file.
+func scanJSON(data []byte, composite bool) ([]Issue, error) {
+	var issues []Issue
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var stack []*jsonFrame
+	docs := 0
+	deepReported := false
+	for {
+		tok, err := dec.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse JSON: %w", err)
+		}
+
+		var top *jsonFrame
+		if len(stack) > 0 {
+			top = stack[len(stack)-1]
+		}
+		delim, isDelim := tok.(json.Delim)
+
+		// Object keys and closing delimiters
+		if top != nil && top.object && top.expectKey {
+			if isDelim {
+				stack = stack[:len(stack)-1]
+				continue
+			}
+			key, _ := tok.(string)
+			if top.keys[key] {
+				line, column := position(data, keyStart(data, int(dec.InputOffset())))
+				issues = append(issues, Issue{
+					Code:       CodeDuplicateKey,
+					Severity:   SeverityError,
+					Path:       top.path.Child(key),
+					Message:    fmt.Sprintf("Duplicate key '%s' at line %d, column %d; encoding/json keeps only the last value", key, line, column),
+					Suggestion: "Remove or merge the duplicate entries",
+					Line:       line,
+					Column:     column,
+				})
+			}
+			top.keys[key] = true
+			top.key, top.expectKey = key, false
+			continue
+		}
+		if isDelim && delim == ']' {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+
+		// A value: compute its path and advance the parent
+		var path Path
+		switch {
+		case top == nil && composite && (!isDelim || delim != '['):
+			path = documentPath(docs, true)
+			docs++
+		case top == nil:
+			path = rootPath
+		case top.documents:
+			path = documentPath(top.index, true)
+			top.index++
+		case top.object:
+			path = top.path.Child(top.key)
+			top.expectKey = true
+		default:
+			path = top.path.Index(top.index)
+			top.index++
+		}
+
+		if !isDelim {
+			continue
+		}
+		stack = append(stack, &jsonFrame{
+			path:      path,
+			object:    delim == '{',
+			keys:      make(map[string]bool),
+			expectKey: true,
+			documents: top == nil && composite && delim == '[',
+		})
+		if len(stack) > MaxJSONDepth && !deepReported {
+			deepReported = true
+			issues = append(issues, Issue{
+				Code:       CodeDeepJSONNesting,
+				Severity:   SeverityWarning,
+				Path:       path,
+				Message:    fmt.Sprintf("JSON is nested more than %d levels deep", MaxJSONDepth),
+				Suggestion: "Move deeply nested schemas into $defs and reference them with $ref",
+			})
+		}
+	}
+	return issues, nil
+}
+
+// keyStart returns the offset of the opening quote of the string token that
+// ends at offset end.
+func keyStart(data []byte, end int) int {
+	for i := end - 2; i >= 0; i-- {
+		if data[i] != '"' {
+			continue
+		}
+		backslashes := 0
+		for j := i - 1; j >= 0 && data[j] == '\\'; j-- {
+			backslashes++
+		}
+		if backslashes%2 == 0 {
+			return i
+		}
+	}
+	return 0
+}
+
+// position returns the 1-based line and column (in bytes) of offset.
+func position(data []byte, offset int) (line, column int) {
+	line = 1 + bytes.Count(data[:offset], []byte("\n"))
+	column = offset + 1
+	if i := bytes.LastIndexByte(data[:offset], '\n'); i >= 0 {
+		column = offset - i
+	}
+	return line, column
+}
+
+// unreachableDefinitions reports definitions that no $ref chain from the
+// root schema reaches. Documents whose root only bundles definitions are
+// not checked.
+func unreachableDefinitions(schema *Schema, root Path) []Issue {
+	if schema == nil || (len(schema.Defs) == 0 && len(schema.Definitions) == 0) || isDefinitionBundle(schema) {
+		return nil
+	}
+
+	reached := reachableDefinitions(schema, root, []Path{root})
+	var issues []Issue
+	for _, path := range definitionPaths(schema, root) {
+		if reached[path.String()] {
+			continue
+		}
+		issues = append(issues, Issue{
+			Code:       CodeUnreachableDefinition,
+			Severity:   SeverityWarning,
+			Path:       path,
+			Message:    "Definition is not referenced from the root schema",
+			Suggestion: "Remove the definition, or reference it where it is meant to be used",
+		})
+	}
+	return issues
+}
+
+// isDefinitionBundle returns true if the root schema declares nothing but
+// definitions (and metadata), as in a shared library of types.
+func isDefinitionBundle(s *Schema) bool {
+	return !s.HasType() && !s.IsRef() && !s.IsUnion() && len(s.AllOf) == 0 &&
+		len(s.Properties) == 0 && s.Items == nil && s.AdditionalPropertiesSchema == nil
+}
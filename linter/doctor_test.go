@@ -0,0 +1,82 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiagnose(t *testing.T) {
+	data := "\xef\xbb\xbf" + `{
+		"type": "object",
+		"properties": {
+			"a": {"$ref": "#/$defs/A"},
+			"b": {"type": "string", "description": "caf` + "\xe9" + `", "type": "string"}
+		},
+		"$defs": {
+			"A": {"$ref": "#/$defs/B"},
+			"B": {"type": "string"},
+			"Unused": {"type": "string"}
+		}
+	}`
+
+	result, err := Diagnose([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to diagnose: %v", err)
+	}
+
+	want := map[IssueCode]string{
+		CodeByteOrderMark:         "$",
+		CodeInvalidUTF8:           "$",
+		CodeDuplicateKey:          "$/properties/b/type",
+		CodeUnreachableDefinition: "$/$defs/Unused",
+	}
+	if len(result.Issues) != len(want) {
+		t.Errorf("Expected %d issues, got: %v", len(want), result.Issues)
+	}
+	for _, issue := range result.Issues {
+		if path, ok := want[issue.Code]; !ok || issue.Path.String() != path {
+			t.Errorf("Unexpected issue: %s", issue)
+		}
+	}
+}
+
+func TestDiagnoseComposite(t *testing.T) {
+	data := `[{"type": "string"}, {"properties": {"x": {"type": "string"}, "x": {"type": "integer"}}}]`
+	result, err := Diagnose([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to diagnose: %v", err)
+	}
+	if len(result.Issues) != 1 || result.Issues[0].Path.String() != "[1]/properties/x" {
+		t.Errorf("Expected duplicate key at [1]/properties/x, got: %v", result.Issues)
+	}
+}
+
+func TestDiagnoseDeepNesting(t *testing.T) {
+	depth := MaxJSONDepth + 1
+	data := strings.Repeat(`{"items":`, depth) + `{}` + strings.Repeat(`}`, depth)
+	result, err := Diagnose([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to diagnose: %v", err)
+	}
+	if len(result.Issues) != 1 || result.Issues[0].Code != CodeDeepJSONNesting {
+		t.Fatalf("Expected one deep-json-nesting issue, got: %v", result.Issues)
+	}
+	if want := "$" + strings.Repeat("/items", MaxJSONDepth); result.Issues[0].Path.String() != want {
+		t.Errorf("Expected path %s, got %s", want, result.Issues[0].Path)
+	}
+}
+
+func TestDiagnoseDefinitionBundle(t *testing.T) {
+	data := `{"$defs": {"A": {"type": "string"}, "B": {"type": "integer"}}}`
+	result, err := Diagnose([]byte(data))
+	if err != nil {
+		t.Fatalf("Failed to diagnose: %v", err)
+	}
+	if len(result.Issues) != 0 {
+		t.Errorf("Expected no issues for a definition bundle, got: %v", result.Issues)
+	}
+
+	if _, err := Diagnose([]byte(`{"type": `)); err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
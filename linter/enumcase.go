@@ -0,0 +1,102 @@
+package linter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// enumConventions are the conventions ConsistentEnumCase chooses from, in
+// the order that breaks ties.
+var enumConventions = []PropertyCase{CaseScreamingSnake, CaseSnake, CaseKebab, CaseCamel, CasePascal}
+
+// enumCase returns the convention enforced for enum members, or CaseNone.
+func (c Config) enumCase() PropertyCase {
+	if c.EnumCase == "" {
+		return CaseNone
+	}
+	return c.EnumCase
+}
+
+// enumConvention returns the convention the string members of an enum
+// must follow: EnumCase, or with ConsistentEnumCase, the one most of them
+// follow. It returns CaseNone if there is none.
+func (c Config) enumConvention(members []any) PropertyCase {
+	if convention := c.enumCase(); convention != CaseNone || !c.ConsistentEnumCase {
+		return convention
+	}
+	sample := make(caseSample)
+	for _, member := range members {
+		if s, ok := member.(string); ok {
+			sample.count(s, enumConventions)
+		}
+	}
+	return sample.dominant(enumConventions)
+}
+
+// lintEnumCase flags the string members of an enum that do not follow the
+// enum convention. Generators turn enum members into constants, so mixed
+// conventions give inconsistently named constants.
+func (l *Linter) lintEnumCase(schema *Schema, path Path, result *Result) {
+	convention := l.config.enumConvention(schema.Enum)
+	if convention == CaseNone {
+		return
+	}
+	var outliers []string
+	for _, member := range schema.Enum {
+		if s, ok := member.(string); ok && !matchesCase(s, convention) {
+			outliers = append(outliers, fmt.Sprintf("'%s'", s))
+		}
+	}
+	if len(outliers) == 0 {
+		return
+	}
+	message := fmt.Sprintf("Enum member %s is not in %s", outliers[0], convention)
+	if len(outliers) > 1 {
+		message = fmt.Sprintf("Enum members %s are not in %s", strings.Join(outliers, ", "), convention)
+	}
+	if l.config.enumCase() == CaseNone {
+		message += ", the convention of most members of the enum"
+	}
+	result.Issues = append(result.Issues, Issue{
+		Code:       CodeEnumMemberCase,
+		Severity:   SeverityWarning,
+		Path:       path.Child("enum"),
+		Message:    message,
+		Suggestion: fmt.Sprintf("Rename the members to follow the %s convention", convention),
+	})
+}
+
+// renameEnumMembers renames the string members of an enum to follow
+// convention, and a default or const naming one of them, reporting whether
+// any changed. Nothing is renamed if two members would get the same name.
+func renameEnumMembers(schema *jsonObject, members []any, convention PropertyCase, acronyms []string) bool {
+	renamed := make(map[string]string)
+	seen := make(map[string]bool)
+	out := make([]any, len(members))
+	for i, member := range members {
+		out[i] = member
+		s, ok := member.(string)
+		if !ok {
+			continue
+		}
+		if name := toCase(s, convention, acronyms); !matchesCase(s, convention) && matchesCase(name, convention) {
+			out[i], renamed[s], s = name, name, name
+		}
+		if seen[s] {
+			return false
+		}
+		seen[s] = true
+	}
+	if len(renamed) == 0 {
+		return false
+	}
+	schema.set("enum", out)
+	for _, key := range []string{"default", "const"} {
+		if v, ok := schema.get(key); ok {
+			if s, ok := v.(string); ok && renamed[s] != "" {
+				schema.set(key, renamed[s])
+			}
+		}
+	}
+	return true
+}
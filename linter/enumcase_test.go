@@ -0,0 +1,79 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEnumCase(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"status": {"type": "string", "enum": ["IN_PROGRESS", "done", "onHold"], "default": "done"},
+			"level": {"type": "string", "enum": ["low", "high", "VERY_HIGH"]},
+			"code": {"enum": [1, 2, null]}
+		}
+	}`)
+	messages := func(config Config) map[string]string {
+		result, err := New(config).Lint(schema)
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		found := make(map[string]string)
+		for _, issue := range result.Issues {
+			if issue.Code == CodeEnumMemberCase {
+				found[issue.Path.String()] = issue.Message
+			}
+		}
+		return found
+	}
+
+	if got := messages(DefaultConfig()); len(got) != 0 {
+		t.Errorf("Expected the rule to be opt-in, got %v", got)
+	}
+
+	config := DefaultConfig()
+	config.EnumCase = CaseScreamingSnake
+	got := messages(config)
+	if len(got) != 2 || !strings.Contains(got["$/properties/status/enum"], "'done', 'onHold'") {
+		t.Errorf("Expected the members not in SCREAMING_SNAKE_CASE, got %v", got)
+	}
+
+	config = DefaultConfig()
+	config.ConsistentEnumCase = true
+	got = messages(config)
+	if len(got) != 2 || !strings.Contains(got["$/properties/level/enum"], "'VERY_HIGH' is not in snake_case") ||
+		!strings.Contains(got["$/properties/status/enum"], "'IN_PROGRESS' is not in camelCase") {
+		t.Errorf("Expected the outlier of each enum, got %v", got)
+	}
+
+	config.EnumCase = "SHOUTING"
+	if err := config.Validate(); err == nil {
+		t.Error("Expected an error for an unknown enum case")
+	}
+}
+
+func TestFixEnumCase(t *testing.T) {
+	schema := `{"type": "string", "enum": ["inProgress", "done", "ON_HOLD"], "default": "inProgress"}`
+	config := DefaultConfig()
+	config.EnumCase = CaseScreamingSnake
+	l := New(config)
+
+	if _, issues, err := l.Fix([]byte(schema)); err != nil || len(issues) != 0 {
+		t.Errorf("Expected enum members to be renamed only on request, got %v, %v", issues, err)
+	}
+	fixed, issues, err := l.Fix([]byte(schema), CodeEnumMemberCase)
+	if err != nil || len(issues) != 1 {
+		t.Fatalf("Expected the enum to be fixed, got %v, %v", issues, err)
+	}
+	for _, want := range []string{`"IN_PROGRESS",`, `"DONE",`, `"default": "IN_PROGRESS"`} {
+		if !strings.Contains(string(fixed), want) {
+			t.Errorf("Expected %s in the fixed schema, got:\n%s", want, fixed)
+		}
+	}
+
+	clash := `{"type": "string", "enum": ["done", "DONE"]}`
+	if _, issues, err := l.Fix([]byte(clash), CodeEnumMemberCase); err != nil || len(issues) != 0 {
+		t.Errorf("Expected no fix when members would clash, got %v, %v", issues, err)
+	}
+}
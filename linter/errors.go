@@ -0,0 +1,97 @@
+package linter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+// Sentinel errors for schemas that cannot be linted. Errors returned by
+// Lint and LintFile wrap one of them when the schema itself is the problem;
+// other errors (e.g., a file that cannot be read) mean the linter failed.
+var (
+	// ErrParse is wrapped by errors for data that is not a JSON Schema
+	// document, such as invalid JSON.
+	ErrParse = errors.New("failed to parse JSON Schema")
+	// ErrUnresolvedRef is wrapped by errors for references that do not
+	// resolve, such as a configured root.
+	ErrUnresolvedRef = errors.New("unresolved $ref")
+	// ErrUnsupportedDraft is wrapped by errors for schemas declaring a
+	// $schema draft older than draft-04.
+	ErrUnsupportedDraft = errors.New("unsupported draft")
+)
+
+// LintError is an error in a schema, located by file and position when
+// known. Use errors.Is with the sentinel errors to classify it.
+type LintError struct {
+	// File is the schema file, set by LintFile.
+	File string
+	// Line and Column are the 1-based position of the error, or 0 if the
+	// error has no position.
+	Line   int
+	Column int
+	Err    error
+}
+
+func (e *LintError) Error() string {
+	var loc string
+	switch {
+	case e.File != "" && e.Line > 0:
+		loc = fmt.Sprintf("%s:%d:%d: ", e.File, e.Line, e.Column)
+	case e.File != "":
+		loc = e.File + ": "
+	case e.Line > 0:
+		loc = fmt.Sprintf("line %d, column %d: ", e.Line, e.Column)
+	}
+	return loc + e.Err.Error()
+}
+
+func (e *LintError) Unwrap() error { return e.Err }
+
+// ParseErrorResult returns the result of a schema file that could not be
+// parsed: a parse-error issue with the error, located at its line and
+// column when known, so that a run over many files can report the file
+// and lint the others. err should wrap ErrParse.
+func ParseErrorResult(path string, err error) *Result {
+	issue := Issue{
+		Code:       CodeParseError,
+		Severity:   SeverityError,
+		Path:       rootPath,
+		Message:    err.Error(),
+		Suggestion: "Fix the JSON syntax, or check that the file is a JSON Schema",
+	}
+	var le *LintError
+	if errors.As(err, &le) {
+		issue.Message = le.Err.Error()
+		issue.Line, issue.Column = le.Line, le.Column
+	}
+	return &Result{SchemaPath: path, Issues: []Issue{issue}}
+}
+
+// parseError returns a LintError wrapping ErrParse for a JSON decoding
+// error. Syntax errors are located in data, offset by base bytes.
+func parseError(data []byte, base int, err error) error {
+	le := &LintError{Err: fmt.Errorf("%w: %w", ErrParse, err)}
+	var syntax *json.SyntaxError
+	if errors.As(err, &syntax) {
+		if offset := base + int(syntax.Offset); offset > 0 && offset <= len(data) {
+			le.Line, le.Column = position(data, offset-1)
+		}
+	}
+	return le
+}
+
+// unsupportedDraft matches the $schema URIs of drafts before draft-04,
+// whose keywords (e.g., a boolean required, divisibleBy) the linter does
+// not understand.
+var unsupportedDraft = regexp.MustCompile(`^https?://json-schema\.org/draft-0[0-3]/`)
+
+// checkDraft returns a LintError wrapping ErrUnsupportedDraft if the
+// document declares an unsupported draft.
+func checkDraft(schema *Schema) error {
+	if schema == nil || !unsupportedDraft.MatchString(schema.Schema) {
+		return nil
+	}
+	return &LintError{Err: fmt.Errorf("%w: %s (supported: draft-04 through 2020-12)", ErrUnsupportedDraft, schema.Schema)}
+}
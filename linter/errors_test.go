@@ -0,0 +1,79 @@
+package linter
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLintErrors(t *testing.T) {
+	tests := []struct {
+		name     string
+		schema   string
+		roots    []string
+		sentinel error
+		line     int
+		column   int
+	}{
+		{"syntax", "{\n  \"type\": \"object\",\n  \"properties\": {]\n}", nil, ErrParse, 3, 18},
+		{"type", `{"required": "name"}`, nil, ErrParse, 0, 0},
+		{"empty", "  ", nil, ErrParse, 0, 0},
+		{"root", `{"$defs": {"A": {}}}`, []string{"#/$defs/B"}, ErrUnresolvedRef, 0, 0},
+		{"draft", `{"$schema": "http://json-schema.org/draft-03/schema#"}`, nil, ErrUnsupportedDraft, 0, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Roots = tt.roots
+			_, err := New(config).Lint([]byte(tt.schema))
+			if !errors.Is(err, tt.sentinel) {
+				t.Fatalf("Expected error wrapping %v, got: %v", tt.sentinel, err)
+			}
+			var le *LintError
+			if !errors.As(err, &le) {
+				t.Fatalf("Expected a *LintError, got %T", err)
+			}
+			if le.Line != tt.line || le.Column != tt.column {
+				t.Errorf("Expected position %d:%d, got %d:%d", tt.line, tt.column, le.Line, le.Column)
+			}
+		})
+	}
+}
+
+func TestLintFileError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "broken.json")
+	if err := os.WriteFile(path, []byte(`{"type": }`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, err := NewWithDefaults().LintFile(path)
+	var le *LintError
+	if !errors.As(err, &le) || le.File != path || le.Line != 1 || le.Column != 10 {
+		t.Fatalf("Expected a located *LintError for %s, got: %v", path, err)
+	}
+	if want := path + ":1:10: failed to parse JSON Schema: invalid character '}' looking for beginning of value"; err.Error() != want {
+		t.Errorf("Expected %q, got %q", want, err.Error())
+	}
+
+	_, err = NewWithDefaults().LintFile(filepath.Join(t.TempDir(), "missing.json"))
+	if err == nil || errors.As(err, &le) {
+		t.Errorf("Expected a read error that is not a *LintError, got: %v", err)
+	}
+}
+
+func TestParseErrorResult(t *testing.T) {
+	_, err := NewWithDefaults().Lint([]byte("{\n  \"type\": }"))
+	result := ParseErrorResult("broken.json", err)
+	if result.SchemaPath != "broken.json" || len(result.Issues) != 1 || !result.HasErrors() {
+		t.Fatalf("Expected one error for broken.json, got %+v", result)
+	}
+	issue := result.Issues[0]
+	if issue.Code != CodeParseError || issue.Path.String() != "$" || issue.Line != 2 || issue.Column != 11 {
+		t.Errorf("Expected a parse-error at 2:11, got %+v", issue)
+	}
+	if want := "failed to parse JSON Schema: invalid character '}' looking for beginning of value"; issue.Message != want {
+		t.Errorf("Expected message %q, got %q", want, issue.Message)
+	}
+}
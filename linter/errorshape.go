@@ -0,0 +1,171 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// errorSchema is an object describing an error (see isErrorSchema), with
+// the shape of its properties.
+type errorSchema struct {
+	root  Path
+	path  Path
+	shape string
+}
+
+// lintErrorShapes reports error schemas whose structure differs from the
+// canonical one, grouped by the root path of the document they occur in.
+// The canonical structure is that of the ErrorSchema definition in
+// documents where it resolves, and otherwise the one used by most error
+// schemas across the documents. Error schemas nested in another error
+// schema (e.g., the items of its details) are not compared.
+func (l *Linter) lintErrorShapes(schemas []*Schema, roots []Path) map[string][]Issue {
+	var found []errorSchema
+	references := make(map[string]errorSchema)
+	for i, schema := range schemas {
+		if schema == nil {
+			continue
+		}
+		root := roots[i]
+		var refPath Path
+		if l.config.ErrorSchema != "" {
+			if reference, path, ok := l.resolveRef(schema, root, l.config.ErrorSchema); ok && reference != nil {
+				refPath = path
+				references[root.Root] = errorSchema{root: root, path: path, shape: l.errorShape(schema, root, reference)}
+			}
+		}
+		for _, e := range l.errorSchemas(schema, root) {
+			if refPath.Root == "" || !e.path.HasPrefix(refPath) {
+				found = append(found, e)
+			}
+		}
+	}
+
+	var unreferenced []errorSchema
+	for _, e := range found {
+		if _, ok := references[e.root.Root]; !ok {
+			unreferenced = append(unreferenced, e)
+		}
+	}
+	shapes := make([]string, len(unreferenced))
+	for i, e := range unreferenced {
+		shapes[i] = e.shape
+	}
+	canonical, n, majority := conventionalShape(shapes)
+
+	byRoot := make(map[string][]Issue)
+	for _, e := range found {
+		issue := Issue{
+			Code:     CodeInconsistentErrorShape,
+			Severity: SeverityWarning,
+			Path:     e.path,
+		}
+		if ref, ok := references[e.root.Root]; ok {
+			if e.shape == ref.shape {
+				continue
+			}
+			issue.Message = fmt.Sprintf("Error schema %s differs from the canonical error schema %s %s",
+				e.shape, l.config.ErrorSchema, ref.shape)
+			issue.Suggestion = fmt.Sprintf("Reference %s with $ref instead of defining an error structure", l.config.ErrorSchema)
+		} else {
+			if !majority || e.shape == canonical {
+				continue
+			}
+			issue.Message = fmt.Sprintf("Error schema %s differs from the error schema %s used by %d of %d error schemas",
+				e.shape, canonical, n, len(unreferenced))
+			issue.Suggestion = fmt.Sprintf("Use the %s error structure, ideally as one shared definition referenced with $ref (see error_schema)", canonical)
+		}
+		byRoot[e.root.Root] = append(byRoot[e.root.Root], issue)
+	}
+	return byRoot
+}
+
+// errorSchemas returns the outermost error schemas in the document and its
+// definitions, skipping vendored definitions.
+func (l *Linter) errorSchemas(doc *Schema, root Path) []errorSchema {
+	vendored := l.ignoredDefinitions(doc, root)
+	var found []errorSchema
+	visit := func(s *Schema, path Path, _ bool) {
+		for _, e := range found {
+			if path.HasPrefix(e.path) {
+				return
+			}
+		}
+		if isErrorSchema(path.Last(), s) {
+			found = append(found, errorSchema{root: root, path: path, shape: l.errorShape(doc, root, s)})
+		}
+	}
+	if !vendored[root.String()] {
+		walkSchema(doc, root, false, visit)
+	}
+	for _, name := range sortedKeys(doc.Defs) {
+		if path := root.Child("$defs", name); !vendored[path.String()] {
+			walkSchema(doc.Defs[name], path, false, visit)
+		}
+	}
+	for _, name := range sortedKeys(doc.Definitions) {
+		if path := root.Child("definitions", name); !vendored[path.String()] {
+			walkSchema(doc.Definitions[name], path, false, visit)
+		}
+	}
+	return found
+}
+
+// isErrorSchema reports whether an object named name describes an error:
+// it has a message field (e.g., message, error_msg, detail) and either a
+// code or status field or a name containing error, problem, or fault.
+func isErrorSchema(name string, s *Schema) bool {
+	if s == nil || len(s.Properties) == 0 {
+		return false
+	}
+	message, code := false, false
+	for prop := range s.Properties {
+		words := lowerWords(prop)
+		message = message || slices.Contains(words, "message") || slices.Contains(words, "msg") ||
+			slices.Equal(words, []string{"detail"})
+		code = code || slices.Contains(words, "code") || slices.Equal(words, []string{"status"})
+	}
+	if !message {
+		return false
+	}
+	if code {
+		return true
+	}
+	words := lowerWords(name)
+	return slices.ContainsFunc(words, func(w string) bool {
+		return w == "error" || w == "errors" || w == "problem" || w == "fault"
+	})
+}
+
+// errorShape describes the properties of an error schema and their types
+// (e.g., "{code: string, message: string}"). $refs are resolved.
+func (l *Linter) errorShape(doc *Schema, root Path, s *Schema) string {
+	names := sortedKeys(s.Properties)
+	fields := make([]string, len(names))
+	for i, name := range names {
+		prop := s.Properties[name]
+		if prop != nil && prop.IsRef() {
+			prop, _, _ = l.resolveRef(doc, root, prop.Ref)
+		}
+		kind := ""
+		if prop != nil {
+			kind = schemaKind(prop)
+		}
+		if kind == "" {
+			kind = "any"
+		}
+		fields[i] = name + ": " + kind
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// lowerWords splits a name into lowercase words (e.g., "errorMsg" is
+// "error", "msg").
+func lowerWords(name string) []string {
+	words := protoWords(name)
+	for i, word := range words {
+		words[i] = strings.ToLower(word)
+	}
+	return words
+}
@@ -0,0 +1,77 @@
+package linter
+
+import (
+	"testing"
+)
+
+func TestLintErrorShapes(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Error": {
+				"type": "object",
+				"properties": {
+					"code": {"type": "string"},
+					"message": {"type": "string"},
+					"details": {
+						"type": "array",
+						"items": {"type": "object", "properties": {"field": {"type": "string"}, "message": {"type": "string"}}}
+					}
+				}
+			},
+			"ValidationError": {
+				"type": "object",
+				"properties": {
+					"code": {"type": "string"},
+					"message": {"type": "string"},
+					"details": {"type": "array"}
+				}
+			},
+			"PaymentFailure": {
+				"type": "object",
+				"properties": {
+					"error_code": {"type": "integer"},
+					"msg": {"type": "string"}
+				}
+			},
+			"Notification": {
+				"type": "object",
+				"properties": {
+					"type": {"type": "string"},
+					"message": {"type": "string"}
+				}
+			}
+		}
+	}`
+
+	paths := func(config Config) []string {
+		result, err := New(config).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		var got []string
+		for _, issue := range result.Issues {
+			if issue.Code == CodeInconsistentErrorShape {
+				got = append(got, issue.Path.String())
+			}
+		}
+		return got
+	}
+
+	// Without error_schema, the most common structure is the convention
+	config := DefaultConfig()
+	config.PropertyCase = CaseNone
+	if got := paths(config); len(got) != 1 || got[0] != "$/$defs/PaymentFailure" {
+		t.Errorf("Expected inconsistent-error-shape at $/$defs/PaymentFailure, got %v", got)
+	}
+
+	config.ErrorSchema = "#/$defs/PaymentFailure"
+	got := paths(config)
+	if len(got) != 2 || got[0] != "$/$defs/Error" || got[1] != "$/$defs/ValidationError" {
+		t.Errorf("Expected inconsistent-error-shape at Error and ValidationError, got %v", got)
+	}
+
+	config.ErrorSchema = "Error"
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for an error schema that is not a JSON pointer")
+	}
+}
@@ -0,0 +1,102 @@
+package linter
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// propertyCluster is a set of properties that a group of definitions
+// declare verbatim: with the same names and identical schemas.
+type propertyCluster struct {
+	properties  []string
+	definitions []string
+}
+
+// lintRepeatedProperties reports clusters of at least MinSharedProperties
+// properties declared verbatim by at least MinSharingDefinitions
+// definitions of the document (e.g., id, createdAt, updatedAt), suggesting
+// a shared base definition. Clusters are reported largest first (by
+// properties times definitions), skipping clusters that share a property
+// and a definition with one already reported, so that each definition is
+// suggested one base per property. Ignored definitions are skipped.
+func (l *Linter) lintRepeatedProperties(schema *Schema, root Path, ignored map[string]bool, result *Result) {
+	minProperties := max(l.config.MinSharedProperties, 2)
+	minDefinitions := max(l.config.MinSharingDefinitions, 2)
+
+	type definition struct {
+		name       string
+		properties map[string]*Schema
+	}
+	var defs []definition
+	for _, kw := range []string{"$defs", "definitions"} {
+		m := schema.Defs
+		if kw == "definitions" {
+			m = schema.Definitions
+		}
+		for _, name := range sortedKeys(m) {
+			if def := m[name]; def != nil && len(def.Properties) >= minProperties && !ignored[root.Child(kw, name).String()] {
+				defs = append(defs, definition{name: name, properties: def.Properties})
+			}
+		}
+	}
+
+	// Each cluster is the intersection of two definitions, with the
+	// definitions that contain all of it.
+	seen := make(map[string]bool)
+	var clusters []propertyCluster
+	for i := range defs {
+		for j := i + 1; j < len(defs); j++ {
+			var shared []string
+			for _, name := range sortedKeys(defs[i].properties) {
+				if p, ok := defs[j].properties[name]; ok && reflect.DeepEqual(defs[i].properties[name], p) {
+					shared = append(shared, name)
+				}
+			}
+			if len(shared) < minProperties {
+				continue
+			}
+			var members []string
+			for _, def := range defs {
+				if !slices.ContainsFunc(shared, func(name string) bool {
+					p, ok := def.properties[name]
+					return !ok || !reflect.DeepEqual(defs[i].properties[name], p)
+				}) {
+					members = append(members, def.name)
+				}
+			}
+			key := strings.Join(shared, ",") + "@" + strings.Join(members, ",")
+			if len(members) >= minDefinitions && !seen[key] {
+				seen[key] = true
+				clusters = append(clusters, propertyCluster{properties: shared, definitions: members})
+			}
+		}
+	}
+	slices.SortStableFunc(clusters, func(a, b propertyCluster) int {
+		return len(b.properties)*len(b.definitions) - len(a.properties)*len(a.definitions)
+	})
+
+	var reported []propertyCluster
+	for _, c := range clusters {
+		if slices.ContainsFunc(reported, func(r propertyCluster) bool {
+			return overlaps(r.properties, c.properties) && overlaps(r.definitions, c.definitions)
+		}) {
+			continue
+		}
+		reported = append(reported, c)
+		result.Issues = append(result.Issues, Issue{
+			Code:     CodeRepeatedProperties,
+			Severity: SeverityInfo,
+			Path:     root,
+			Message: fmt.Sprintf("Properties %s are repeated verbatim in %d definitions: %s",
+				strings.Join(c.properties, ", "), len(c.definitions), strings.Join(c.definitions, ", ")),
+			Suggestion: "Extract the properties into a shared base definition and extend it with allOf: [{$ref: <base>}, {...}] in each definition",
+		})
+	}
+}
+
+// overlaps reports whether two lists have an element in common.
+func overlaps(a, b []string) bool {
+	return slices.ContainsFunc(a, func(s string) bool { return slices.Contains(b, s) })
+}
@@ -0,0 +1,65 @@
+package linter
+
+import (
+	"testing"
+)
+
+func TestLintRepeatedProperties(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"User": {"type": "object", "properties": {
+				"id": {"type": "string"}, "createdAt": {"type": "string", "format": "date-time"},
+				"updatedAt": {"type": "string", "format": "date-time"}, "name": {"type": "string"}
+			}},
+			"Order": {"type": "object", "properties": {
+				"id": {"type": "string"}, "createdAt": {"type": "string", "format": "date-time"},
+				"updatedAt": {"type": "string", "format": "date-time"}, "total": {"type": "integer"}
+			}},
+			"Invoice": {"type": "object", "properties": {
+				"id": {"type": "string"}, "createdAt": {"type": "string", "format": "date-time"},
+				"updatedAt": {"type": "string", "format": "date-time"}, "total": {"type": "integer"}
+			}},
+			"Event": {"type": "object", "properties": {
+				"id": {"type": "integer"}, "createdAt": {"type": "string", "format": "date-time"},
+				"updatedAt": {"type": "string", "format": "date-time"}
+			}}
+		}
+	}`
+
+	messages := func(config Config) []string {
+		result, err := New(config).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		var got []string
+		for _, issue := range result.Issues {
+			if issue.Code == CodeRepeatedProperties {
+				got = append(got, issue.Message)
+			}
+		}
+		return got
+	}
+
+	// Event's id differs, and Order and Invoice alone are too few
+	config := DefaultConfig()
+	got := messages(config)
+	want := []string{"Properties createdAt, id, updatedAt are repeated verbatim in 3 definitions: Invoice, Order, User"}
+	if len(got) != len(want) || got[0] != want[0] {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+
+	// Clusters overlapping the larger one (e.g., Order and Invoice also
+	// share total) are not reported
+	config.MinSharingDefinitions = 2
+	config.MinSharedProperties = 2
+	if got := messages(config); len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+
+	config.MinSharedProperties = 4
+	got = messages(config)
+	want = []string{"Properties createdAt, id, total, updatedAt are repeated verbatim in 2 definitions: Invoice, Order"}
+	if len(got) != 1 || got[0] != want[0] {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+}
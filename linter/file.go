@@ -0,0 +1,81 @@
+package linter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+)
+
+// LoadConfig reads a JSON config file and applies it over DefaultConfig,
+// with the thresholds of the strictness level it names, if any.
+func LoadConfig(path string) (Config, error) {
+	config := DefaultConfig()
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return config, fmt.Errorf("failed to read config: %w", err)
+	}
+	if err := json.Unmarshal(data, &config); err != nil {
+		return config, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	if config.Strictness != "" {
+		// Start from the strictness preset, then apply the file's settings
+		// again so that explicit thresholds take precedence.
+		preset := DefaultConfig()
+		if err := preset.SetStrictness(config.Strictness); err != nil {
+			return config, fmt.Errorf("invalid config %s: %w", path, err)
+		}
+		if err := json.Unmarshal(data, &preset); err != nil {
+			return config, fmt.Errorf("failed to parse config %s: %w", path, err)
+		}
+		config = preset
+	}
+	if err := config.Validate(); err != nil {
+		return config, fmt.Errorf("invalid config %s: %w", path, err)
+	}
+	return config, nil
+}
+
+// LoadResult reads a lint result saved as JSON (e.g., by lint -o json).
+func LoadResult(path string) (Result, error) {
+	var result Result
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return result, fmt.Errorf("failed to read result: %w", err)
+	}
+	if err := json.Unmarshal(data, &result); err != nil {
+		return result, fmt.Errorf("failed to parse result %s: %w", path, err)
+	}
+	return result, nil
+}
+
+// LintFile lints a JSON Schema file. Errors in the schema are *LintError
+// values with File set to path; see Lint.
+func (l *Linter) LintFile(path string) (*Result, error) {
+	data, err := l.readFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	result, err := l.Lint(data)
+	if err != nil {
+		var le *LintError
+		if errors.As(err, &le) {
+			le.File = path
+		}
+		return nil, err
+	}
+	result.SchemaPath = path
+	l.CheckIDLocation(result, IDLocation{Path: path})
+	l.ApplyPathClass(result, path)
+	return result, nil
+}
+
+// readFile reads a file from the linter's file system; see WithFS.
+func (l *Linter) readFile(path string) ([]byte, error) {
+	if l.fsys != nil {
+		return fs.ReadFile(l.fsys, path)
+	}
+	return os.ReadFile(path)
+}
@@ -0,0 +1,319 @@
+package linter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// fixers rewrite the value at the path of an issue, given the object
+// holding it, its key, and the fix context, by code, and report whether
+// they changed it.
+var fixers = map[IssueCode]func(parent *jsonObject, key string, fc *fixContext) bool{
+	CodeMissingContentEncoding: func(parent *jsonObject, key string, _ *fixContext) bool {
+		v, _ := parent.get(key)
+		obj, ok := v.(*jsonObject)
+		if !ok || obj.index("contentEncoding") >= 0 {
+			return false
+		}
+		obj.set("contentEncoding", "base64")
+		return true
+	},
+	CodeUnanchoredPattern: func(parent *jsonObject, key string, _ *fixContext) bool {
+		v, _ := parent.get(key)
+		pattern, ok := v.(string)
+		if !ok || patternAnchored(pattern) || patternError(pattern) != nil {
+			return false
+		}
+		parent.set(key, anchorPattern(pattern))
+		return true
+	},
+	CodeTitleNameMismatch: func(parent *jsonObject, key string, _ *fixContext) bool {
+		v, _ := parent.get(key)
+		def, ok := v.(*jsonObject)
+		if !ok {
+			return false
+		}
+		def.set("title", definitionTitle(key))
+		return true
+	},
+	CodeKeywordTypo: func(parent *jsonObject, key string, _ *fixContext) bool {
+		kw, ok := closestKeyword(key)
+		if !ok || parent.index(kw) >= 0 {
+			return false
+		}
+		parent.rename(key, kw)
+		return true
+	},
+	CodeMissingSchemaDeclaration: func(parent *jsonObject, key string, fc *fixContext) bool {
+		if fc.config.DefaultDraft == "" || parent.index(key) >= 0 {
+			return false
+		}
+		parent.members = slices.Insert(parent.members, 0, jsonMember{Key: key, Value: fc.config.DefaultDraft})
+		return true
+	},
+	CodeImplicitAdditionalProps: func(parent *jsonObject, key string, fc *fixContext) bool {
+		if fc.config.DefaultAdditionalProperties == nil || parent.index(key) >= 0 {
+			return false
+		}
+		parent.set(key, *fc.config.DefaultAdditionalProperties)
+		return true
+	},
+	CodeConstUnion: func(parent *jsonObject, key string, _ *fixContext) bool {
+		v, _ := parent.get(key)
+		values, typ, ok := constUnionEnum(v)
+		if !ok || parent.index("enum") >= 0 {
+			return false
+		}
+		with := []jsonMember{{Key: "enum", Value: values}}
+		if typ != "" && parent.index("type") < 0 {
+			with = append([]jsonMember{{Key: "type", Value: typ}}, with...)
+		}
+		parent.replace(key, with...)
+		return true
+	},
+	CodeInvalidPropertyCase: func(parent *jsonObject, key string, fc *fixContext) bool {
+		name := toCase(key, fc.config.PropertyCase, fc.config.Acronyms)
+		if name == key || !fc.config.followsCase(name) || parent.index(name) >= 0 {
+			return false
+		}
+		parent.rename(key, name)
+		// Rename the property in the required list of its schema
+		if schema, ok := lookupValue(fc.doc, fc.path.Parent().Parent()).(*jsonObject); ok {
+			if required, ok := schema.get("required"); ok {
+				if names, ok := required.([]any); ok {
+					for i, n := range names {
+						if n == key {
+							names[i] = name
+						}
+					}
+				}
+			}
+		}
+		return true
+	},
+	CodeEnumMemberCase: func(parent *jsonObject, key string, fc *fixContext) bool {
+		v, _ := parent.get(key)
+		members, ok := v.([]any)
+		if !ok {
+			return false
+		}
+		return renameEnumMembers(parent, members, fc.config.enumConvention(members), fc.config.Acronyms)
+	},
+	CodeDefinitionNameCase: func(parent *jsonObject, key string, fc *fixContext) bool {
+		name := toCase(key, fc.config.DefinitionCase, fc.config.Acronyms)
+		if name == key || !matchesCase(name, fc.config.DefinitionCase) || parent.index(name) >= 0 {
+			return false
+		}
+		parent.rename(key, name)
+		// Point the $refs of the document to the new name
+		keyword := "#/" + fc.path.Segments[0] + "/"
+		renameRefs(documentRoot(fc.doc, fc.path.Root), keyword+escapePointer(key), keyword+escapePointer(name))
+		return true
+	},
+}
+
+// optInFixes are the fixes Fix only applies when their codes are given,
+// because they rename what instances or generated code use.
+var optInFixes = []IssueCode{CodeInvalidPropertyCase, CodeEnumMemberCase, CodeDefinitionNameCase}
+
+// fixDescriptions say what the fixer of each code changes.
+var fixDescriptions = map[IssueCode]string{
+	CodeMissingContentEncoding:   "adds contentEncoding: base64",
+	CodeUnanchoredPattern:        "anchors the pattern with ^...$",
+	CodeTitleNameMismatch:        "sets the definition's title to its key's words",
+	CodeKeywordTypo:              "renames the key to the keyword it misspells",
+	CodeMissingSchemaDeclaration: "inserts $schema with the configured default_draft",
+	CodeImplicitAdditionalProps:  "adds the configured default_additional_properties",
+	CodeConstUnion:               "replaces the union of constants with an enum",
+	CodeInvalidPropertyCase:      "renames the property to the --property-case convention",
+	CodeEnumMemberCase:           "renames the enum members to the enum_case convention",
+	CodeDefinitionNameCase:       "renames the definition and the $refs to it",
+}
+
+// FixInfo describes the automatic fix of a rule.
+type FixInfo struct {
+	Code IssueCode `json:"code"`
+	// Description says what the fix changes.
+	Description string `json:"description"`
+	// OptIn is true for fixes Fix only applies when their code is given,
+	// because they rename what instances or generated code use.
+	OptIn bool `json:"opt_in,omitempty"`
+}
+
+// Fixes returns the automatic fixes of Fix, sorted by code.
+func Fixes() []FixInfo {
+	codes := FixableCodes()
+	fixes := make([]FixInfo, len(codes))
+	for i, code := range codes {
+		fixes[i] = FixInfo{Code: code, Description: fixDescriptions[code], OptIn: slices.Contains(optInFixes, code)}
+	}
+	return fixes
+}
+
+// fixContext is what fixers know besides the value they fix.
+type fixContext struct {
+	config *Config
+	// doc is the document being fixed, as decoded by decodeDocument
+	doc any
+	// path is the path of the issue being fixed
+	path Path
+}
+
+// FixableCodes returns the issue codes Fix can fix, sorted.
+func FixableCodes() []IssueCode {
+	codes := make([]IssueCode, 0, len(fixers))
+	for code := range fixers {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return codes
+}
+
+// Fix lints data and rewrites the schema where an issue with one of the
+// given codes (all of FixableCodes but the renames of optInFixes if none)
+// can be fixed, returning the fixed data and the issues it fixed. Key order
+// and all other keywords are preserved. As with Convert, data is a single
+// schema document or a JSON array of schemas.
+func (l *Linter) Fix(data []byte, codes ...IssueCode) ([]byte, []Issue, error) {
+	for _, code := range codes {
+		if fixers[code] == nil {
+			return nil, nil, fmt.Errorf("no automatic fix for %s (fixable: %s)", code, joinCodes(FixableCodes()))
+		}
+	}
+	if len(codes) == 0 {
+		for _, code := range FixableCodes() {
+			if !slices.Contains(optInFixes, code) {
+				codes = append(codes, code)
+			}
+		}
+	}
+
+	result, err := l.Lint(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	doc, err := decodeDocument(data)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// Fix property names in the convention inferred for each document
+	config := l.config
+	inferred := make(map[string]PropertyCase)
+	if config.PropertyCase == CaseAuto && slices.Contains(codes, CodeInvalidPropertyCase) {
+		normalized, err := NormalizeJSON(data)
+		if err != nil {
+			return nil, nil, err
+		}
+		schemas, composite, err := ParseDocuments(normalized)
+		if err != nil {
+			return nil, nil, err
+		}
+		for i, schema := range schemas {
+			root := documentPath(i, composite)
+			inferred[root.Root] = sampleCase(schema).dominant(caseConventions)
+		}
+	}
+
+	fixed := []Issue{}
+	fc := &fixContext{config: &config, doc: doc}
+	for _, issue := range result.Issues {
+		if !slices.Contains(codes, issue.Code) {
+			continue
+		}
+		fc.path = issue.Path
+		if l.config.PropertyCase == CaseAuto {
+			config.PropertyCase = inferred[issue.Path.Root]
+		}
+		if parent, key := lookupParent(doc, issue.Path); parent != nil && fixers[issue.Code](parent, key, fc) {
+			fixed = append(fixed, issue)
+		}
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, nil, fmt.Errorf("failed to serialize schema: %w", err)
+	}
+	return buf.Bytes(), fixed, nil
+}
+
+// addedKeys are the keys that issues point to while they are missing, for
+// fixers that add them.
+var addedKeys = []string{"$schema", "additionalProperties"}
+
+// lookupParent returns the object holding the value at an issue path
+// (e.g., "$/$defs/User/properties/avatar", or "[1]/..." in a JSON array of
+// schemas) and its key, or nil if the path does not lead to an object
+// member or to one of addedKeys in an object.
+func lookupParent(doc any, path Path) (*jsonObject, string) {
+	if len(path.Segments) == 0 {
+		return nil, ""
+	}
+	v := documentRoot(doc, path.Root)
+	if v == nil {
+		return nil, ""
+	}
+	for _, segment := range path.Parent().Segments {
+		switch node := v.(type) {
+		case *jsonObject:
+			v, _ = node.get(segment)
+		case []any:
+			i, err := strconv.Atoi(segment)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, ""
+			}
+			v = node[i]
+		default:
+			return nil, ""
+		}
+	}
+	parent, ok := v.(*jsonObject)
+	key := path.Last()
+	if !ok || parent.index(key) < 0 && !slices.Contains(addedKeys, key) {
+		return nil, ""
+	}
+	return parent, key
+}
+
+// lookupValue returns the value at a path, or nil if there is none.
+func lookupValue(doc any, path Path) any {
+	if parent, key := lookupParent(doc, path); parent != nil {
+		v, _ := parent.get(key)
+		return v
+	}
+	if len(path.Segments) > 0 {
+		return nil
+	}
+	return documentRoot(doc, path.Root)
+}
+
+// documentRoot returns the schema at an issue path root: doc for "$", or
+// the document at index i of a JSON array of schemas for "[i]".
+func documentRoot(doc any, root string) any {
+	if root == "$" {
+		return doc
+	}
+	i, err := strconv.Atoi(strings.TrimSuffix(strings.TrimPrefix(root, "["), "]"))
+	docs, ok := doc.([]any)
+	if err != nil || !ok || i < 0 || i >= len(docs) {
+		return nil
+	}
+	return docs[i]
+}
+
+// joinCodes joins issue codes with commas.
+func joinCodes(codes []IssueCode) string {
+	names := make([]string, len(codes))
+	for i, code := range codes {
+		names[i] = string(code)
+	}
+	return strings.Join(names, ", ")
+}
@@ -0,0 +1,112 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestFixes(t *testing.T) {
+	fixes := Fixes()
+	if len(fixes) != len(fixers) {
+		t.Fatalf("Expected a fix for each fixer, got %d of %d", len(fixes), len(fixers))
+	}
+	for _, fix := range fixes {
+		if fix.Description == "" {
+			t.Errorf("Fix for %s has no description", fix.Code)
+		}
+		if _, ok := LookupRule(fix.Code); !ok {
+			t.Errorf("Fix for unknown rule %s", fix.Code)
+		}
+		if fix.OptIn != (fix.Code == CodeInvalidPropertyCase || fix.Code == CodeEnumMemberCase || fix.Code == CodeDefinitionNameCase) {
+			t.Errorf("Unexpected opt-in %v for %s", fix.OptIn, fix.Code)
+		}
+	}
+}
+
+func TestFix(t *testing.T) {
+	schema := `{
+  "$defs": {
+    "File": {
+      "type": "object",
+      "properties": {
+        "data": {"type": "string", "format": "byte"},
+        "name": {"type": "string"}
+      }
+    }
+  },
+  "$ref": "#/$defs/File"
+}`
+
+	l := NewWithDefaults()
+	fixed, issues, err := l.Fix([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to fix: %v", err)
+	}
+	if len(issues) != 1 || issues[0].Path.String() != "$/$defs/File/properties/data" {
+		t.Errorf("Expected the data property to be fixed, got %v", issues)
+	}
+	want := `"data": {
+          "type": "string",
+          "format": "byte",
+          "contentEncoding": "base64"
+        }`
+	if !strings.Contains(string(fixed), want) {
+		t.Errorf("Expected contentEncoding after the existing keywords, got:\n%s", fixed)
+	}
+	if strings.Index(string(fixed), `"$defs"`) > strings.Index(string(fixed), `"$ref"`) {
+		t.Errorf("Expected key order to be preserved, got:\n%s", fixed)
+	}
+
+	result, err := l.Lint(fixed)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	for _, issue := range result.Issues {
+		if issue.Code == CodeMissingContentEncoding {
+			t.Errorf("Expected no missing-content-encoding after fixing, got %v", issue)
+		}
+	}
+
+	composite := `[{"type": "object"}, {"type": "object", "properties": {"data": {"type": "string", "format": "byte"}}}]`
+	if fixed, issues, err := l.Fix([]byte(composite)); err != nil || len(issues) != 1 ||
+		!strings.Contains(string(fixed), `"contentEncoding": "base64"`) {
+		t.Errorf("Expected the second document to be fixed, got %v, %v:\n%s", issues, err, fixed)
+	}
+
+	escaped := `{"type": "object", "properties": {"a/b": {"type": "string", "format": "byte"}}}`
+	if fixed, issues, err := l.Fix([]byte(escaped)); err != nil || len(issues) != 1 ||
+		!strings.Contains(string(fixed), `"contentEncoding": "base64"`) {
+		t.Errorf("Expected the property named a/b to be fixed, got %v, %v:\n%s", issues, err, fixed)
+	}
+
+	if _, _, err := l.Fix([]byte(schema), CodeLargeEnum); err == nil {
+		t.Error("Expected an error for a code without an automatic fix")
+	}
+}
+
+func TestFixPropertyCase(t *testing.T) {
+	schema := `{"type": "object", "properties": {"html_url": {"type": "string"}, "user_id": {"type": "string"}, "userName": {"type": "string"}}, "required": ["html_url", "userName"]}`
+	config := DefaultConfig()
+	config.Acronyms = []string{"ID", "URL"}
+	l := New(config)
+
+	if _, issues, err := l.Fix([]byte(schema)); err != nil || len(issues) != 0 {
+		t.Errorf("Expected property names to be fixed only on request, got %v, %v", issues, err)
+	}
+
+	fixed, issues, err := l.Fix([]byte(schema), CodeInvalidPropertyCase)
+	if err != nil {
+		t.Fatalf("Failed to fix: %v", err)
+	}
+	if len(issues) != 2 {
+		t.Errorf("Expected two renamed properties, got %v", issues)
+	}
+	for _, want := range []string{`"htmlURL": {`, `"userID": {`, `"required": [
+    "htmlURL",
+    "userName"
+  ]`} {
+		if !strings.Contains(string(fixed), want) {
+			t.Errorf("Expected %s in the fixed schema, got:\n%s", want, fixed)
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package linter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Flatten rewrites allOf inheritance (allOf: [{$ref: Base}, {extra
+// properties}]) into the merged object it describes: the properties and
+// required lists of the parts, in order, replace the allOf, and a later
+// part's property replaces an earlier one of the same name. Bases that use
+// the idiom themselves are flattened first. If any part sets
+// additionalProperties: false, the merged object does, so that it accepts
+// the properties of all parts. Other keywords of the parts (e.g., title)
+// are dropped, while the schema's own keywords and key order are kept. An
+// allOf with a part that is not an object, or that uses anyOf or oneOf, is
+// left alone. A JSON array of schemas is flattened element by element.
+func Flatten(data []byte) ([]byte, error) {
+	doc, err := decodeDocument(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if docs, ok := doc.([]any); ok {
+		for _, d := range docs {
+			flattenDocument(d)
+		}
+	} else {
+		flattenDocument(doc)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to serialize schema: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// flattener flattens the inheritance in one document.
+type flattener struct {
+	doc  *jsonObject
+	done map[*jsonObject]bool
+}
+
+func flattenDocument(v any) {
+	if doc, ok := v.(*jsonObject); ok {
+		f := &flattener{doc: doc, done: make(map[*jsonObject]bool)}
+		f.walk(doc)
+	}
+}
+
+// walk flattens a schema and its subschemas.
+func (f *flattener) walk(v any) {
+	obj, ok := v.(*jsonObject)
+	if !ok {
+		return
+	}
+	f.flatten(obj)
+	for _, kw := range subschemaKeywords {
+		if sub, ok := obj.get(kw); ok {
+			f.walk(sub)
+		}
+	}
+	for _, kw := range append([]string{"definitions"}, subschemaMapKeywords...) {
+		if m, ok := obj.get(kw); ok {
+			if m, ok := m.(*jsonObject); ok {
+				for _, member := range m.members {
+					f.walk(member.Value)
+				}
+			}
+		}
+	}
+	for _, kw := range subschemaArrayKeywords {
+		if a, ok := obj.get(kw); ok {
+			if a, ok := a.([]any); ok {
+				for _, sub := range a {
+					f.walk(sub)
+				}
+			}
+		}
+	}
+}
+
+// flatten replaces the allOf of obj with the merged object if it is the
+// inheritance idiom. Each object is flattened once, and a base is
+// flattened before the schemas extending it.
+func (f *flattener) flatten(obj *jsonObject) {
+	if f.done[obj] {
+		return
+	}
+	f.done[obj] = true
+
+	parts := f.parts(obj)
+	if parts == nil {
+		return
+	}
+	properties := &jsonObject{}
+	var required []any
+	closed := false
+	for _, part := range append(parts, obj) {
+		if props, ok := part.get("properties"); ok {
+			if props, ok := props.(*jsonObject); ok {
+				for _, member := range props.members {
+					properties.set(member.Key, member.Value)
+				}
+			}
+		}
+		if req, ok := part.get("required"); ok {
+			if req, ok := req.([]any); ok {
+				for _, name := range req {
+					if !slices.Contains(required, name) {
+						required = append(required, name)
+					}
+				}
+			}
+		}
+		if ap, ok := part.get("additionalProperties"); ok && ap == false && part != obj {
+			closed = true
+		}
+	}
+
+	var with []jsonMember
+	if obj.index("type") < 0 {
+		with = append(with, jsonMember{Key: "type", Value: "object"})
+	}
+	with = append(with, jsonMember{Key: "properties", Value: properties})
+	if len(required) > 0 {
+		with = append(with, jsonMember{Key: "required", Value: required})
+	}
+	if closed && obj.index("additionalProperties") < 0 {
+		with = append(with, jsonMember{Key: "additionalProperties", Value: false})
+	}
+	obj.replace("properties")
+	obj.replace("required")
+	obj.replace("allOf", with...)
+}
+
+// parts returns the allOf parts of obj, with local $refs resolved and
+// flattened, if obj is the inheritance idiom, or nil.
+func (f *flattener) parts(obj *jsonObject) []*jsonObject {
+	allOf, ok := obj.get("allOf")
+	if !ok || obj.index("anyOf") >= 0 || obj.index("oneOf") >= 0 {
+		return nil
+	}
+	members, ok := allOf.([]any)
+	if !ok || len(members) == 0 {
+		return nil
+	}
+	parts := make([]*jsonObject, 0, len(members))
+	refs := 0
+	for _, member := range members {
+		part, ok := member.(*jsonObject)
+		if !ok {
+			return nil
+		}
+		if ref, ok := part.get("$ref"); ok {
+			s, _ := ref.(string)
+			if part = f.resolve(s); part == nil {
+				return nil
+			}
+			refs++
+			f.flatten(part)
+		}
+		if t, ok := part.get("type"); (ok && t != "object") || part.index("$ref") >= 0 ||
+			part.index("allOf") >= 0 || part.index("anyOf") >= 0 || part.index("oneOf") >= 0 {
+			return nil
+		}
+		parts = append(parts, part)
+	}
+	if refs == 0 {
+		return nil
+	}
+	return parts
+}
+
+// resolve returns the definition a local $ref names, or nil.
+func (f *flattener) resolve(ref string) *jsonObject {
+	for _, kw := range []string{"$defs", "definitions"} {
+		name, ok := strings.CutPrefix(ref, "#/"+kw+"/")
+		if !ok {
+			continue
+		}
+		if defs, ok := f.doc.get(kw); ok {
+			if defs, ok := defs.(*jsonObject); ok {
+				if def, ok := defs.get(name); ok {
+					def, _ := def.(*jsonObject)
+					return def
+				}
+			}
+		}
+	}
+	return nil
+}
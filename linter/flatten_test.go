@@ -0,0 +1,65 @@
+package linter
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestFlatten(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Base": {"type": "object", "title": "Base", "properties": {"id": {"type": "string"}}, "required": ["id"], "additionalProperties": false},
+			"Circle": {
+				"description": "A circle",
+				"allOf": [
+					{"$ref": "#/$defs/Base"},
+					{"properties": {"radius": {"type": "number"}}, "required": ["radius"]}
+				]
+			},
+			"Big": {"allOf": [{"$ref": "#/$defs/Circle"}, {"properties": {"scale": {"type": "integer"}}}]},
+			"Named": {"allOf": [{"$ref": "#/$defs/Base"}, {"type": "string"}]}
+		}
+	}`
+
+	out, err := Flatten([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to flatten: %v", err)
+	}
+	var got struct {
+		Defs map[string]map[string]any `json:"$defs"`
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("Failed to parse output: %v", err)
+	}
+
+	var want map[string]any
+	if err := json.Unmarshal([]byte(`{
+		"description": "A circle",
+		"type": "object",
+		"properties": {"id": {"type": "string"}, "radius": {"type": "number"}},
+		"required": ["id", "radius"],
+		"additionalProperties": false
+	}`), &want); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got.Defs["Circle"], want) {
+		t.Errorf("Expected Circle %v, got %v", want, got.Defs["Circle"])
+	}
+
+	big := got.Defs["Big"]
+	if _, ok := big["allOf"]; ok {
+		t.Errorf("Expected Big to be flattened, got %v", big)
+	}
+	if props, _ := big["properties"].(map[string]any); len(props) != 3 {
+		t.Errorf("Expected Big to have the properties of Base, Circle, and itself, got %v", big["properties"])
+	}
+
+	if _, ok := got.Defs["Named"]["allOf"]; !ok {
+		t.Errorf("Expected allOf with a non-object part to be left alone, got %v", got.Defs["Named"])
+	}
+
+	if _, err := Flatten([]byte(`{"allOf": [`)); err == nil {
+		t.Error("Expected error for invalid JSON")
+	}
+}
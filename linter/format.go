@@ -0,0 +1,127 @@
+package linter
+
+import (
+	"crypto/sha256"
+	_ "embed"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"runtime/debug"
+	"strings"
+	"time"
+)
+
+// ResultFormatVersion is the version, MAJOR.MINOR, of the JSON format of
+// results, written as "format_version". Adding a field increments the
+// minor version; removing or changing one increments the major version,
+// and results of another major version fail to parse.
+const ResultFormatVersion = "1.2"
+
+// ResultSchema is the JSON Schema of results in JSON format, as written by
+// lint -o json for a file or a directory.
+//
+//go:embed result.schema.json
+var ResultSchema []byte
+
+// modulePath is the module path of schemakit.
+const modulePath = "github.com/grokify/schemakit"
+
+// ToolVersion is the schemakit version written to JSON results as
+// "version" and in Metadata.Version: the module version of the build, or
+// "dev" for builds from a source checkout. Programs that set their version
+// at link time may replace it.
+var ToolVersion = moduleVersion()
+
+// moduleVersion returns the version of the schemakit module the program
+// was built with, or "dev" if unknown.
+func moduleVersion() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return "dev"
+	}
+	version := info.Main.Version
+	if info.Main.Path != modulePath {
+		version = ""
+		for _, dep := range info.Deps {
+			if dep.Path == modulePath {
+				version = dep.Version
+			}
+		}
+	}
+	if version == "" || version == "(devel)" {
+		return "dev"
+	}
+	return version
+}
+
+// Metadata records how a result was produced, so that results stored as CI
+// artifacts can be audited and reproduced.
+type Metadata struct {
+	Tool    string  `json:"tool"`
+	Version string  `json:"version"`
+	Profile Profile `json:"profile"`
+	// ConfigHash identifies the configuration, as "sha256:" and the hash
+	// of the configuration in JSON; the custom rules and the $ref resolver
+	// are not included.
+	ConfigHash string `json:"config_hash"`
+	// Timestamp is when the lint run started.
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// metadata returns the metadata of a lint run started at start.
+func (l *Linter) metadata(start time.Time) *Metadata {
+	profile := l.config.Profile
+	if profile == "" {
+		profile = ProfileDefault
+	}
+	return &Metadata{
+		Tool:       "schemakit",
+		Version:    ToolVersion,
+		Profile:    profile,
+		ConfigHash: l.configHash,
+		Timestamp:  start.UTC(),
+	}
+}
+
+// configHash returns the ConfigHash of a configuration.
+func configHash(config Config) string {
+	data, err := json.Marshal(config)
+	if err != nil {
+		return ""
+	}
+	sum := sha256.Sum256(data)
+	return "sha256:" + hex.EncodeToString(sum[:])
+}
+
+// MarshalJSON implements json.Marshaler, writing the result with the
+// format version and the tool and version writing it. Issues are written
+// as an array even if nil.
+func (r Result) MarshalJSON() ([]byte, error) {
+	if r.Issues == nil {
+		r.Issues = []Issue{}
+	}
+	type result Result
+	return json.Marshal(struct {
+		FormatVersion string `json:"format_version"`
+		Tool          string `json:"tool"`
+		Version       string `json:"version"`
+		result
+	}{ResultFormatVersion, "schemakit", ToolVersion, result(r)})
+}
+
+// UnmarshalJSON implements json.Unmarshaler. Results without a format
+// version, written before it was introduced, are read as version 1.0.
+func (r *Result) UnmarshalJSON(data []byte) error {
+	var header struct {
+		FormatVersion string `json:"format_version"`
+	}
+	if err := json.Unmarshal(data, &header); err != nil {
+		return err
+	}
+	major, _, _ := strings.Cut(ResultFormatVersion, ".")
+	if v := header.FormatVersion; v != "" && !strings.HasPrefix(v, major+".") {
+		return fmt.Errorf("unsupported result format version %s (supported: %s.x)", v, major)
+	}
+	type result Result
+	return json.Unmarshal(data, (*result)(r))
+}
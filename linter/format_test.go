@@ -0,0 +1,75 @@
+package linter
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestResultFormatVersion(t *testing.T) {
+	data, err := json.Marshal(Result{SchemaPath: "pet.json"})
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if fields["format_version"] != ResultFormatVersion || fields["tool"] != "schemakit" || fields["version"] != ToolVersion {
+		t.Errorf("Expected the format version, tool, and version, got %s", data)
+	}
+	if issues, ok := fields["issues"].([]any); !ok || len(issues) != 0 {
+		t.Errorf("Expected an empty issue array, got %v", fields["issues"])
+	}
+
+	for _, tt := range []struct {
+		data    string
+		wantErr bool
+	}{
+		{`{"schema_path": "a.json", "issues": []}`, false},
+		{`{"format_version": "1.7", "schema_path": "a.json", "issues": []}`, false},
+		{`{"format_version": "2.0", "schema_path": "a.json", "issues": []}`, true},
+	} {
+		var result Result
+		err := json.Unmarshal([]byte(tt.data), &result)
+		if tt.wantErr {
+			if err == nil || !strings.Contains(err.Error(), "unsupported result format version 2.0") {
+				t.Errorf("Expected an unsupported version error for %s, got %v", tt.data, err)
+			}
+			continue
+		}
+		if err != nil || result.SchemaPath != "a.json" {
+			t.Errorf("Failed to read %s: %v", tt.data, err)
+		}
+	}
+}
+
+func TestResultMetadata(t *testing.T) {
+	schema := []byte(`{"type": "object", "properties": {"name": {"type": "string"}}}`)
+	lint := func(config Config) *Metadata {
+		t.Helper()
+		result, err := New(config).Lint(schema)
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		if result.Metadata == nil {
+			t.Fatal("Expected metadata")
+		}
+		return result.Metadata
+	}
+
+	first, second := lint(DefaultConfig()), lint(DefaultConfig())
+	if first.Tool != "schemakit" || first.Version != ToolVersion || first.Profile != ProfileDefault || first.Timestamp.IsZero() {
+		t.Errorf("Unexpected metadata %+v", first)
+	}
+	if !strings.HasPrefix(first.ConfigHash, "sha256:") || first.ConfigHash != second.ConfigHash {
+		t.Errorf("Expected the same config hash for the same config, got %s and %s", first.ConfigHash, second.ConfigHash)
+	}
+
+	config := DefaultConfig()
+	config.Profile = ProfileScale
+	scale := lint(config)
+	if scale.Profile != ProfileScale || scale.ConfigHash == first.ConfigHash {
+		t.Errorf("Expected the scale profile and another config hash, got %+v", scale)
+	}
+}
@@ -0,0 +1,40 @@
+// Package goplugin loads lint rule packs from Go plugins. It is separate
+// from the linter package so that only programs that load rule packs link
+// the standard library plugin package, which requires cgo on the
+// platforms that support it.
+package goplugin
+
+import (
+	"fmt"
+	"plugin"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// RulesSymbol is the symbol a rule pack plugin must export. It must be a
+// function returning the plugin's rules:
+//
+//	func Rules() []linter.Rule
+const RulesSymbol = "Rules"
+
+// Load loads a rule pack from a Go plugin (.so) built with
+// go build -buildmode=plugin against the same version of this module.
+// Go plugins are supported on Linux, FreeBSD, and macOS with cgo enabled.
+func Load(path string) ([]linter.Rule, error) {
+	p, err := plugin.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open rule plugin %s: %w", path, err)
+	}
+
+	sym, err := p.Lookup(RulesSymbol)
+	if err != nil {
+		return nil, fmt.Errorf("rule plugin %s: %w", path, err)
+	}
+
+	rulesFunc, ok := sym.(func() []linter.Rule)
+	if !ok {
+		return nil, fmt.Errorf("rule plugin %s: %s has type %T, want func() []linter.Rule", path, RulesSymbol, sym)
+	}
+
+	return rulesFunc(), nil
+}
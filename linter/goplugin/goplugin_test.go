@@ -0,0 +1,11 @@
+package goplugin
+
+import (
+	"testing"
+)
+
+func TestLoadMissing(t *testing.T) {
+	if _, err := Load("testdata/does-not-exist.so"); err == nil {
+		t.Error("Expected error loading missing plugin")
+	}
+}
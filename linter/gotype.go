@@ -0,0 +1,314 @@
+package linter
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// GoKind is the JSON kind a Go type encodes to.
+type GoKind string
+
+const (
+	GoString  GoKind = "string"
+	GoInteger GoKind = "integer"
+	GoNumber  GoKind = "number"
+	GoBoolean GoKind = "boolean"
+	GoArray   GoKind = "array"
+	GoStruct  GoKind = "struct"
+	GoMap     GoKind = "map"
+	// GoAny is an interface or a type with custom JSON marshaling.
+	GoAny GoKind = "any"
+)
+
+// GoType describes the JSON shape of a Go type as encoded by encoding/json.
+type GoType struct {
+	Kind GoKind `json:"kind"`
+	// Name is the Go type name (e.g., "Event" or "time.Time").
+	Name string `json:"name,omitempty"`
+	// Nullable is true for pointers, slices, maps, and interfaces.
+	Nullable bool `json:"nullable,omitempty"`
+	// Format is the string format of well-known types (e.g., "date-time").
+	Format string `json:"format,omitempty"`
+	// Elem is the element type of arrays and maps.
+	Elem *GoType `json:"elem,omitempty"`
+	// Fields are the JSON fields of a struct. A struct that recurses into
+	// itself is described once; nested occurrences have no fields.
+	Fields []GoField `json:"fields,omitempty"`
+}
+
+// GoField is a JSON-encoded struct field.
+type GoField struct {
+	Name      string  `json:"name"`
+	JSONName  string  `json:"json_name"`
+	OmitEmpty bool    `json:"omitempty,omitempty"`
+	Type      *GoType `json:"type"`
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// DescribeGoType describes the JSON shape of a Go type, following json
+// struct tags and embedded structs.
+func DescribeGoType(t reflect.Type) *GoType {
+	return describeGoType(t, make(map[reflect.Type]bool))
+}
+
+func describeGoType(t reflect.Type, active map[reflect.Type]bool) *GoType {
+	nullable := false
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+		nullable = true
+	}
+	gt := &GoType{Name: t.String(), Nullable: nullable}
+
+	switch {
+	case t == timeType:
+		gt.Kind, gt.Format = GoString, "date-time"
+		return gt
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		gt.Kind = GoAny
+		return gt
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		gt.Kind = GoString
+		return gt
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		gt.Kind = GoString
+	case reflect.Bool:
+		gt.Kind = GoBoolean
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		gt.Kind = GoInteger
+	case reflect.Float32, reflect.Float64:
+		gt.Kind = GoNumber
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			// []byte is encoded as a base64 string
+			gt.Kind = GoString
+			break
+		}
+		gt.Kind, gt.Elem = GoArray, describeGoType(t.Elem(), active)
+		gt.Nullable = gt.Nullable || t.Kind() == reflect.Slice
+	case reflect.Map:
+		gt.Kind, gt.Elem, gt.Nullable = GoMap, describeGoType(t.Elem(), active), true
+	case reflect.Struct:
+		gt.Kind = GoStruct
+		if active[t] {
+			return gt
+		}
+		active[t] = true
+		gt.Fields = describeGoFields(t, active)
+		delete(active, t)
+	default:
+		gt.Kind, gt.Nullable = GoAny, true
+	}
+	return gt
+}
+
+// describeGoFields returns the JSON fields of a struct, flattening untagged
+// embedded structs as encoding/json does.
+func describeGoFields(t reflect.Type, active map[reflect.Type]bool) []GoField {
+	var fields []GoField
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, opts, _ := strings.Cut(tag, ",")
+
+		if field.Anonymous && name == "" {
+			ft := field.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				fields = append(fields, describeGoFields(ft, active)...)
+				continue
+			}
+		}
+		if !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		fields = append(fields, GoField{
+			Name:      field.Name,
+			JSONName:  name,
+			OmitEmpty: strings.Contains(","+opts+",", ",omitempty,") || strings.Contains(","+opts+",", ",omitzero,"),
+			Type:      describeGoType(field.Type, active),
+		})
+	}
+	return fields
+}
+
+// CheckGoType compares a Go type against the schema and reports contract
+// mismatches: schema properties with no Go field, Go fields not in the
+// schema, type drift, and optionality that differs from the schema's
+// required list. Local $refs in the schema are followed.
+func CheckGoType(schema *Schema, t *GoType) []Issue {
+	c := &goChecker{doc: schema, visited: make(map[string]bool), issues: []Issue{}}
+	c.check(schema, rootPath, t)
+	return c.issues
+}
+
+type goChecker struct {
+	doc     *Schema
+	visited map[string]bool
+	issues  []Issue
+}
+
+func (c *goChecker) report(code IssueCode, severity Severity, path Path, t *GoType, message, suggestion string) {
+	c.issues = append(c.issues, Issue{
+		Code:       code,
+		Severity:   severity,
+		Path:       path,
+		Message:    message,
+		Suggestion: suggestion,
+		TypeName:   t.Name,
+	})
+}
+
+// check compares the Go type against the schema at path.
+func (c *goChecker) check(schema *Schema, path Path, t *GoType) {
+	if schema == nil || schema.IsBooleanSchema || t == nil || t.Kind == GoAny {
+		return
+	}
+	if ref := schema.RefTarget(); ref != "" {
+		target, targetPath, ok := resolveLocalRef(c.doc, rootPath, ref)
+		if !ok {
+			return
+		}
+		schema, path = target, targetPath
+	}
+
+	// Compare each struct and definition pairing once, which also stops
+	// recursive types.
+	key := path.String() + "\x00" + t.Name
+	if c.visited[key] {
+		return
+	}
+	c.visited[key] = true
+
+	kind := schemaKind(schema)
+	if kind == "" {
+		return
+	}
+	if !goKindMatches(kind, t.Kind) {
+		c.report(CodeGoTypeMismatch, SeverityError, path, t,
+			fmt.Sprintf("Schema type %s does not match Go type %s", kind, t.Name),
+			fmt.Sprintf("Change the Go type to encode as %s, or update the schema", kind))
+		return
+	}
+
+	switch t.Kind {
+	case GoStruct:
+		if t.Fields != nil {
+			c.checkStruct(schema, path, t)
+		}
+	case GoArray:
+		c.check(schema.Items, path.Child("items"), t.Elem)
+	case GoMap:
+		c.check(schema.AdditionalPropertiesSchema, path.Child("additionalProperties"), t.Elem)
+	}
+}
+
+func (c *goChecker) checkStruct(schema *Schema, path Path, t *GoType) {
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	fields := make(map[string]GoField, len(t.Fields))
+	for _, f := range t.Fields {
+		fields[f.JSONName] = f
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		propPath := path.Child("properties", name)
+		f, ok := fields[name]
+		if !ok {
+			c.report(CodeGoMissingField, SeverityError, propPath, t,
+				fmt.Sprintf("Go type %s has no field for property %q", t.Name, name),
+				fmt.Sprintf("Add a field with the tag `json:\"%s\"`", name))
+			continue
+		}
+
+		switch {
+		case required[name] && f.OmitEmpty:
+			c.report(CodeGoOptionality, SeverityWarning, propPath, t,
+				fmt.Sprintf("Property %q is required but field %s.%s has omitempty", name, t.Name, f.Name),
+				"Remove omitempty so the property is always encoded")
+		case !required[name] && !f.OmitEmpty && !f.Type.Nullable && f.Type.Kind != GoStruct:
+			c.report(CodeGoOptionality, SeverityWarning, propPath, t,
+				fmt.Sprintf("Property %q is optional but field %s.%s is always encoded", name, t.Name, f.Name),
+				"Add omitempty or use a pointer so absent values are not encoded as zero values")
+		}
+
+		c.check(schema.Properties[name], propPath, f.Type)
+	}
+
+	closed := schema.AdditionalProperties != nil && !*schema.AdditionalProperties
+	for _, f := range t.Fields {
+		if _, ok := schema.Properties[f.JSONName]; ok {
+			continue
+		}
+		severity := SeverityWarning
+		if closed {
+			severity = SeverityError
+		}
+		c.report(CodeGoExtraField, severity, path, t,
+			fmt.Sprintf("Field %s.%s (%q) is not a schema property", t.Name, f.Name, f.JSONName),
+			fmt.Sprintf("Add %q to the schema properties or exclude the field with `json:\"-\"`", f.JSONName))
+	}
+}
+
+// schemaKind returns the single non-null JSON type of a schema, inferred from
+// its keywords if not declared, or "" if it cannot be compared.
+func schemaKind(schema *Schema) string {
+	if schema.Type != "" {
+		return schema.Type
+	}
+	var kind string
+	for _, t := range schema.TypeList {
+		if t == "null" {
+			continue
+		}
+		if kind != "" {
+			return ""
+		}
+		kind = t
+	}
+	if kind == "" && schema.IsObject() {
+		kind = "object"
+	}
+	return kind
+}
+
+// goKindMatches reports whether a Go kind encodes as the JSON Schema type.
+func goKindMatches(schemaType string, kind GoKind) bool {
+	switch schemaType {
+	case "object":
+		return kind == GoStruct || kind == GoMap
+	case "array":
+		return kind == GoArray
+	case "string":
+		return kind == GoString
+	case "integer":
+		return kind == GoInteger
+	case "number":
+		return kind == GoNumber
+	case "boolean":
+		return kind == GoBoolean
+	}
+	return true
+}
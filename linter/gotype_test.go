@@ -0,0 +1,105 @@
+package linter
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+type goTestBase struct {
+	ID string `json:"id"`
+}
+
+type goTestEvent struct {
+	goTestBase
+	Kind      string            `json:"kind"`
+	Count     float64           `json:"count"`
+	Note      string            `json:"note,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	Tags      []string          `json:"tags,omitempty"`
+	Labels    map[string]int    `json:"labels,omitempty"`
+	Parent    *goTestEvent      `json:"parent,omitempty"`
+	Extra     string            `json:"extra"`
+	internal  string            //nolint:unused
+	Ignored   map[string]string `json:"-"`
+}
+
+func TestDescribeGoType(t *testing.T) {
+	gt := DescribeGoType(reflect.TypeOf(goTestEvent{}))
+	if gt.Kind != GoStruct {
+		t.Fatalf("Expected struct kind, got %s", gt.Kind)
+	}
+
+	byName := make(map[string]GoField)
+	for _, f := range gt.Fields {
+		byName[f.JSONName] = f
+	}
+	if len(byName) != 9 {
+		t.Errorf("Expected 9 JSON fields, got %d: %v", len(byName), gt.Fields)
+	}
+	if f := byName["id"]; f.Type == nil || f.Type.Kind != GoString {
+		t.Errorf("Expected embedded id field to be flattened, got %+v", f)
+	}
+	if f := byName["created_at"]; f.Type.Kind != GoString || f.Type.Format != "date-time" {
+		t.Errorf("Expected time.Time as date-time string, got %+v", f.Type)
+	}
+	if f := byName["parent"]; !f.OmitEmpty || !f.Type.Nullable || f.Type.Fields != nil {
+		t.Errorf("Expected recursive parent to be nullable with no fields, got %+v", f.Type)
+	}
+	if f := byName["labels"]; f.Type.Kind != GoMap || f.Type.Elem.Kind != GoInteger {
+		t.Errorf("Expected map of integers, got %+v", f.Type)
+	}
+}
+
+func TestCheckGoType(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Event": {
+				"type": "object",
+				"properties": {
+					"id": {"type": "string"},
+					"kind": {"type": "string"},
+					"count": {"type": "integer"},
+					"note": {"type": "string"},
+					"created_at": {"type": "string", "format": "date-time"},
+					"tags": {"type": "array", "items": {"type": "string"}},
+					"labels": {"type": "object", "additionalProperties": {"type": "string"}},
+					"parent": {"$ref": "#/$defs/Event"},
+					"source": {"type": "string"}
+				},
+				"required": ["id", "kind", "note", "count"]
+			}
+		},
+		"$ref": "#/$defs/Event"
+	}`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	issues := CheckGoType(s, DescribeGoType(reflect.TypeOf(goTestEvent{})))
+
+	want := map[string]IssueCode{
+		"$/$defs/Event/properties/count":                       CodeGoTypeMismatch,
+		"$/$defs/Event/properties/note":                        CodeGoOptionality,
+		"$/$defs/Event/properties/created_at":                  CodeGoOptionality,
+		"$/$defs/Event/properties/labels/additionalProperties": CodeGoTypeMismatch,
+		"$/$defs/Event/properties/source":                      CodeGoMissingField,
+		"$/$defs/Event":                                        CodeGoExtraField,
+	}
+	got := make(map[string]IssueCode)
+	for _, issue := range issues {
+		got[issue.Path.String()] = issue.Code
+		if issue.TypeName == "" {
+			t.Errorf("Expected type name on issue: %v", issue)
+		}
+	}
+	for path, code := range want {
+		if got[path] != code {
+			t.Errorf("Expected %s at %s, got %q", code, path, got[path])
+		}
+	}
+	if len(issues) != len(want) {
+		t.Errorf("Expected %d issues, got %d: %v", len(want), len(issues), issues)
+	}
+}
@@ -0,0 +1,219 @@
+package linter
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// GraphNode is a definition in a schema reference graph.
+type GraphNode struct {
+	// ID is the JSON pointer of the definition (e.g., "#/$defs/Pet").
+	ID string `json:"id"`
+	// Name is the definition name (or "root" for the root schema).
+	Name string `json:"name"`
+	// IsUnion is true if the definition is itself an anyOf/oneOf union.
+	IsUnion bool `json:"is_union,omitempty"`
+}
+
+// GraphEdge is a $ref from one definition to another.
+type GraphEdge struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+	// Path is the location of the $ref within the source definition.
+	Path string `json:"path"`
+	// IsUnionVariant is true if the $ref is an anyOf/oneOf variant.
+	IsUnionVariant bool `json:"is_union_variant,omitempty"`
+}
+
+// Graph is the definition/reference graph of a schema document.
+type Graph struct {
+	Nodes []GraphNode `json:"nodes"`
+	Edges []GraphEdge `json:"edges"`
+}
+
+const graphRootID = "#"
+
+// BuildGraph builds the definition/reference graph for a schema document.
+// Nodes are the root schema and its $defs/definitions; edges are local $refs.
+func BuildGraph(schema *Schema) *Graph {
+	g := &Graph{Nodes: []GraphNode{}, Edges: []GraphEdge{}}
+	if schema == nil {
+		return g
+	}
+
+	g.addDefinition(schema, graphRootID, "root", schema)
+	for _, name := range sortedKeys(schema.Defs) {
+		g.addDefinition(schema, "#/$defs/"+escapePointer(name), name, schema.Defs[name])
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		g.addDefinition(schema, "#/definitions/"+escapePointer(name), name, schema.Definitions[name])
+	}
+
+	return g
+}
+
+// addDefinition adds a node for the definition and an edge for each local
+// $ref found within it. Edges point to the definition containing the
+// target: a $ref into a definition, such as #/$defs/Pet/properties/name,
+// points to #/$defs/Pet, and a reference to an anchor points to the
+// definition that declares the anchor.
+func (g *Graph) addDefinition(doc *Schema, id, name string, def *Schema) {
+	if def == nil {
+		return
+	}
+	g.Nodes = append(g.Nodes, GraphNode{ID: id, Name: name, IsUnion: def.IsUnion()})
+
+	walkSchema(def, rootPath, false, func(s *Schema, path Path, isVariant bool) {
+		ref := s.RefTarget()
+		if ref == "" || !strings.HasPrefix(ref, "#") {
+			return
+		}
+		if _, target, ok := resolveLocalRef(doc, NewPath(graphRootID), ref); ok {
+			ref = definitionID(target.String())
+		} else if strings.HasPrefix(ref, "#/") {
+			ref = definitionID(ref)
+		}
+		g.Edges = append(g.Edges, GraphEdge{
+			From:           id,
+			To:             ref,
+			Path:           path.String(),
+			IsUnionVariant: isVariant,
+		})
+	})
+}
+
+// definitionID returns the graph node ID of the definition containing the
+// JSON pointer fragment (e.g., "#/$defs/Pet" for "#/$defs/Pet/properties/id").
+func definitionID(pointer string) string {
+	for _, prefix := range []string{"#/$defs/", "#/definitions/"} {
+		if rest, ok := strings.CutPrefix(pointer, prefix); ok {
+			name, _, _ := strings.Cut(rest, "/")
+			return prefix + name
+		}
+	}
+	return graphRootID
+}
+
+// walkSchema calls fn for the schema and each nested subschema (properties,
+// items, prefixItems, contains, additionalProperties, and composition
+// variants), depth first.
+// It does not descend into $defs/definitions or follow $refs.
+func walkSchema(schema *Schema, path Path, isVariant bool, fn func(s *Schema, path Path, isVariant bool)) {
+	if schema == nil {
+		return
+	}
+	fn(schema, path, isVariant)
+
+	for _, propName := range sortedKeys(schema.Properties) {
+		walkSchema(schema.Properties[propName], path.Child("properties", propName), false, fn)
+	}
+	if schema.Items != nil {
+		walkSchema(schema.Items, path.Child("items"), false, fn)
+	}
+	for i, v := range schema.PrefixItems {
+		walkSchema(v, prefixItemPath(schema, path, i), false, fn)
+	}
+	if schema.AdditionalItems != nil {
+		walkSchema(schema.AdditionalItems, path.Child("additionalItems"), false, fn)
+	}
+	if schema.Contains != nil {
+		walkSchema(schema.Contains, path.Child("contains"), false, fn)
+	}
+	if schema.AdditionalPropertiesSchema != nil {
+		walkSchema(schema.AdditionalPropertiesSchema, path.Child("additionalProperties"), false, fn)
+	}
+	if schema.PropertyNames != nil {
+		walkSchema(schema.PropertyNames, path.Child("propertyNames"), false, fn)
+	}
+	for i, v := range schema.AnyOf {
+		walkSchema(v, path.Child("anyOf").Index(i), true, fn)
+	}
+	for i, v := range schema.OneOf {
+		walkSchema(v, path.Child("oneOf").Index(i), true, fn)
+	}
+	for i, v := range schema.AllOf {
+		walkSchema(v, path.Child("allOf").Index(i), false, fn)
+	}
+}
+
+// sortedKeys returns the keys of a schema map in sorted order.
+func sortedKeys(m map[string]*Schema) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// nodeName returns the display name for a node ID, falling back to the ID.
+func (g *Graph) nodeName(id string) string {
+	for _, n := range g.Nodes {
+		if n.ID == id {
+			return n.Name
+		}
+	}
+	return id
+}
+
+// DOT renders the graph in Graphviz DOT format. Union definitions are drawn
+// as diamonds and union variant edges are dashed.
+func (g *Graph) DOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph schema {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box];\n")
+	for _, n := range g.Nodes {
+		if n.IsUnion {
+			fmt.Fprintf(&sb, "  %q [label=%q, shape=diamond, style=filled, fillcolor=lightyellow];\n", n.ID, n.Name)
+		} else {
+			fmt.Fprintf(&sb, "  %q [label=%q];\n", n.ID, n.Name)
+		}
+	}
+	for _, e := range g.Edges {
+		if e.IsUnionVariant {
+			fmt.Fprintf(&sb, "  %q -> %q [style=dashed];\n", e.From, e.To)
+		} else {
+			fmt.Fprintf(&sb, "  %q -> %q;\n", e.From, e.To)
+		}
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+// Mermaid renders the graph as a Mermaid flowchart. Union definitions are
+// drawn as rhombuses and union variant edges are dotted.
+func (g *Graph) Mermaid() string {
+	ids := make(map[string]string)
+	mermaidID := func(id string) string {
+		if mid, ok := ids[id]; ok {
+			return mid
+		}
+		mid := fmt.Sprintf("n%d", len(ids))
+		ids[id] = mid
+		return mid
+	}
+
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for _, n := range g.Nodes {
+		if n.IsUnion {
+			fmt.Fprintf(&sb, "  %s{%q}\n", mermaidID(n.ID), n.Name)
+		} else {
+			fmt.Fprintf(&sb, "  %s[%q]\n", mermaidID(n.ID), n.Name)
+		}
+	}
+	for _, e := range g.Edges {
+		arrow := "-->"
+		if e.IsUnionVariant {
+			arrow = "-.->"
+		}
+		if _, ok := ids[e.To]; !ok {
+			// Dangling reference; render with its pointer as the label.
+			fmt.Fprintf(&sb, "  %s[%q]\n", mermaidID(e.To), g.nodeName(e.To))
+		}
+		fmt.Fprintf(&sb, "  %s %s %s\n", mermaidID(e.From), arrow, mermaidID(e.To))
+	}
+	return sb.String()
+}
@@ -0,0 +1,135 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBuildGraph(t *testing.T) {
+	schema := `{
+		"properties": {
+			"pet": {"$ref": "#/$defs/Pet"}
+		},
+		"$defs": {
+			"Pet": {
+				"oneOf": [
+					{"$ref": "#/$defs/Dog"},
+					{"$ref": "#/$defs/Cat"}
+				]
+			},
+			"Dog": {"type": "object"},
+			"Cat": {"type": "object"}
+		}
+	}`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+
+	g := BuildGraph(s)
+	if len(g.Nodes) != 4 {
+		t.Errorf("Expected 4 nodes, got %d: %v", len(g.Nodes), g.Nodes)
+	}
+	if len(g.Edges) != 3 {
+		t.Fatalf("Expected 3 edges, got %d: %v", len(g.Edges), g.Edges)
+	}
+
+	variantEdges := 0
+	for _, e := range g.Edges {
+		if e.IsUnionVariant {
+			variantEdges++
+			if e.From != "#/$defs/Pet" {
+				t.Errorf("Expected union variant edge from Pet, got %s", e.From)
+			}
+		}
+	}
+	if variantEdges != 2 {
+		t.Errorf("Expected 2 union variant edges, got %d", variantEdges)
+	}
+
+	dot := g.DOT()
+	if !strings.Contains(dot, `"#/$defs/Pet" [label="Pet", shape=diamond`) {
+		t.Errorf("Expected Pet to be highlighted as a union in DOT output:\n%s", dot)
+	}
+	if !strings.Contains(dot, `"#" -> "#/$defs/Pet";`) {
+		t.Errorf("Expected root -> Pet edge in DOT output:\n%s", dot)
+	}
+
+	mermaid := g.Mermaid()
+	if !strings.HasPrefix(mermaid, "flowchart LR\n") || !strings.Contains(mermaid, "-.->") {
+		t.Errorf("Unexpected Mermaid output:\n%s", mermaid)
+	}
+}
+
+func TestBuildGraphAnchors(t *testing.T) {
+	schema := `{
+		"$dynamicAnchor": "node",
+		"properties": {
+			"owner": {"$ref": "#person"},
+			"children": {"type": "array", "items": {"$dynamicRef": "#node"}}
+		},
+		"$defs": {
+			"Person": {"type": "object", "properties": {"name": {"$anchor": "person", "type": "string"}}}
+		}
+	}`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+
+	edges := make(map[string]string)
+	for _, e := range BuildGraph(s).Edges {
+		edges[e.Path] = e.To
+	}
+	if to := edges["$/properties/owner"]; to != "#/$defs/Person" {
+		t.Errorf("Expected anchor $ref to point to #/$defs/Person, got %q", to)
+	}
+	if to := edges["$/properties/children/items"]; to != "#" {
+		t.Errorf("Expected $dynamicRef to point to the root, got %q", to)
+	}
+}
+
+func TestBuildGraphNestedRefs(t *testing.T) {
+	schema := `{
+		"properties": {
+			"name": {"$ref": "#/$defs/Pet/properties/name"},
+			"tag": {"$ref": "#/$defs/a~1b/properties/tag"},
+			"owner": {"$ref": "#/$defs/a~1b"},
+			"self": {"$ref": "#/properties/name"}
+		},
+		"$defs": {
+			"Pet": {"type": "object", "properties": {"name": {"type": "string"}}},
+			"a/b": {"type": "object", "properties": {"tag": {"type": "string"}}}
+		}
+	}`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+
+	g := BuildGraph(s)
+	nodes := make(map[string]bool)
+	for _, n := range g.Nodes {
+		nodes[n.ID] = true
+	}
+	want := map[string]string{
+		"$/properties/name":  "#/$defs/Pet",
+		"$/properties/tag":   "#/$defs/a~1b",
+		"$/properties/owner": "#/$defs/a~1b",
+		"$/properties/self":  "#",
+	}
+	for _, e := range g.Edges {
+		if e.To != want[e.Path] {
+			t.Errorf("Edge at %s points to %q, want %q", e.Path, e.To, want[e.Path])
+		}
+		if !nodes[e.To] {
+			t.Errorf("Edge at %s points to %q, which is not a node", e.Path, e.To)
+		}
+	}
+	if len(g.Edges) != len(want) {
+		t.Errorf("Expected %d edges, got %d", len(want), len(g.Edges))
+	}
+}
@@ -0,0 +1,344 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"path"
+	"slices"
+	"strings"
+)
+
+// proseEnumPhrases are description phrases that indicate a fixed set of values.
+var proseEnumPhrases = []string{
+	"one of:",
+	"one of the following",
+	"must be one of",
+	"allowed values",
+	"possible values",
+	"valid values",
+	"accepted values",
+	"permitted values",
+}
+
+// lintProseEnum flags scalar schemas whose description lists a fixed set of
+// values but which declare no enum or const, leaving type information in prose.
+func (l *Linter) lintProseEnum(schema *Schema, path Path, result *Result) {
+	if schema.Description == "" || len(schema.Enum) > 0 || schema.Const != nil {
+		return
+	}
+	if schema.IsObject() || schema.IsArray() || schema.IsUnion() || schema.IsRef() {
+		return
+	}
+
+	desc := strings.ToLower(schema.Description)
+	for _, phrase := range proseEnumPhrases {
+		if strings.Contains(desc, phrase) {
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeProseEnum,
+				Severity:   SeverityInfo,
+				Path:       path,
+				Message:    "Description lists a fixed set of values (\"" + phrase + "\") but the schema has no enum or const",
+				Suggestion: "Declare the values with 'enum' so generators can produce typed constants",
+			})
+			return
+		}
+	}
+}
+
+// genericContainerNames are property names typical of untyped envelopes.
+var genericContainerNames = map[string]bool{
+	"data":    true,
+	"payload": true,
+	"value":   true,
+}
+
+// lintGenericContainer flags objects whose only property is an envelope
+// field (data, payload, value) that accepts any value or any object. Such
+// envelopes become map[string]interface{} in generated Go code.
+func (l *Linter) lintGenericContainer(schema *Schema, path Path, result *Result) {
+	if len(schema.Properties) != 1 {
+		return
+	}
+	for name, prop := range schema.Properties {
+		if !genericContainerNames[name] || !isUntyped(prop) {
+			return
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeGenericContainer,
+			Severity:   SeverityWarning,
+			Path:       path.Child("properties", name),
+			Message:    fmt.Sprintf("Property '%s' is an untyped envelope that accepts any %s", name, untypedKind(prop)),
+			Suggestion: "Give the property a concrete schema, or a discriminated union ($ref variants with a const 'type') if it carries several payload types",
+		})
+	}
+}
+
+// isUntyped returns true for schemas that accept any value (true or an
+// empty schema) or any object (an object with no properties whose
+// additionalProperties are unconstrained).
+func isUntyped(s *Schema) bool {
+	if s == nil {
+		return false
+	}
+	if s.IsBooleanSchema {
+		return s.BooleanValue
+	}
+	if s.IsRef() || s.IsUnion() || len(s.AllOf) > 0 || len(s.Enum) > 0 || s.Const != nil {
+		return false
+	}
+	switch {
+	case !s.HasType():
+		return len(s.Properties) == 0 && s.Items == nil && s.AdditionalProperties == nil && s.AdditionalPropertiesSchema == nil
+	case s.Type == "object":
+		return len(s.Properties) == 0 && s.AdditionalPropertiesSchema == nil &&
+			(s.AdditionalProperties == nil || *s.AdditionalProperties)
+	}
+	return false
+}
+
+// untypedKind describes what an untyped schema accepts.
+func untypedKind(s *Schema) string {
+	if s.Type == "object" {
+		return "object"
+	}
+	return "value"
+}
+
+// timestampFormats are the string formats that type a timestamp property.
+var timestampFormats = map[string]bool{
+	"date-time": true,
+	"date":      true,
+	"time":      true,
+}
+
+// lintStringlyTyped flags properties whose names suggest a timestamp or a
+// UUID but that are plain strings without a format, so generators emit
+// string instead of time.Time or a UUID type.
+func (l *Linter) lintStringlyTyped(schema *Schema, schemaPath Path, result *Result) {
+	for _, name := range sortedKeys(schema.Properties) {
+		prop := schema.Properties[name]
+		if !isPlainString(prop) {
+			continue
+		}
+		propPath := schemaPath.Child("properties", name)
+
+		switch {
+		case matchesAny(l.config.TimestampNamePatterns, name) && !timestampFormats[prop.Format]:
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeStringlyTypedTimestamp,
+				Severity:   SeverityWarning,
+				Path:       propPath,
+				Message:    fmt.Sprintf("Property '%s' looks like a timestamp but is a plain string", name),
+				Suggestion: "Add 'format: date-time' (or 'date'/'time') so generators can use time.Time",
+			})
+		case matchesAny(l.config.IDNamePatterns, name) && prop.Format == "":
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeStringlyTypedID,
+				Severity:   SeverityWarning,
+				Path:       propPath,
+				Message:    fmt.Sprintf("Property '%s' looks like an identifier but is a plain string", name),
+				Suggestion: "Add 'format: uuid' so generators can use a UUID type, or a 'pattern' describing the ID",
+			})
+		}
+	}
+}
+
+// MoneyPolicy is the representation required for money amounts.
+type MoneyPolicy string
+
+const (
+	// MoneyAny accepts decimal strings and integer minor units, and only
+	// rejects floating-point numbers.
+	MoneyAny MoneyPolicy = "any"
+	// MoneyDecimalString requires strings (e.g., "12.34").
+	MoneyDecimalString MoneyPolicy = "decimal-string"
+	// MoneyMinorUnits requires integers counting minor units (e.g., cents).
+	MoneyMinorUnits MoneyPolicy = "minor-units"
+)
+
+// lintMoney flags properties whose names suggest a money amount but that
+// are floating-point numbers, which cannot represent most decimal amounts
+// exactly, or that do not follow the configured money policy.
+func (l *Linter) lintMoney(schema *Schema, path Path, result *Result) {
+	policy := l.config.MoneyPolicy
+	if policy == "" {
+		policy = MoneyAny
+	}
+	for _, name := range sortedKeys(schema.Properties) {
+		prop := schema.Properties[name]
+		if prop == nil || prop.IsBooleanSchema || prop.IsRef() || !matchesAny(l.config.MoneyNamePatterns, name) {
+			continue
+		}
+
+		var message string
+		switch kind := schemaKind(prop); {
+		case kind == "number":
+			message = fmt.Sprintf("Property '%s' looks like a money amount but is a floating-point number, which cannot represent most decimal amounts exactly", name)
+		case kind == "integer" && policy == MoneyDecimalString:
+			message = fmt.Sprintf("Property '%s' looks like a money amount but is an integer; the money policy requires decimal strings", name)
+		case kind == "string" && policy == MoneyMinorUnits:
+			message = fmt.Sprintf("Property '%s' looks like a money amount but is a string; the money policy requires integer minor units", name)
+		default:
+			continue
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeFloatMoney,
+			Severity:   SeverityWarning,
+			Path:       path.Child("properties", name),
+			Message:    message,
+			Suggestion: moneySuggestions[policy],
+		})
+	}
+}
+
+// moneySuggestions are the fix suggestions for float-money by policy.
+var moneySuggestions = map[MoneyPolicy]string{
+	MoneyAny:           "Use a string with a decimal format (e.g., \"12.34\") or an integer count of minor units (e.g., cents)",
+	MoneyDecimalString: "Use a string with a decimal format (e.g., \"12.34\") and a pattern such as ^-?[0-9]+(\\.[0-9]+)?$",
+	MoneyMinorUnits:    "Use an integer count of minor units (e.g., cents) with the currency in a sibling property",
+}
+
+// lintNullableOptional flags properties that are optional and nullable, so
+// that absent and null are two encodings of "no value". Go generators
+// cannot tell them apart without extra machinery.
+func (l *Linter) lintNullableOptional(schema *Schema, path Path, result *Result) {
+	for _, name := range sortedKeys(schema.Properties) {
+		if slices.Contains(schema.Required, name) || !isNullable(schema.Properties[name]) {
+			continue
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeNullableOptional,
+			Severity:   SeverityWarning,
+			Path:       path.Child("properties", name),
+			Message:    fmt.Sprintf("Property '%s' is both optional and nullable, so absent and null can mean different things", name),
+			Suggestion: "Pick one semantics: make the property required and nullable, or optional without null",
+		})
+	}
+}
+
+// isNullable reports whether a schema accepts null alongside other values,
+// through a type list, a null union variant, or a null enum value.
+func isNullable(s *Schema) bool {
+	if s == nil || s.IsBooleanSchema {
+		return false
+	}
+	if len(s.TypeList) > 1 && slices.Contains(s.TypeList, "null") {
+		return true
+	}
+	if variants := s.GetUnionVariants(); len(variants) > 1 && len(nonNullVariants(s)) < len(variants) {
+		return true
+	}
+	return len(s.Enum) > 1 && slices.Contains(s.Enum, any(nil))
+}
+
+// isPlainString returns true for string schemas (optionally nullable) that
+// are not constrained to specific values or a pattern.
+func isPlainString(s *Schema) bool {
+	if s == nil || s.IsBooleanSchema || s.IsRef() || len(s.Enum) > 0 || s.Const != nil || s.Pattern != "" {
+		return false
+	}
+	if s.Type != "" {
+		return s.Type == "string"
+	}
+	var str bool
+	for _, t := range s.TypeList {
+		switch t {
+		case "string":
+			str = true
+		case "null":
+		default:
+			return false
+		}
+	}
+	return str
+}
+
+// validateNamePatterns returns an error if a glob pattern is malformed.
+func validateNamePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid name pattern %q: %w", pattern, err)
+		}
+	}
+	return nil
+}
+
+// matchesAny reports whether name matches any of the glob patterns.
+func matchesAny(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// booleanWords are string enum values that encode a boolean.
+var booleanWords = map[string]bool{
+	"true":  true,
+	"false": false,
+	"yes":   true,
+	"no":    false,
+}
+
+// lintBooleanEnum flags enums that encode a boolean as the integers 0 and 1
+// or as strings such as "true"/"false" or "yes"/"no", which are common in
+// schemas converted from databases and generate int or string fields.
+func (l *Linter) lintBooleanEnum(schema *Schema, path Path, result *Result) {
+	if len(schema.Enum) != 2 {
+		return
+	}
+
+	var kind string
+	truth := make(map[bool]bool, 2)
+	for _, v := range schema.Enum {
+		if n, ok := v.(json.Number); ok {
+			v, _ = n.Float64()
+		}
+		switch v := v.(type) {
+		case float64:
+			if kind == "string" || (v != 0 && v != 1) {
+				return
+			}
+			kind = "integer"
+			truth[v == 1] = true
+		case string:
+			b, ok := booleanWords[strings.ToLower(v)]
+			if kind == "integer" || !ok {
+				return
+			}
+			kind = "string"
+			truth[b] = true
+		default:
+			return
+		}
+	}
+	if !truth[true] || !truth[false] {
+		return
+	}
+
+	result.Issues = append(result.Issues, Issue{
+		Code:       CodeBooleanEnum,
+		Severity:   SeverityWarning,
+		Path:       path.Child("enum"),
+		Message:    fmt.Sprintf("Enum %s encodes a boolean as %s values", formatEnum(schema.Enum), kind),
+		Suggestion: "Use 'type: boolean' so generators produce a bool field",
+	})
+}
+
+// formatEnum formats enum values as JSON (e.g., [0, 1] or ["yes", "no"]).
+func formatEnum(values []any) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = enumLiteral(v)
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// enumLiteral formats an enum value, quoting strings.
+func enumLiteral(v any) string {
+	if s, ok := v.(string); ok {
+		return fmt.Sprintf("%q", s)
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,292 @@
+package linter
+
+import (
+	"testing"
+)
+
+func TestLintProseEnum(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Status": {"type": "string", "description": "Order status. One of: pending, shipped, delivered."},
+			"Color": {"type": "string", "description": "Allowed values are red and blue.", "enum": ["red", "blue"]},
+			"Name": {"type": "string", "description": "Display name."}
+		}
+	}`
+
+	l := NewWithDefaults()
+	result, err := l.Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	for _, issue := range result.Issues {
+		if issue.Code == CodeProseEnum {
+			t.Errorf("Did not expect prose-enum when the rule is not enabled")
+		}
+	}
+
+	config := DefaultConfig()
+	config.DetectProseEnums = true
+	result, err = New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	var paths []string
+	for _, issue := range result.Issues {
+		if issue.Code == CodeProseEnum {
+			paths = append(paths, issue.Path.String())
+		}
+	}
+	if len(paths) != 1 || paths[0] != "$/$defs/Status" {
+		t.Errorf("Expected prose-enum only at $/$defs/Status, got: %v", paths)
+	}
+}
+
+func TestLintGenericContainer(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"AnyEnvelope": {"type": "object", "properties": {"data": {}}},
+			"TrueEnvelope": {"type": "object", "properties": {"payload": true}},
+			"MapEnvelope": {"type": "object", "properties": {"value": {"type": "object", "additionalProperties": true}}},
+			"OpenEnvelope": {"type": "object", "properties": {"data": {"type": "object", "description": "Anything"}}},
+			"TypedEnvelope": {"type": "object", "properties": {"data": {"$ref": "#/$defs/Pet"}}},
+			"TypedMap": {"type": "object", "properties": {"data": {"type": "object", "additionalProperties": {"type": "string"}}}},
+			"ClosedEnvelope": {"type": "object", "properties": {"data": {"type": "object", "additionalProperties": false}}},
+			"Paged": {"type": "object", "properties": {"data": {}, "next": {"type": "string"}}},
+			"Other": {"type": "object", "properties": {"extra": {}}},
+			"Pet": {"type": "object", "properties": {"name": {"type": "string"}}}
+		}
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	want := map[string]bool{
+		"$/$defs/AnyEnvelope/properties/data":     true,
+		"$/$defs/TrueEnvelope/properties/payload": true,
+		"$/$defs/MapEnvelope/properties/value":    true,
+		"$/$defs/OpenEnvelope/properties/data":    true,
+	}
+	for _, issue := range result.Issues {
+		if issue.Code != CodeGenericContainer {
+			continue
+		}
+		if !want[issue.Path.String()] {
+			t.Errorf("Unexpected generic-container at %s", issue.Path)
+		}
+		delete(want, issue.Path.String())
+	}
+	for path := range want {
+		t.Errorf("Expected generic-container at %s", path)
+	}
+}
+
+func TestLintStringlyTyped(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"created_at": {"type": "string"},
+			"updated_at": {"type": "string", "format": "date-time"},
+			"birthDate": {"type": ["string", "null"]},
+			"start_time": {"type": "string", "format": "time"},
+			"deleted_at": {"type": "integer"},
+			"user_id": {"type": "string"},
+			"order_id": {"type": "string", "format": "uuid"},
+			"customer_id": {"type": "string", "pattern": "^cus_[a-z0-9]+$"},
+			"uuid": {"type": "string"},
+			"name": {"type": "string"}
+		}
+	}`
+
+	codes := func(config Config) map[string]IssueCode {
+		result, err := New(config).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		got := make(map[string]IssueCode)
+		for _, issue := range result.Issues {
+			if issue.Code == CodeStringlyTypedTimestamp || issue.Code == CodeStringlyTypedID {
+				got[issue.Path.String()] = issue.Code
+			}
+		}
+		return got
+	}
+
+	config := DefaultConfig()
+	config.PropertyCase = CaseNone
+	got := codes(config)
+	want := map[string]IssueCode{
+		"$/properties/created_at": CodeStringlyTypedTimestamp,
+		"$/properties/birthDate":  CodeStringlyTypedTimestamp,
+		"$/properties/user_id":    CodeStringlyTypedID,
+		"$/properties/uuid":       CodeStringlyTypedID,
+	}
+	if len(got) != len(want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	for path, code := range want {
+		if got[path] != code {
+			t.Errorf("Expected %s at %s, got %q", code, path, got[path])
+		}
+	}
+
+	config.TimestampNamePatterns = []string{"*_time"}
+	config.IDNamePatterns = nil
+	if got := codes(config); len(got) != 0 {
+		t.Errorf("Expected no issues with custom patterns, got %v", got)
+	}
+
+	config.IDNamePatterns = []string{"[a-"}
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for malformed name pattern")
+	}
+}
+
+func TestLintBooleanEnum(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Flag": {"type": "integer", "enum": [0, 1]},
+			"Active": {"type": "string", "enum": ["true", "false"]},
+			"Opted": {"type": "string", "enum": ["No", "Yes"]},
+			"Mixed": {"type": "string", "enum": ["yes", "false"]},
+			"Level": {"type": "integer", "enum": [1, 2]},
+			"Same": {"type": "string", "enum": ["yes", "true"]},
+			"Tri": {"type": "string", "enum": ["yes", "no", "maybe"]},
+			"Types": {"enum": [0, "false"]}
+		}
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	want := map[string]bool{
+		"$/$defs/Flag/enum":   true,
+		"$/$defs/Active/enum": true,
+		"$/$defs/Opted/enum":  true,
+		"$/$defs/Mixed/enum":  true,
+	}
+	for _, issue := range result.Issues {
+		if issue.Code != CodeBooleanEnum {
+			continue
+		}
+		if !want[issue.Path.String()] {
+			t.Errorf("Unexpected boolean-enum at %s", issue.Path)
+		}
+		delete(want, issue.Path.String())
+	}
+	for path := range want {
+		t.Errorf("Expected boolean-enum at %s", path)
+	}
+}
+
+func TestLintNullableOptional(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"nickname": {"type": ["string", "null"]},
+			"deletedAt": {"type": ["string", "null"], "format": "date-time"},
+			"parent": {"anyOf": [{"$ref": "#/$defs/Node"}, {"type": "null"}]},
+			"color": {"enum": ["red", "blue", null]},
+			"title": {"type": "string"},
+			"nothing": {"type": "null"}
+		},
+		"required": ["deletedAt"],
+		"$defs": {"Node": {"type": "object"}}
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	for _, issue := range result.Issues {
+		if issue.Code == CodeNullableOptional {
+			t.Errorf("Did not expect nullable-optional when the rule is not enabled")
+		}
+	}
+
+	config := DefaultConfig()
+	config.DetectNullableOptional = true
+	result, err = New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	want := map[string]bool{
+		"$/properties/nickname": true,
+		"$/properties/parent":   true,
+		"$/properties/color":    true,
+	}
+	for _, issue := range result.Issues {
+		if issue.Code != CodeNullableOptional {
+			continue
+		}
+		if !want[issue.Path.String()] {
+			t.Errorf("Unexpected nullable-optional at %s", issue.Path)
+		}
+		delete(want, issue.Path.String())
+	}
+	for path := range want {
+		t.Errorf("Expected nullable-optional at %s", path)
+	}
+}
+
+func TestLintFloatMoney(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"amount": {"type": "number"},
+			"unitPrice": {"type": ["number", "null"]},
+			"balance": {"type": "integer"},
+			"total_amount": {"type": "string", "pattern": "^[0-9]+\\.[0-9]{2}$"},
+			"weight": {"type": "number"}
+		}
+	}`
+
+	paths := func(config Config) []string {
+		result, err := New(config).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		var got []string
+		for _, issue := range result.Issues {
+			if issue.Code == CodeFloatMoney {
+				got = append(got, issue.Path.String())
+			}
+		}
+		return got
+	}
+
+	config := DefaultConfig()
+	config.PropertyCase = CaseNone
+	tests := []struct {
+		policy MoneyPolicy
+		want   []string
+	}{
+		{MoneyAny, []string{"$/properties/amount", "$/properties/unitPrice"}},
+		{MoneyDecimalString, []string{"$/properties/amount", "$/properties/balance", "$/properties/unitPrice"}},
+		{MoneyMinorUnits, []string{"$/properties/amount", "$/properties/total_amount", "$/properties/unitPrice"}},
+	}
+	for _, tt := range tests {
+		config.MoneyPolicy = tt.policy
+		got := paths(config)
+		if len(got) != len(tt.want) {
+			t.Errorf("%s: expected %v, got %v", tt.policy, tt.want, got)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("%s: expected %v, got %v", tt.policy, tt.want, got)
+				break
+			}
+		}
+	}
+
+	config.MoneyPolicy = "float"
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for unknown money policy")
+	}
+}
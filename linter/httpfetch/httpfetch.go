@@ -0,0 +1,71 @@
+// Package httpfetch fetches the URL and schema registry sources of crawl
+// manifests over HTTP. It is separate from the linter package so that the
+// linter does not depend on net/http.
+package httpfetch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// New returns a linter.FetchFunc that gets URL sources, and the subject
+// versions of registry sources from a Confluent-compatible schema
+// registry, with client. A nil client uses one with a 30 second timeout.
+func New(client *http.Client) linter.FetchFunc {
+	if client == nil {
+		client = &http.Client{Timeout: 30 * time.Second}
+	}
+	return func(ctx context.Context, s linter.Source) ([]byte, error) {
+		return fetch(ctx, client, s)
+	}
+}
+
+// fetch reads a URL or registry source.
+func fetch(ctx context.Context, client *http.Client, s linter.Source) ([]byte, error) {
+	body, err := get(ctx, client, s.Location())
+	if err != nil || s.Registry == "" {
+		return body, err
+	}
+	var version struct {
+		Schema     string `json:"schema"`
+		SchemaType string `json:"schemaType"`
+	}
+	if err := json.Unmarshal(body, &version); err != nil {
+		return nil, fmt.Errorf("failed to parse registry response: %w", err)
+	}
+	// The registry omits schemaType for Avro schemas
+	if version.SchemaType != "JSON" {
+		schemaType := version.SchemaType
+		if schemaType == "" {
+			schemaType = "AVRO"
+		}
+		return nil, fmt.Errorf("subject %s has schema type %s, not JSON", s.Subject, schemaType)
+	}
+	return []byte(version.Schema), nil
+}
+
+func get(ctx context.Context, client *http.Client, location string) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, location, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", location, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("failed to fetch %s: %s", location, resp.Status)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch %s: %w", location, err)
+	}
+	return body, nil
+}
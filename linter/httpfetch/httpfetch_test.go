@@ -0,0 +1,58 @@
+package httpfetch
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+func TestFetch(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/billing.json":
+			_, _ = w.Write([]byte(`{"type": "string"}`))
+		case "/subjects/payments-value/versions/latest":
+			_, _ = w.Write([]byte(`{"subject": "payments-value", "version": 3, "schemaType": "JSON", "schema": "{\"type\": \"number\"}"}`))
+		case "/subjects/orders-value/versions/2":
+			_, _ = w.Write([]byte(`{"subject": "orders-value", "version": 2, "schema": "{\"type\": \"record\"}"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name    string
+		source  linter.Source
+		want    string
+		wantErr string
+	}{
+		{name: "url", source: linter.Source{URL: server.URL + "/billing.json"}, want: `{"type": "string"}`},
+		{name: "registry", source: linter.Source{Registry: server.URL + "/", Subject: "payments-value"}, want: `{"type": "number"}`},
+		{name: "avro subject", source: linter.Source{Registry: server.URL, Subject: "orders-value", Version: "2"}, wantErr: "schema type AVRO"},
+		{name: "not found", source: linter.Source{URL: server.URL + "/missing.json"}, wantErr: "404"},
+	}
+
+	fetch := New(server.Client())
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := fetch(context.Background(), tt.source)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if string(got) != tt.want {
+				t.Errorf("Expected %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,152 @@
+package linter
+
+import (
+	"fmt"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// IDLocation is where a schema was read from, for checking its $id
+// against IDTemplate: a file path, or a registry subject.
+type IDLocation struct {
+	Path    string
+	Subject string
+}
+
+// declaredID is a root $id, recorded in a result to compare across the
+// schemas of a project.
+type declaredID struct {
+	id   string
+	path Path
+}
+
+// normalizeID returns an $id without an empty fragment, which does not
+// change its meaning.
+func normalizeID(id string) string {
+	return strings.TrimSuffix(id, "#")
+}
+
+// lintIDs reports $ids that are not absolute URIs and $ids declared twice
+// in the document, skipping ignored paths, and records the root $id in the
+// result for IDTemplate and cross-schema checks.
+func (l *Linter) lintIDs(schema *Schema, root Path, ignored map[string]bool, result *Result) {
+	if schema.ID != "" && !ignored[root.String()] {
+		result.ids = append(result.ids, declaredID{id: normalizeID(schema.ID), path: root})
+	}
+
+	seen := make(map[string]string)
+	check := func(s *Schema, p Path, _ bool) {
+		if s.ID == "" || ignored[definitionPath(root, p).String()] {
+			return
+		}
+		if u, err := url.Parse(s.ID); err != nil || !u.IsAbs() {
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeRelativeID,
+				Severity:   SeverityWarning,
+				Path:       p.Child("$id"),
+				Message:    fmt.Sprintf("$id %q is not an absolute URI, so it resolves differently depending on where the schema is loaded from", s.ID),
+				Suggestion: "Use an absolute URI (e.g., https://schemas.example.com/orders/order.json)",
+			})
+		}
+		id := normalizeID(s.ID)
+		if first, ok := seen[id]; ok {
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeDuplicateID,
+				Severity:   SeverityError,
+				Path:       p.Child("$id"),
+				Message:    fmt.Sprintf("$id %q is also declared at %s, so $refs to it are ambiguous", s.ID, first),
+				Suggestion: "Give each schema a unique $id",
+			})
+			return
+		}
+		seen[id] = p.String()
+	}
+	walkSchema(schema, root, false, check)
+	for _, name := range sortedKeys(schema.Defs) {
+		walkSchema(schema.Defs[name], root.Child("$defs", name), false, check)
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		walkSchema(schema.Definitions[name], root.Child("definitions", name), false, check)
+	}
+}
+
+// expandIDTemplate returns the $id IDTemplate expects at a location, or ""
+// if the template uses a placeholder the location does not provide.
+// {dir} is the slash-separated directory of the path ("" in the current
+// directory), {name} its base name without extension, and {subject} the
+// registry subject.
+func expandIDTemplate(template string, loc IDLocation) string {
+	if loc.Path == "" && (strings.Contains(template, "{dir}") || strings.Contains(template, "{name}")) ||
+		loc.Subject == "" && strings.Contains(template, "{subject}") {
+		return ""
+	}
+	file := path.Clean(strings.ReplaceAll(loc.Path, "\\", "/"))
+	dir := path.Dir(file)
+	if dir == "." {
+		dir = ""
+		template = strings.ReplaceAll(template, "{dir}/", "")
+	}
+	name := strings.TrimSuffix(path.Base(file), path.Ext(file))
+	return strings.NewReplacer("{dir}", dir, "{name}", name, "{subject}", loc.Subject).Replace(template)
+}
+
+// CheckIDLocation reports the root $ids of a result that do not match
+// IDTemplate expanded for the location the schema was read from, and
+// schemas without a root $id. It does nothing without a template.
+func (l *Linter) CheckIDLocation(result *Result, loc IDLocation) {
+	if l.config.IDTemplate == "" {
+		return
+	}
+	want := expandIDTemplate(l.config.IDTemplate, loc)
+	if want == "" {
+		return
+	}
+	start := len(result.Issues)
+	if len(result.ids) == 0 {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeIDTemplateMismatch,
+			Severity:   SeverityWarning,
+			Path:       rootPath,
+			Message:    fmt.Sprintf("Schema has no $id; the id_template convention expects %q", want),
+			Suggestion: fmt.Sprintf("Set $id to %q", want),
+		})
+	}
+	for _, d := range result.ids {
+		if d.id != normalizeID(want) {
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeIDTemplateMismatch,
+				Severity:   SeverityWarning,
+				Path:       d.path.Child("$id"),
+				Message:    fmt.Sprintf("$id %q does not match the id_template convention, which expects %q", d.id, want),
+				Suggestion: fmt.Sprintf("Set $id to %q, or move the schema to match its $id", want),
+			})
+		}
+	}
+	l.filterRules(result, start)
+}
+
+// CheckDuplicateIDs reports root $ids declared by more than one of the
+// results (e.g., the files of a directory or the sources of a manifest),
+// in each result after the first. Definitions are not compared across
+// schemas, since bundling copies them with their $id.
+func (l *Linter) CheckDuplicateIDs(results []*Result) {
+	first := make(map[string]string)
+	for _, result := range results {
+		start := len(result.Issues)
+		for _, d := range result.ids {
+			if where, ok := first[d.id]; ok {
+				result.Issues = append(result.Issues, Issue{
+					Code:       CodeDuplicateID,
+					Severity:   SeverityError,
+					Path:       d.path.Child("$id"),
+					Message:    fmt.Sprintf("$id %q is also declared by %s, so $refs to it are ambiguous", d.id, where),
+					Suggestion: "Give each schema a unique $id",
+				})
+				continue
+			}
+			first[d.id] = result.SchemaPath
+		}
+		l.filterRules(result, start)
+	}
+}
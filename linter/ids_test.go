@@ -0,0 +1,99 @@
+package linter
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLintIDs(t *testing.T) {
+	schema := `{
+		"$id": "https://schemas.example.com/orders/order.json",
+		"$defs": {
+			"Item": {"$id": "item.json", "type": "object"},
+			"Line": {"$id": "https://schemas.example.com/orders/order.json#", "type": "object"}
+		}
+	}`
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	got := map[string]IssueCode{}
+	for _, issue := range result.Issues {
+		if issue.Code == CodeRelativeID || issue.Code == CodeDuplicateID {
+			got[issue.Path.String()] = issue.Code
+		}
+	}
+	want := map[string]IssueCode{
+		"$/$defs/Item/$id": CodeRelativeID,
+		"$/$defs/Line/$id": CodeDuplicateID,
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for path, code := range want {
+		if got[path] != code {
+			t.Errorf("Expected %s at %s, got %v", code, path, got)
+		}
+	}
+}
+
+func TestExpandIDTemplate(t *testing.T) {
+	tests := []struct {
+		template string
+		loc      IDLocation
+		want     string
+	}{
+		{"https://schemas.example.com/{dir}/{name}.json", IDLocation{Path: "orders/v1/order.json"}, "https://schemas.example.com/orders/v1/order.json"},
+		{"https://schemas.example.com/{dir}/{name}.json", IDLocation{Path: "order.json"}, "https://schemas.example.com/order.json"},
+		{"https://schemas.example.com/{subject}", IDLocation{Subject: "orders-value"}, "https://schemas.example.com/orders-value"},
+		{"https://schemas.example.com/{subject}", IDLocation{Path: "order.json"}, ""},
+		{"https://schemas.example.com/{name}.json", IDLocation{Subject: "orders-value"}, ""},
+	}
+	for _, tt := range tests {
+		if got := expandIDTemplate(tt.template, tt.loc); got != tt.want {
+			t.Errorf("expandIDTemplate(%q, %v) = %q, want %q", tt.template, tt.loc, got, tt.want)
+		}
+	}
+}
+
+func TestCheckIDs(t *testing.T) {
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"order.json":   `{"$id": "https://schemas.example.com/order.json", "type": "object"}`,
+		"invoice.json": `{"$id": "https://schemas.example.com/order.json", "type": "object"}`,
+		"refund.json":  `{"type": "object"}`,
+	} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	config := DefaultConfig()
+	config.IDTemplate = "https://schemas.example.com/{name}.json"
+	m := &Manifest{Sources: []Source{{Path: "order.json"}, {Path: "invoice.json"}, {Path: "refund.json"}}}
+	results := New(config).Crawl(context.Background(), m, os.DirFS(dir), nil)
+
+	want := [][]IssueCode{
+		nil,
+		{CodeIDTemplateMismatch, CodeDuplicateID},
+		{CodeIDTemplateMismatch},
+	}
+	for i, result := range results {
+		var got []IssueCode
+		for _, issue := range result.Issues {
+			got = append(got, issue.Code)
+		}
+		if len(got) != len(want[i]) {
+			t.Errorf("%s: expected %v, got %v", result.SchemaPath, want[i], result.Issues)
+			continue
+		}
+		for j := range got {
+			if got[j] != want[i][j] {
+				t.Errorf("%s: expected %v, got %v", result.SchemaPath, want[i], got)
+				break
+			}
+		}
+	}
+}
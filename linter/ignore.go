@@ -0,0 +1,62 @@
+package linter
+
+import (
+	"strings"
+)
+
+// ignoredDefinitions returns the paths of the document root and definitions
+// whose $id matches a configured ignore prefix.
+func (l *Linter) ignoredDefinitions(schema *Schema, root Path) map[string]bool {
+	if len(l.config.IgnoreIDPrefixes) == 0 {
+		return nil
+	}
+
+	ignored := make(map[string]bool)
+	check := func(s *Schema, path Path) {
+		if s != nil && l.ignoresID(s.ID) {
+			ignored[path.String()] = true
+		}
+	}
+	check(schema, root)
+	for name, def := range schema.Defs {
+		check(def, root.Child("$defs", name))
+	}
+	for name, def := range schema.Definitions {
+		check(def, root.Child("definitions", name))
+	}
+	return ignored
+}
+
+// ignoresID reports whether the $id starts with an ignore prefix.
+func (l *Linter) ignoresID(id string) bool {
+	if id == "" {
+		return false
+	}
+	for _, prefix := range l.config.IgnoreIDPrefixes {
+		if strings.HasPrefix(id, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// dropIgnored removes issues located in ignored definitions, such as those
+// found by analyses that follow $refs. Issues dropped from vendored
+// definitions (matched by IgnoreIDPrefixes) are recorded as suppressed.
+// Issues before index start belong to other documents and are kept.
+func dropIgnored(ignored, vendored map[string]bool, root Path, result *Result, start int) {
+	if len(ignored) == 0 {
+		return
+	}
+	kept := result.Issues[:start]
+	for _, issue := range result.Issues[start:] {
+		path := definitionPath(root, issue.Path).String()
+		switch {
+		case !ignored[path]:
+			kept = append(kept, issue)
+		case vendored[path]:
+			result.Suppressed = append(result.Suppressed, Suppression{Issue: issue, Source: SuppressedIgnoredID})
+		}
+	}
+	result.Issues = kept
+}
@@ -0,0 +1,62 @@
+package linter
+
+import "testing"
+
+func TestIgnoreIDPrefixes(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"address": {"$ref": "#/$defs/Address"},
+			"geo": {"$ref": "#/$defs/Point"}
+		},
+		"required": ["geo"],
+		"$defs": {
+			"Address": {
+				"type": "object",
+				"properties": {"zip": {"type": "integer", "pattern": "^[0-9]+$"}}
+			},
+			"Point": {
+				"$id": "https://geojson.org/schema/Point.json",
+				"type": "object",
+				"properties": {
+					"type": {"type": "string", "minLength": 5, "maxLength": 1},
+					"bbox": {"type": "integer", "minItems": 4}
+				},
+				"required": ["type"]
+			}
+		}
+	}`
+
+	config := DefaultConfig()
+	config.IgnoreIDPrefixes = []string{"https://geojson.org/"}
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	var own bool
+	for _, issue := range result.Issues {
+		if issue.Path.HasPrefix(ParsePath("$/$defs/Point")) {
+			t.Errorf("Unexpected issue in ignored definition: %s", issue)
+		}
+		own = own || issue.Path.String() == "$/$defs/Address/properties/zip/pattern"
+	}
+	if !own {
+		t.Errorf("Expected issues in owned definitions, got: %v", result.Issues)
+	}
+
+	// Without the prefix, the vendored definition is linted
+	result, err = NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var vendored int
+	for _, issue := range result.Issues {
+		if issue.Path.HasPrefix(ParsePath("$/$defs/Point")) {
+			vendored++
+		}
+	}
+	if vendored == 0 {
+		t.Error("Expected issues in the definition when it is not ignored")
+	}
+}
@@ -0,0 +1,186 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// inheritance is the merged object of the "allOf: [{$ref: Base}, {extra
+// properties}]" inheritance idiom, with the conflicts found merging it.
+type inheritance struct {
+	properties map[string]*Schema
+	required   []string
+	// closed are the parts with additionalProperties: false
+	closed    []*Schema
+	conflicts []string
+}
+
+// inheritanceParts returns the allOf parts of s, with $refs resolved, if
+// s is the inheritance idiom: at least one part is a $ref, and every
+// part is an object (or untyped, e.g., only adding required) without anyOf
+// or oneOf. A referenced base may itself use the idiom.
+func (l *Linter) inheritanceParts(doc *Schema, root Path, s *Schema) ([]*Schema, bool) {
+	if len(s.AllOf) == 0 || len(s.AnyOf) > 0 || len(s.OneOf) > 0 {
+		return nil, false
+	}
+	parts := make([]*Schema, 0, len(s.AllOf))
+	refs := 0
+	for _, part := range s.AllOf {
+		if part != nil && part.IsRef() {
+			refs++
+			part, _, _ = l.resolveRef(doc, root, part.Ref)
+		}
+		if part == nil || part.IsBooleanSchema || part.IsRef() || len(part.AnyOf) > 0 || len(part.OneOf) > 0 {
+			return nil, false
+		}
+		if kind := schemaKind(part); kind != "" && kind != "object" {
+			return nil, false
+		}
+		parts = append(parts, part)
+	}
+	return parts, refs > 0
+}
+
+// mergeInheritance merges the properties and required lists of the parts
+// of an inheritance idiom and of s itself, in order, following bases that
+// use the idiom. A property declared with different types in two parts is
+// a conflict, since no instance can satisfy both; conflicts within a base
+// are left to the base.
+func (l *Linter) mergeInheritance(doc *Schema, root Path, s *Schema, seen map[*Schema]bool) *inheritance {
+	m := &inheritance{properties: make(map[string]*Schema)}
+	if seen[s] {
+		return m
+	}
+	seen[s] = true
+
+	add := func(part *Schema) {
+		for _, name := range sortedKeys(part.Properties) {
+			prop := part.Properties[name]
+			if prev, ok := m.properties[name]; ok {
+				if a, b := schemaKind(prev), schemaKind(prop); a != "" && b != "" && a != b {
+					m.conflicts = append(m.conflicts, fmt.Sprintf("property '%s' is %s in one part and %s in another", name, article(a), article(b)))
+				}
+			}
+			m.properties[name] = prop
+		}
+		for _, name := range part.Required {
+			if !slices.Contains(m.required, name) {
+				m.required = append(m.required, name)
+			}
+		}
+	}
+
+	parts, _ := l.inheritanceParts(doc, root, s)
+	for _, part := range parts {
+		if _, ok := l.inheritanceParts(doc, root, part); ok {
+			base := l.mergeInheritance(doc, root, part, seen)
+			m.closed = append(m.closed, base.closed...)
+			add(&Schema{Properties: base.properties, Required: base.required})
+		} else {
+			add(part)
+		}
+		if part.AdditionalProperties != nil && !*part.AdditionalProperties {
+			m.closed = append(m.closed, part)
+		}
+	}
+	add(&Schema{Properties: s.Properties, Required: s.Required})
+	return m
+}
+
+// rejected returns the properties of the merged object that a part with
+// additionalProperties: false does not declare, and so rejects.
+func (m *inheritance) rejected() []string {
+	var names []string
+	for _, name := range sortedKeys(m.properties) {
+		for _, part := range m.closed {
+			if _, ok := part.Properties[name]; !ok {
+				names = append(names, name)
+				break
+			}
+		}
+	}
+	return names
+}
+
+// article returns a type name with its indefinite article.
+func article(kind string) string {
+	if strings.ContainsAny(kind[:1], "aeiou") {
+		return "an " + kind
+	}
+	return "a " + kind
+}
+
+// summary describes the merged object (e.g., "properties id (string),
+// radius (number); required: id").
+func (m *inheritance) summary() string {
+	names := sortedKeys(m.properties)
+	props := make([]string, len(names))
+	for i, name := range names {
+		kind := schemaKind(m.properties[name])
+		if kind == "" {
+			kind = "any"
+		}
+		props[i] = fmt.Sprintf("%s (%s)", name, kind)
+	}
+	summary := "properties " + strings.Join(props, ", ")
+	if len(m.required) > 0 {
+		summary += "; required: " + strings.Join(m.required, ", ")
+	}
+	return summary
+}
+
+// lintInheritance lints schemas using the allOf inheritance idiom as the
+// merged object they describe: the inline parts are linted as objects
+// (they are not linted otherwise, as the linter does not descend into
+// allOf), and conflicts between the parts are reported. In the scale
+// profile, the composition-disallowed finding for the allOf suggests the
+// flatten command with the merged object. start is the index of the
+// document's first issue.
+func (l *Linter) lintInheritance(schema *Schema, root Path, result *Result, start int) {
+	check := func(s *Schema, path Path, _ bool) {
+		if _, ok := l.inheritanceParts(schema, root, s); !ok {
+			return
+		}
+		for i, part := range s.AllOf {
+			if part == nil || part.IsRef() {
+				continue
+			}
+			inline := *part
+			if !inline.HasType() {
+				inline.Type = "object"
+			}
+			l.lintSchema(&inline, path.Child("allOf").Index(i), result, 0, false)
+		}
+
+		merged := l.mergeInheritance(schema, root, s, make(map[*Schema]bool))
+		conflicts := merged.conflicts
+		if rejected := merged.rejected(); len(rejected) > 0 {
+			conflicts = append(conflicts, fmt.Sprintf("a part sets additionalProperties: false, which rejects %s from the other parts", strings.Join(rejected, ", ")))
+		}
+		for _, conflict := range conflicts {
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeInheritanceConflict,
+				Severity:   SeverityWarning,
+				Path:       path.Child("allOf"),
+				Message:    "allOf parts conflict: " + conflict,
+				Suggestion: "Make the parts agree, or move additionalProperties: false from the base to the merged object (see 'schemakit flatten')",
+			})
+		}
+
+		if l.config.IsScaleProfile() {
+			for i := start; i < len(result.Issues); i++ {
+				if issue := &result.Issues[i]; issue.Code == CodeCompositionDisallowed && issue.Path.Equal(path.Child("allOf")) {
+					issue.Suggestion = "Run 'schemakit flatten' to replace the allOf inheritance with the merged object: " + merged.summary()
+				}
+			}
+		}
+	}
+	walkSchema(schema, root, false, check)
+	for _, name := range sortedKeys(schema.Defs) {
+		walkSchema(schema.Defs[name], root.Child("$defs", name), false, check)
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		walkSchema(schema.Definitions[name], root.Child("definitions", name), false, check)
+	}
+}
@@ -0,0 +1,62 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintInheritance(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Base": {
+				"type": "object",
+				"properties": {"id": {"type": "string"}, "size": {"type": "integer"}},
+				"additionalProperties": false
+			},
+			"Circle": {
+				"allOf": [
+					{"$ref": "#/$defs/Base"},
+					{"properties": {"Radius": {"type": "number"}, "size": {"type": "string"}}}
+				]
+			}
+		}
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var conflicts []string
+	caseIssue := false
+	for _, issue := range result.Issues {
+		switch issue.Code {
+		case CodeInheritanceConflict:
+			conflicts = append(conflicts, issue.Message)
+		case CodeInvalidPropertyCase:
+			caseIssue = caseIssue || issue.Path.String() == "$/$defs/Circle/allOf/1/properties/Radius"
+		}
+	}
+	if !caseIssue {
+		t.Error("Expected the extension part's properties to be linted")
+	}
+	if len(conflicts) != 2 || !strings.Contains(conflicts[0], "'size' is an integer in one part and a string") ||
+		!strings.Contains(conflicts[1], "rejects Radius from the other parts") {
+		t.Errorf("Expected type and additionalProperties conflicts, got %v", conflicts)
+	}
+
+	config := DefaultConfig()
+	config.Profile = ProfileScale
+	result, err = New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	for _, issue := range result.Issues {
+		if issue.Code == CodeMissingType && issue.Path.String() == "$/$defs/Circle/allOf/1" {
+			t.Error("Did not expect missing-type for the untyped extension part")
+		}
+		if issue.Code == CodeCompositionDisallowed && issue.Path.String() == "$/$defs/Circle/allOf" &&
+			!strings.Contains(issue.Suggestion, "schemakit flatten") {
+			t.Errorf("Expected the flatten command as the suggestion, got %q", issue.Suggestion)
+		}
+	}
+}
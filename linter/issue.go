@@ -4,6 +4,7 @@ package linter
 import (
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 )
 
@@ -25,20 +26,112 @@ const (
 	CodeInconsistentDiscriminator IssueCode = "inconsistent-discriminator"
 	CodeMissingConst              IssueCode = "missing-const"
 	CodeDuplicateConstValue       IssueCode = "duplicate-const-value"
+	CodeDiscriminatorEnumMismatch IssueCode = "discriminator-enum-mismatch"
+	CodeDuplicateID               IssueCode = "duplicate-id"
 	CodeInvalidPropertyCase       IssueCode = "invalid-property-case"
+	CodeInvalidPattern            IssueCode = "invalid-pattern"
+
+	// Budget errors - schemas exceeding configured size limits
+	CodeTooManyDefinitions IssueCode = "too-many-definitions"
+	CodeTooManyProperties  IssueCode = "too-many-properties"
+	CodeSchemaTooLarge     IssueCode = "schema-too-large"
 
 	// Warnings - these may cause issues or indicate suboptimal patterns
-	CodeLargeUnion        IssueCode = "large-union"
-	CodeNestedUnion       IssueCode = "nested-union"
-	CodeAdditionalProps   IssueCode = "additional-properties"
-	CodeAmbiguousUnion    IssueCode = "ambiguous-union"
-	CodeCircularReference IssueCode = "circular-reference"
+	CodeLargeUnion               IssueCode = "large-union"
+	CodeNestedUnion              IssueCode = "nested-union"
+	CodeAdditionalProps          IssueCode = "additional-properties"
+	CodeAmbiguousUnion           IssueCode = "ambiguous-union"
+	CodeUnionVariantOrder        IssueCode = "union-variant-order"
+	CodeCircularReference        IssueCode = "circular-reference"
+	CodeDeadKeyword              IssueCode = "dead-keyword"
+	CodeLargeEnum                IssueCode = "large-enum"
+	CodeUnsatisfiable            IssueCode = "unsatisfiable-schema"
+	CodeGenericContainer         IssueCode = "generic-container"
+	CodeStringlyTypedTimestamp   IssueCode = "stringly-typed-timestamp"
+	CodeStringlyTypedID          IssueCode = "stringly-typed-id"
+	CodeBooleanEnum              IssueCode = "boolean-enum"
+	CodeNullableOptional         IssueCode = "nullable-optional"
+	CodeFloatMoney               IssueCode = "float-money"
+	CodeMissingContentEncoding   IssueCode = "missing-content-encoding"
+	CodeContentEncodingNotString IssueCode = "content-encoding-not-string"
+	CodeInheritanceConflict      IssueCode = "inheritance-conflict"
+	CodeMissingUnitSuffix        IssueCode = "missing-unit-suffix"
+	CodeInconsistentPagination   IssueCode = "inconsistent-pagination"
+	CodeInconsistentErrorShape   IssueCode = "inconsistent-error-shape"
+	CodeVersionNaming            IssueCode = "version-naming"
+	CodeUnportablePattern        IssueCode = "unportable-pattern"
+	CodeUnanchoredPattern        IssueCode = "unanchored-pattern"
+	CodeRelativeID               IssueCode = "relative-id"
+	CodeIDTemplateMismatch       IssueCode = "id-template-mismatch"
+	CodeKeywordTypo              IssueCode = "keyword-typo"
+	CodeNonObjectRoot            IssueCode = "non-object-root"
+	CodeTitleNameMismatch        IssueCode = "title-name-mismatch"
+	CodeMissingSchemaDeclaration IssueCode = "missing-schema-declaration"
+	CodeDisallowedDraft          IssueCode = "disallowed-draft"
+	CodeNonASCIIName             IssueCode = "non-ascii-name"
+	CodeEnumMemberCase           IssueCode = "enum-member-case"
+	CodeDefinitionNameCase       IssueCode = "definition-name-case"
+	CodeImplicitAdditionalProps  IssueCode = "implicit-additional-properties"
+	CodeUnconstrainedMapKeys     IssueCode = "unconstrained-map-keys"
+	CodeMapOfUnion               IssueCode = "map-of-union"
+	CodeConstUnion               IssueCode = "const-union"
+	CodeMixedEnum                IssueCode = "mixed-enum"
+	CodeOpenTuple                IssueCode = "open-tuple"
+	CodeInvalidAnnotation        IssueCode = "invalid-annotation"
+
+	// Info - analysis that was skipped or needs more context
+	CodeUnresolvedUnion      IssueCode = "unresolved-union"
+	CodeProseEnum            IssueCode = "prose-enum"
+	CodeContains             IssueCode = "contains-constraint"
+	CodeUnknownKeyword       IssueCode = "unknown-keyword"
+	CodeRepeatedProperties   IssueCode = "repeated-properties"
+	CodeUnreachableFromRoots IssueCode = "unreachable-from-roots"
+	CodeDefinitionSplit      IssueCode = "definition-split"
+
+	// Validation errors - instance documents that do not match the schema
+	CodeInvalidInstance IssueCode = "invalid-instance"
+
+	// Go contract errors - mismatches between a Go type and the schema
+	CodeGoMissingField IssueCode = "go-missing-field"
+	CodeGoExtraField   IssueCode = "go-extra-field"
+	CodeGoTypeMismatch IssueCode = "go-type-mismatch"
+	CodeGoOptionality  IssueCode = "go-optionality-mismatch"
+
+	// Doctor findings - file problems outside the scope of lint rules
+	CodeByteOrderMark         IssueCode = "byte-order-mark"
+	CodeInvalidUTF8           IssueCode = "invalid-utf8"
+	CodeDuplicateKey          IssueCode = "duplicate-key"
+	CodeDeepJSONNesting       IssueCode = "deep-json-nesting"
+	CodeUnreachableDefinition IssueCode = "unreachable-definition"
+
+	// Source errors - files and manifest sources that could not be linted
+	CodeSourceUnreadable IssueCode = "source-unreadable"
+	CodeParseError       IssueCode = "parse-error"
+
+	// Config errors - assertions whose CEL expression does not compile
+	CodeInvalidAssertion IssueCode = "invalid-assertion"
+
+	// Avro findings - constructs that cannot be represented as an Avro record
+	CodeAvroOpenMap     IssueCode = "avro-open-map"
+	CodeAvroUnion       IssueCode = "avro-union"
+	CodeAvroUntyped     IssueCode = "avro-untyped"
+	CodeAvroAllOf       IssueCode = "avro-allof"
+	CodeAvroInvalidName IssueCode = "avro-invalid-name"
+	CodeAvroEnumSymbol  IssueCode = "avro-enum-symbol"
+
+	// Code generation previews - constructs the target language cannot express
+	CodeProtoUnrepresentable IssueCode = "proto-unrepresentable"
+	CodeCUEUnsupported       IssueCode = "cue-unsupported"
+
+	// Database mapping - constructs with no clean Postgres column mapping
+	CodeDDLUnmappable IssueCode = "ddl-unmappable"
 
 	// Scale profile errors - strict rules for static type compatibility
 	CodeCompositionDisallowed     IssueCode = "composition-disallowed"
 	CodeAdditionalPropsDisallowed IssueCode = "additional-properties-disallowed"
 	CodeMissingType               IssueCode = "missing-type"
 	CodeMixedTypeDisallowed       IssueCode = "mixed-type-disallowed"
+	CodeDynamicRefDisallowed      IssueCode = "dynamic-ref-disallowed"
 
 	// Navigable profile errors - rules for human review and AI agent authoring
 	CodeDeepNesting        IssueCode = "deep-nesting"
@@ -52,10 +145,18 @@ const (
 type Issue struct {
 	Code       IssueCode `json:"code"`
 	Severity   Severity  `json:"severity"`
-	Path       string    `json:"path"`
+	Path       Path      `json:"path"`
 	Message    string    `json:"message"`
 	Suggestion string    `json:"suggestion,omitempty"`
 	TypeName   string    `json:"type_name,omitempty"`
+	Owner      string    `json:"owner,omitempty"`
+	// Line and Column locate the issue in the source file (1-based) when
+	// it is tied to the file text rather than the parsed schema.
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+	// ReferencedBy lists the $ref locations that use the definition the
+	// issue is in, so an issue in a shared definition is reported once.
+	ReferencedBy []string `json:"referenced_by,omitempty"`
 }
 
 // String returns a human-readable representation of the issue.
@@ -65,6 +166,9 @@ func (i Issue) String() string {
 	if i.Suggestion != "" {
 		fmt.Fprintf(&sb, "\n  suggestion: %s", i.Suggestion)
 	}
+	if len(i.ReferencedBy) > 0 {
+		fmt.Fprintf(&sb, "\n  referenced by: %s", strings.Join(i.ReferencedBy, ", "))
+	}
 	return sb.String()
 }
 
@@ -72,6 +176,27 @@ func (i Issue) String() string {
 type Result struct {
 	SchemaPath string  `json:"schema_path"`
 	Issues     []Issue `json:"issues"`
+	// Suppressed are the issues left out of Issues by x-schemalint
+	// annotations, IgnoreIDPrefixes, or a baseline comparison.
+	Suppressed []Suppression `json:"suppressed,omitempty"`
+	// Timing is the execution time by rule, with Config.ProfileRules.
+	Timing *Timing `json:"timing,omitempty"`
+	// Metadata records the version, profile, and configuration of the lint
+	// run that produced the result.
+	Metadata *Metadata `json:"metadata,omitempty"`
+	// PathClass is the class of Config.PathClasses the schema file belongs
+	// to, which adjusted the severity of its issues; see ApplyPathClass.
+	PathClass string `json:"path_class,omitempty"`
+	// ids are the root $ids of the linted documents
+	ids []declaredID
+	// profiler times the rules while linting
+	profiler *profiler
+	// doc and root are the document being linted, for the rules on a
+	// subschema that resolve $refs
+	doc  *Schema
+	root Path
+	// celViews caches the CEL values of the schemas for the assertions
+	celViews map[*Schema]map[string]any
 }
 
 // ErrorCount returns the number of error-severity issues.
@@ -128,17 +253,86 @@ func (r Result) String() string {
 	return sb.String()
 }
 
+// OwnerGroup is the issues attributed to one owner.
+type OwnerGroup struct {
+	Owner  string  `json:"owner"`
+	Issues []Issue `json:"issues"`
+}
+
+// UnownedGroup is the owner name used for issues without an x-owner.
+const UnownedGroup = "(unowned)"
+
+// GroupByOwner returns the issues grouped by owner, sorted by owner name,
+// with issues that have no owner last.
+func (r Result) GroupByOwner() []OwnerGroup {
+	index := make(map[string]int)
+	var groups []OwnerGroup
+	for _, issue := range r.Issues {
+		owner := issue.Owner
+		if owner == "" {
+			owner = UnownedGroup
+		}
+		i, ok := index[owner]
+		if !ok {
+			i = len(groups)
+			index[owner] = i
+			groups = append(groups, OwnerGroup{Owner: owner})
+		}
+		groups[i].Issues = append(groups[i].Issues, issue)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		if (groups[i].Owner == UnownedGroup) != (groups[j].Owner == UnownedGroup) {
+			return groups[j].Owner == UnownedGroup
+		}
+		return groups[i].Owner < groups[j].Owner
+	})
+	return groups
+}
+
+// StringByOwner returns a human-readable summary with issues grouped under
+// their owners.
+func (r Result) StringByOwner() string {
+	if len(r.Issues) == 0 {
+		return r.String()
+	}
+
+	var sb strings.Builder
+	for _, group := range r.GroupByOwner() {
+		sub := Result{Issues: group.Issues}
+		fmt.Fprintf(&sb, "## %s: %d error(s), %d warning(s)\n", group.Owner, sub.ErrorCount(), sub.WarningCount())
+		for _, issue := range group.Issues {
+			sb.WriteString(issue.String())
+			sb.WriteString("\n")
+		}
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "Summary: %d error(s), %d warning(s)\n", r.ErrorCount(), r.WarningCount())
+	return sb.String()
+}
+
 // GitHubAnnotations returns issues formatted as GitHub Actions annotations.
 func (r Result) GitHubAnnotations() string {
 	var sb strings.Builder
 	for _, issue := range r.Issues {
 		// Format: ::{level} file={path}::{message}
 		level := "warning"
-		if issue.Severity == SeverityError {
+		switch issue.Severity {
+		case SeverityError:
 			level = "error"
+		case SeverityInfo:
+			level = "notice"
+		}
+		location := "file=" + r.SchemaPath
+		if issue.Line > 0 {
+			location += fmt.Sprintf(",line=%d,col=%d", issue.Line, issue.Column)
+		}
+		if issue.Owner != "" {
+			fmt.Fprintf(&sb, "::%s %s::%s - %s (owner: %s)\n",
+				level, location, issue.Code, issue.Message, issue.Owner)
+			continue
 		}
-		fmt.Fprintf(&sb, "::%s file=%s::%s - %s\n",
-			level, r.SchemaPath, issue.Code, issue.Message)
+		fmt.Fprintf(&sb, "::%s %s::%s - %s\n",
+			level, location, issue.Code, issue.Message)
 	}
 	return sb.String()
 }
@@ -0,0 +1,119 @@
+package linter
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// NormalizeJSON returns schema source data as plain UTF-8 JSON: a leading
+// UTF-8 byte order mark is removed, and comments and trailing commas are
+// blanked (see StripJSONC). Data encoded as UTF-16 or UTF-32, e.g. by
+// Windows editors, is rejected with a *LintError wrapping ErrParse that
+// names the encoding, instead of an "invalid character" error.
+func NormalizeJSON(data []byte) ([]byte, error) {
+	if encoding := wideEncoding(data); encoding != "" {
+		return nil, &LintError{Err: fmt.Errorf("%w: data is encoded as %s; re-save it as UTF-8", ErrParse, encoding)}
+	}
+	return StripJSONC(bytes.TrimPrefix(data, utf8BOM)), nil
+}
+
+// wideEncoding returns the name of the encoding of data if it is UTF-16 or
+// UTF-32, detected by its byte order mark or, without one, by the zero
+// bytes around the first character, which is ASCII in JSON.
+func wideEncoding(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte{0x00, 0x00, 0xFE, 0xFF}):
+		return "UTF-32BE"
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE, 0x00, 0x00}):
+		return "UTF-32LE"
+	case bytes.HasPrefix(data, []byte{0xFE, 0xFF}):
+		return "UTF-16BE"
+	case bytes.HasPrefix(data, []byte{0xFF, 0xFE}):
+		return "UTF-16LE"
+	case len(data) >= 4 && data[0] == 0 && data[1] == 0 && data[2] == 0 && data[3] != 0:
+		return "UTF-32BE"
+	case len(data) >= 4 && data[0] != 0 && data[1] == 0 && data[2] == 0 && data[3] == 0:
+		return "UTF-32LE"
+	case len(data) >= 2 && data[0] == 0 && data[1] != 0:
+		return "UTF-16BE"
+	case len(data) >= 2 && data[0] != 0 && data[1] == 0:
+		return "UTF-16LE"
+	}
+	return ""
+}
+
+// StripJSONC returns data with the JSONC and JSON5 extensions that schema
+// authors commonly use, // and /* */ comments and trailing commas in objects
+// and arrays, replaced by spaces, so that it parses as JSON. Newlines inside
+// comments are kept, so every byte keeps its offset, line, and column, and
+// issue and parse error locations point into the original text. Data
+// without comments or trailing commas is returned unchanged; other JSON5
+// syntax, such as unquoted keys or single-quoted strings, is not supported.
+func StripJSONC(data []byte) []byte {
+	out, copied := data, false
+	blank := func(i int) {
+		if !copied {
+			out, copied = append([]byte(nil), data...), true
+		}
+		if out[i] != '\n' && out[i] != '\r' {
+			out[i] = ' '
+		}
+	}
+
+	inString := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == '/' && i+1 < len(data) && data[i+1] == '/':
+			for ; i < len(data) && data[i] != '\n'; i++ {
+				blank(i)
+			}
+		case c == '/' && i+1 < len(data) && data[i+1] == '*':
+			end := i + 2
+			for end+1 < len(data) && (data[end] != '*' || data[end+1] != '/') {
+				end++
+			}
+			if end+1 >= len(data) {
+				// Unterminated: leave it for the JSON parser to report
+				return out
+			}
+			for ; i <= end+1; i++ {
+				blank(i)
+			}
+			i--
+		}
+	}
+
+	// Trailing commas, now that comments are blank
+	inString = false
+	for i := 0; i < len(out); i++ {
+		c := out[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"':
+			inString = true
+		case c == ',':
+			next := i + 1
+			for next < len(out) && (out[next] == ' ' || out[next] == '\t' || out[next] == '\n' || out[next] == '\r') {
+				next++
+			}
+			if next < len(out) && (out[next] == '}' || out[next] == ']') {
+				blank(i)
+			}
+		}
+	}
+	return out
+}
@@ -0,0 +1,90 @@
+package linter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestStripJSONC(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"plain", `{"a": [1, 2]}`, `{"a": [1, 2]}`},
+		{"line comment", "{\"a\": 1 // note\n}", "{\"a\": 1        \n}"},
+		{"block comment", "{/* a\nb */\"a\": 1}", "{    \n    \"a\": 1}"},
+		{"trailing commas", "{\"a\": [1, 2,],\n}", "{\"a\": [1, 2 ] \n}"},
+		{"comma before comment", "[1, // last\n]", "[1         \n]"},
+		{"strings", `{"url": "http://x/*y*/", "s": "a,]", "q": "\"//"}`, `{"url": "http://x/*y*/", "s": "a,]", "q": "\"//"}`},
+		{"unterminated", `{"a": 1 /* open`, `{"a": 1 /* open`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := string(StripJSONC([]byte(tt.in))); got != tt.want {
+				t.Errorf("StripJSONC(%q) = %q, want %q", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLintJSONC(t *testing.T) {
+	schema := `{
+  // Order schema
+  "type": "object",
+  /* properties
+     of an order */
+  "properties": {
+    "id": {"type": "string",},
+    "id": {"type": "integer"},
+  },
+}`
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint JSONC: %v", err)
+	}
+	var found bool
+	for _, issue := range result.Issues {
+		if issue.Code == CodeDuplicateKey {
+			found = true
+			if issue.Line != 8 || issue.Column != 5 {
+				t.Errorf("Expected duplicate-key at 8:5 of the original text, got %d:%d", issue.Line, issue.Column)
+			}
+		}
+	}
+	if !found {
+		t.Error("Expected a duplicate-key issue")
+	}
+
+	_, err = NewWithDefaults().Lint([]byte("{\n  // comment\n  \"type\": object\n}"))
+	var le *LintError
+	if !errors.As(err, &le) || le.Line != 3 {
+		t.Errorf("Expected a parse error on line 3, got %v", err)
+	}
+}
+
+func TestNormalizeJSON(t *testing.T) {
+	bom := append([]byte{0xEF, 0xBB, 0xBF}, `{"type": "string"}`...)
+	if _, err := NewWithDefaults().Lint(bom); err != nil {
+		t.Errorf("Expected a schema with a UTF-8 BOM to lint, got %v", err)
+	}
+
+	tests := []struct {
+		name string
+		data []byte
+		want string
+	}{
+		{"UTF-16LE with BOM", []byte{0xFF, 0xFE, '{', 0, '}', 0}, "UTF-16LE"},
+		{"UTF-16BE without BOM", []byte{0, '{', 0, '}'}, "UTF-16BE"},
+		{"UTF-32LE with BOM", []byte{0xFF, 0xFE, 0, 0, '{', 0, 0, 0}, "UTF-32LE"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewWithDefaults().Lint(tt.data)
+			if !errors.Is(err, ErrParse) || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected a parse error naming %s, got %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,223 @@
+package linter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// keywordApplicability lists type-specific keywords and the types they apply to.
+// A keyword used on a schema whose declared type is not in its list has no effect.
+var keywordApplicability = []struct {
+	keyword string
+	types   []string
+	present func(s *Schema) bool
+}{
+	{"properties", []string{"object"}, func(s *Schema) bool { return len(s.Properties) > 0 }},
+	{"required", []string{"object"}, func(s *Schema) bool { return len(s.Required) > 0 }},
+	{"additionalProperties", []string{"object"}, func(s *Schema) bool { return s.AdditionalProperties != nil }},
+	{"minProperties", []string{"object"}, func(s *Schema) bool { return s.MinProperties != nil }},
+	{"maxProperties", []string{"object"}, func(s *Schema) bool { return s.MaxProperties != nil }},
+	{"items", []string{"array"}, func(s *Schema) bool { return s.Items != nil || s.legacyTuple }},
+	{"prefixItems", []string{"array"}, func(s *Schema) bool { return len(s.PrefixItems) > 0 && !s.legacyTuple }},
+	{"minItems", []string{"array"}, func(s *Schema) bool { return s.MinItems != nil }},
+	{"maxItems", []string{"array"}, func(s *Schema) bool { return s.MaxItems != nil }},
+	{"uniqueItems", []string{"array"}, func(s *Schema) bool { return s.UniqueItems != nil }},
+	{"contains", []string{"array"}, func(s *Schema) bool { return s.Contains != nil }},
+	{"minContains", []string{"array"}, func(s *Schema) bool { return s.MinContains != nil }},
+	{"maxContains", []string{"array"}, func(s *Schema) bool { return s.MaxContains != nil }},
+	{"minLength", []string{"string"}, func(s *Schema) bool { return s.MinLength != nil }},
+	{"maxLength", []string{"string"}, func(s *Schema) bool { return s.MaxLength != nil }},
+	{"pattern", []string{"string"}, func(s *Schema) bool { return s.Pattern != "" }},
+	{"minimum", []string{"number", "integer"}, func(s *Schema) bool { return s.Minimum != nil }},
+	{"maximum", []string{"number", "integer"}, func(s *Schema) bool { return s.Maximum != nil }},
+	{"exclusiveMinimum", []string{"number", "integer"}, func(s *Schema) bool { return s.ExclusiveMinimum != nil }},
+	{"exclusiveMaximum", []string{"number", "integer"}, func(s *Schema) bool { return s.ExclusiveMaximum != nil }},
+	{"multipleOf", []string{"number", "integer"}, func(s *Schema) bool { return s.MultipleOf != nil }},
+}
+
+// lintDeadKeywords flags type-specific keywords that have no effect given the
+// schema's declared type, such as minLength on an integer or items on an object.
+func (l *Linter) lintDeadKeywords(schema *Schema, path Path, result *Result) {
+	types := schema.TypeList
+	if len(types) == 0 && schema.Type != "" {
+		types = []string{schema.Type}
+	}
+	if len(types) == 0 {
+		return
+	}
+
+	for _, kw := range keywordApplicability {
+		if !kw.present(schema) || typesOverlap(types, kw.types) {
+			continue
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeDeadKeyword,
+			Severity:   SeverityWarning,
+			Path:       path.Child(kw.keyword),
+			Message:    fmt.Sprintf("Keyword '%s' has no effect on type %s", kw.keyword, formatTypes(types)),
+			Suggestion: fmt.Sprintf("Remove '%s' or change the type to %s", kw.keyword, formatTypes(kw.types)),
+		})
+	}
+}
+
+// lintContains notes that contains, minContains, and maxContains only
+// validate: a generated []T cannot require or locate the contained element.
+func (l *Linter) lintContains(schema *Schema, path Path, result *Result) {
+	keywords := []string{"'contains'"}
+	if schema.MinContains != nil {
+		keywords = append(keywords, "'minContains'")
+	}
+	if schema.MaxContains != nil {
+		keywords = append(keywords, "'maxContains'")
+	}
+	message := "Keyword 'contains' is a validation-only constraint that generated types cannot express"
+	if len(keywords) > 1 {
+		message = fmt.Sprintf("Keywords %s are validation-only constraints that generated types cannot express", strings.Join(keywords, ", "))
+	}
+	result.Issues = append(result.Issues, Issue{
+		Code:       CodeContains,
+		Severity:   SeverityInfo,
+		Path:       path.Child("contains"),
+		Message:    message,
+		Suggestion: "If the contained element is structurally important, model it as a dedicated typed property instead of an array element",
+	})
+}
+
+// typesOverlap returns true if any declared type matches an applicable type.
+func typesOverlap(declared, applicable []string) bool {
+	for _, d := range declared {
+		for _, a := range applicable {
+			if d == a {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// formatTypes formats a list of types for messages (e.g., 'number' or 'integer').
+func formatTypes(types []string) string {
+	quoted := make([]string, len(types))
+	for i, t := range types {
+		quoted[i] = "'" + t + "'"
+	}
+	return strings.Join(quoted, " or ")
+}
+
+// schemaKeywords are the keywords of all JSON Schema drafts and the OpenAPI
+// schema object. Other keys of a schema are ignored by validators.
+var schemaKeywords = []string{
+	"$schema", "$id", "id", "$ref", "$anchor", "$dynamicRef", "$dynamicAnchor",
+	"$recursiveRef", "$recursiveAnchor", "$vocabulary", "$comment", "$defs", "definitions",
+	"type", "enum", "const", "format",
+	"properties", "patternProperties", "additionalProperties", "propertyNames",
+	"unevaluatedProperties", "required", "dependentRequired", "dependentSchemas", "dependencies",
+	"minProperties", "maxProperties",
+	"items", "prefixItems", "additionalItems", "unevaluatedItems", "contains",
+	"minContains", "maxContains", "minItems", "maxItems", "uniqueItems",
+	"minLength", "maxLength", "pattern",
+	"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf",
+	"allOf", "anyOf", "oneOf", "not", "if", "then", "else",
+	"contentEncoding", "contentMediaType", "contentSchema",
+	"title", "description", "default", "examples", "deprecated", "readOnly", "writeOnly",
+	"nullable", "discriminator", "example", "externalDocs", "xml",
+}
+
+// linterExtensions are the x- extensions the linter reads.
+var linterExtensions = []string{"x-abstract-component", "x-stability", "x-owner", "x-schemalint"}
+
+// closestKeyword returns the keyword an unknown key is likely a misspelling
+// of: a keyword that differs only in case, or, for keywords of four or more
+// characters, one within an edit distance that grows with the key's length.
+func closestKeyword(key string) (string, bool) {
+	lower := strings.ToLower(key)
+	maxDistance := 1
+	switch {
+	case len(key) >= 16:
+		maxDistance = 3
+	case len(key) >= 8:
+		maxDistance = 2
+	}
+	best, bestDistance := "", maxDistance+1
+	for _, kw := range schemaKeywords {
+		if strings.ToLower(kw) == lower {
+			return kw, true
+		}
+		if len(kw) < 4 || len(key) < 3 {
+			continue
+		}
+		if d := editDistance(lower, strings.ToLower(kw)); d < bestDistance {
+			best, bestDistance = kw, d
+		}
+	}
+	return best, best != ""
+}
+
+// editDistance returns the optimal string alignment distance between a and
+// b: the number of insertions, deletions, substitutions, and transpositions
+// of adjacent characters that turn one into the other.
+func editDistance(a, b string) int {
+	s, t := []rune(a), []rune(b)
+	d := make([][]int, len(s)+1)
+	for i := range d {
+		d[i] = make([]int, len(t)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(s); i++ {
+		for j := 1; j <= len(t); j++ {
+			cost := 1
+			if s[i-1] == t[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && s[i-1] == t[j-2] && s[i-2] == t[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(s)][len(t)]
+}
+
+// lintKeywordTypos flags unknown keys that are likely misspelled keywords,
+// such as "requried" or "oneof": validators ignore them, so the constraint
+// the author meant is silently not enforced.
+func (l *Linter) lintKeywordTypos(schema *Schema, path Path, result *Result) {
+	for _, key := range schema.UnknownKeywords {
+		kw, ok := closestKeyword(key)
+		if !ok || strings.HasPrefix(key, "x-") {
+			continue
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeKeywordTypo,
+			Severity:   SeverityWarning,
+			Path:       path.Child(key),
+			Message:    fmt.Sprintf("Unknown keyword '%s' is ignored by validators; did you mean '%s'?", key, kw),
+			Suggestion: fmt.Sprintf("Rename '%s' to '%s'", key, kw),
+		})
+	}
+}
+
+// lintUnknownKeywords notes the unknown keys of a schema that are not
+// allowed by AllowedKeywords, such as vendor extensions without an x-
+// prefix: validators and generators ignore them. Likely typos are reported
+// as keyword-typo instead.
+func (l *Linter) lintUnknownKeywords(schema *Schema, path Path, result *Result) {
+	for _, key := range schema.UnknownKeywords {
+		if matchesAny(l.config.AllowedKeywords, key) {
+			continue
+		}
+		if _, typo := closestKeyword(key); typo && !strings.HasPrefix(key, "x-") {
+			continue
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeUnknownKeyword,
+			Severity:   SeverityInfo,
+			Path:       path.Child(key),
+			Message:    fmt.Sprintf("Keyword '%s' is not a JSON Schema keyword, so validators and generators ignore it", key),
+			Suggestion: fmt.Sprintf("Remove '%s', prefix it with x- as a vendor extension, or add it to allowed_keywords", key),
+		})
+	}
+}
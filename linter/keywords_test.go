@@ -0,0 +1,218 @@
+package linter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLintDeadKeywords(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Count": {"type": "integer", "minLength": 1},
+			"Tags": {"type": "array", "properties": {"name": {"type": "string"}}},
+			"Person": {"type": "object", "items": {"type": "string"}}
+		}
+	}`
+
+	l := NewWithDefaults()
+	result, err := l.Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	expected := map[string]bool{
+		"$/$defs/Count/minLength": false,
+		"$/$defs/Tags/properties": false,
+		"$/$defs/Person/items":    false,
+	}
+	for _, issue := range result.Issues {
+		if issue.Code != CodeDeadKeyword {
+			continue
+		}
+		if _, ok := expected[issue.Path.String()]; !ok {
+			t.Errorf("Unexpected dead-keyword issue at %s", issue.Path)
+		}
+		expected[issue.Path.String()] = true
+	}
+	for path, found := range expected {
+		if !found {
+			t.Errorf("Expected dead-keyword warning at %s", path)
+		}
+	}
+}
+
+func TestLintDeadKeywordsApplicable(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Name": {"type": "string", "minLength": 1, "pattern": "^[a-z]+$"},
+			"Age": {"type": "integer", "minimum": 0, "exclusiveMaximum": 150},
+			"Nullable": {"type": ["string", "null"], "maxLength": 10},
+			"Untyped": {"minLength": 1},
+			"Legacy": {"type": "number", "minimum": 0, "exclusiveMinimum": true}
+		}
+	}`
+
+	l := NewWithDefaults()
+	result, err := l.Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	for _, issue := range result.Issues {
+		if issue.Code == CodeDeadKeyword {
+			t.Errorf("Did not expect dead-keyword issue: %v", issue)
+		}
+	}
+}
+
+func TestLintContains(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Roles": {"type": "array", "items": {"type": "string"}, "contains": {"const": "owner"}},
+			"Scores": {"type": "array", "items": {"type": "integer"}, "contains": {"minimum": 90}, "minContains": 2},
+			"Count": {"type": "integer", "contains": {"const": 1}},
+			"Tags": {"type": "array", "items": {"type": "string"}}
+		}
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	want := map[string]string{
+		"$/$defs/Roles/contains":  "Keyword 'contains' is a validation-only constraint that generated types cannot express",
+		"$/$defs/Scores/contains": "Keywords 'contains', 'minContains' are validation-only constraints that generated types cannot express",
+		"$/$defs/Count/contains":  "Keyword 'contains' is a validation-only constraint that generated types cannot express",
+	}
+	var dead bool
+	for _, issue := range result.Issues {
+		switch issue.Code {
+		case CodeContains:
+			if message, ok := want[issue.Path.String()]; !ok || issue.Message != message {
+				t.Errorf("Unexpected contains-constraint at %s: %s", issue.Path, issue.Message)
+			}
+			delete(want, issue.Path.String())
+		case CodeDeadKeyword:
+			dead = dead || issue.Path.String() == "$/$defs/Count/contains"
+		}
+	}
+	for path := range want {
+		t.Errorf("Expected contains-constraint at %s", path)
+	}
+	if !dead {
+		t.Error("Expected dead-keyword for contains on an integer")
+	}
+}
+
+func TestLintKeywordTypos(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"requried": ["name"],
+		"additionalproperties": false,
+		"x-requried": true,
+		"properties": {
+			"name": {"type": "string", "minLenght": 1, "note": "display name"},
+			"kind": {"oneof": [{"const": "a"}, {"const": "b"}]}
+		}
+	}`
+
+	l := NewWithDefaults()
+	result, err := l.Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	expected := map[string]string{
+		"$/requried":                  "required",
+		"$/additionalproperties":      "additionalProperties",
+		"$/properties/name/minLenght": "minLength",
+		"$/properties/kind/oneof":     "oneOf",
+	}
+	for _, issue := range result.Issues {
+		if issue.Code != CodeKeywordTypo {
+			continue
+		}
+		kw, ok := expected[issue.Path.String()]
+		if !ok {
+			t.Errorf("Unexpected keyword-typo issue at %s", issue.Path)
+			continue
+		}
+		if !strings.Contains(issue.Message, "'"+kw+"'") {
+			t.Errorf("Expected %s to suggest %s, got %q", issue.Path, kw, issue.Message)
+		}
+		delete(expected, issue.Path.String())
+	}
+	for path := range expected {
+		t.Errorf("Expected keyword-typo warning at %s", path)
+	}
+
+	fixed, issues, err := l.Fix([]byte(schema), CodeKeywordTypo)
+	if err != nil || len(issues) != 4 {
+		t.Fatalf("Expected 4 fixes, got %v, %v", issues, err)
+	}
+	if !strings.Contains(string(fixed), `"required": [`) || !strings.Contains(string(fixed), `"note"`) {
+		t.Errorf("Expected misspelled keywords to be renamed, got:\n%s", fixed)
+	}
+}
+
+func TestEditDistance(t *testing.T) {
+	tests := []struct {
+		a, b string
+		want int
+	}{
+		{"required", "required", 0},
+		{"requried", "required", 1},
+		{"minimun", "minimum", 1},
+		{"additionalproperty", "additionalproperties", 3},
+		{"", "type", 4},
+	}
+	for _, tt := range tests {
+		if got := editDistance(tt.a, tt.b); got != tt.want {
+			t.Errorf("editDistance(%q, %q) = %d, want %d", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestLintUnknownKeywords(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"requried": ["name"],
+		"x-internal": true,
+		"x-stability": "stable",
+		"properties": {
+			"name": {"type": "string", "sensitive": true, "x-go-name": "Name"}
+		}
+	}`
+
+	unknown := func(config Config) []string {
+		result, err := New(config).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		var paths []string
+		for _, issue := range result.Issues {
+			if issue.Code == CodeUnknownKeyword {
+				paths = append(paths, issue.Path.String())
+			}
+		}
+		return paths
+	}
+
+	if got := unknown(DefaultConfig()); !slices.Equal(got, []string{"$/properties/name/sensitive"}) {
+		t.Errorf("Expected only the unprefixed extension to be reported, got %v", got)
+	}
+
+	config := DefaultConfig()
+	config.AllowedKeywords = []string{"sensitive"}
+	want := []string{"$/x-internal", "$/properties/name/x-go-name"}
+	if got := unknown(config); !slices.Equal(got, want) {
+		t.Errorf("Expected x- extensions to be reported without the default allowlist, got %v, want %v", got, want)
+	}
+
+	config.AllowedKeywords = []string{"["}
+	if err := config.Validate(); err == nil {
+		t.Error("Expected an error for a malformed allowed keyword pattern")
+	}
+}
@@ -1,10 +1,18 @@
 package linter
 
 import (
-	"encoding/json"
+	"context"
 	"fmt"
-	"os"
+	"io/fs"
+	"regexp"
+	"slices"
 	"strings"
+	"time"
+	"unicode"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Profile represents a linting profile with predefined rules.
@@ -20,7 +28,8 @@ const (
 	ProfileNavigable Profile = "navigable"
 )
 
-// PropertyCase defines the casing convention for object properties.
+// PropertyCase defines the casing convention for object properties, or
+// for enum members (see Config.EnumCase).
 type PropertyCase string
 
 const (
@@ -34,39 +43,334 @@ const (
 	CaseKebab PropertyCase = "kebab-case"
 	// CasePascal is for PascalCase.
 	CasePascal PropertyCase = "PascalCase"
+	// CaseScreamingSnake is for SCREAMING_SNAKE_CASE, used for enum members.
+	CaseScreamingSnake PropertyCase = "SCREAMING_SNAKE_CASE"
+	// CaseAuto enforces the convention most property names of each
+	// document follow, reporting only the outliers.
+	CaseAuto PropertyCase = "auto"
 )
 
 // Config holds linter configuration options.
 type Config struct {
 	// Profile is the linting profile to use.
-	Profile Profile
+	Profile Profile `json:"profile,omitempty"`
 	// PropertyCase is the casing convention to enforce for property names.
-	PropertyCase PropertyCase
+	PropertyCase PropertyCase `json:"property_case,omitempty"`
+	// EnumCase is the casing convention to enforce for string enum members
+	// (e.g., SCREAMING_SNAKE_CASE); empty or none disables the check
+	EnumCase PropertyCase `json:"enum_case,omitempty"`
+	// DefinitionCase is the casing convention to enforce for $defs and
+	// definitions keys (typically PascalCase); empty or none disables the
+	// check
+	DefinitionCase PropertyCase `json:"definition_case,omitempty"`
+	// ConsistentEnumCase requires the string members of each enum to follow
+	// one convention, that of most of them, when EnumCase is not set
+	ConsistentEnumCase bool `json:"consistent_enum_case,omitempty"`
+	// UnicodeNames accepts property and definition names with non-ASCII
+	// letters and digits (e.g., "prénom"), checking their case like ASCII
+	// names; otherwise they are reported as non-ascii-name
+	UnicodeNames bool `json:"unicode_names,omitempty"`
+	// Acronyms are words written in capitals in camelCase and PascalCase
+	// property names (e.g., "ID" and "URL" for "userID" and "htmlURL"),
+	// and lowercase as the first word of a camelCase name
+	Acronyms []string `json:"acronyms,omitempty"`
+	// Strictness is the preset the thresholds and opt-in rules start from
+	// (see SetStrictness); settings given alongside it in a config file
+	// take precedence
+	Strictness Strictness `json:"strictness,omitempty"`
 	// MaxUnionVariants is the threshold for large union warnings (default: 10)
-	MaxUnionVariants int
+	MaxUnionVariants int `json:"max_union_variants,omitempty"`
 	// MaxUnionNestingDepth is the threshold for nested union warnings (default: 2)
-	MaxUnionNestingDepth int
+	MaxUnionNestingDepth int `json:"max_union_nesting_depth,omitempty"`
 	// DiscriminatorFields are the field names to look for as discriminators
-	DiscriminatorFields []string
+	DiscriminatorFields []string `json:"discriminator_fields,omitempty"`
 	// MaxObjectNestingDepth is the threshold for object nesting (navigable profile, default: 2)
-	MaxObjectNestingDepth int
+	MaxObjectNestingDepth int `json:"max_object_nesting_depth,omitempty"`
 	// MaxArrayNestingDepth is the threshold for array nesting (navigable profile, default: 1)
-	MaxArrayNestingDepth int
+	MaxArrayNestingDepth int `json:"max_array_nesting_depth,omitempty"`
+	// MaxEnumValues is the threshold for large enum warnings (default: 100)
+	MaxEnumValues int `json:"max_enum_values,omitempty"`
+	// DetectProseEnums reports values listed in descriptions without enum/const (opt-in)
+	DetectProseEnums bool `json:"detect_prose_enums,omitempty"`
+	// DetectNullableOptional reports optional properties that are also
+	// nullable (opt-in)
+	DetectNullableOptional bool `json:"detect_nullable_optional,omitempty"`
+	// DetectUnitSuffixes reports numeric duration and size properties
+	// without an allowed unit suffix (opt-in)
+	DetectUnitSuffixes bool `json:"detect_unit_suffixes,omitempty"`
+	// DetectVersionNaming reports definitions without consistent version
+	// markers in their names and $ids (opt-in)
+	DetectVersionNaming bool `json:"detect_version_naming,omitempty"`
+	// StrictUnresolved reports unions whose analysis was skipped due to unresolved
+	// $refs as errors instead of info
+	StrictUnresolved bool `json:"strict_unresolved,omitempty"`
+	// Rules are additional custom rules evaluated at each schema node
+	Rules []Rule `json:"-"`
+	// Assertions are config-defined CEL rules evaluated at each schema node
+	Assertions []Assertion `json:"assertions,omitempty"`
+	// TimestampNamePatterns are glob patterns (e.g., "*_at") for property
+	// names expected to hold timestamps (default: *_at, *Date, *_time)
+	TimestampNamePatterns []string `json:"timestamp_name_patterns,omitempty"`
+	// IDNamePatterns are glob patterns for property names expected to hold
+	// UUIDs (default: *_id, uuid)
+	IDNamePatterns []string `json:"id_name_patterns,omitempty"`
+	// MoneyNamePatterns are glob patterns for property names expected to
+	// hold money amounts (default: *amount, *price, *balance, and their
+	// capitalized forms)
+	MoneyNamePatterns []string `json:"money_name_patterns,omitempty"`
+	// MoneyPolicy is the representation required for money amounts
+	// (default: any, which only rejects floating-point numbers)
+	MoneyPolicy MoneyPolicy `json:"money_policy,omitempty"`
+	// UnitSuffixes maps the bare words of duration and size property names
+	// (e.g., "timeout") to their allowed unit suffixes (e.g., "ms"); an
+	// empty list turns a word off (default: DefaultUnitSuffixes)
+	UnitSuffixes map[string][]string `json:"unit_suffixes,omitempty"`
+	// UnanchoredPatternSeverity is the severity of unanchored-pattern
+	// findings: error, warning, or info (default: warning)
+	UnanchoredPatternSeverity Severity `json:"unanchored_pattern_severity,omitempty"`
+	// ErrorSchema is a JSON pointer to the canonical error definition
+	// (e.g., "#/$defs/Error") that other error schemas must match; in
+	// documents where it does not resolve, the structure used by most error
+	// schemas is the convention
+	ErrorSchema string `json:"error_schema,omitempty"`
+	// VersionNamePattern is the regular expression that finds the version
+	// in a definition name, in its first capture group (default:
+	// DefaultVersionNamePattern, e.g., EventV2)
+	VersionNamePattern string `json:"version_name_pattern,omitempty"`
+	// VersionIDPattern is the regular expression that finds the version in
+	// an $id (default: DefaultVersionIDPattern, e.g., .../event/v2)
+	VersionIDPattern string `json:"version_id_pattern,omitempty"`
+	// VersionExemptions are glob patterns for definition names that need
+	// no version marker (e.g., shared types like "Address")
+	VersionExemptions []string `json:"version_exemptions,omitempty"`
+	// IDTemplate is the root $id convention for schema files and registry
+	// subjects, with {dir}, {name}, and {subject} placeholders (e.g.,
+	// "https://schemas.example.com/{dir}/{name}.json"); see CheckIDLocation
+	IDTemplate string `json:"id_template,omitempty"`
+	// IgnoreIDPrefixes skips linting the document or definitions whose $id
+	// starts with one of these URL prefixes (e.g., bundled third-party
+	// schemas); $refs into them are still resolved
+	IgnoreIDPrefixes []string `json:"ignore_id_prefixes,omitempty"`
+	// StabilityPolicy overrides the severity of all findings on schemas
+	// annotated with x-stability, by level (default: stable findings are
+	// errors, experimental findings are info)
+	StabilityPolicy map[string]Severity `json:"stability_policy,omitempty"`
+	// PathClasses classify schema files by path (e.g., production, test,
+	// and example schemas), adjusting the severity of the findings in each
+	// class; the first class with a matching pattern applies (default:
+	// none)
+	PathClasses []PathClass `json:"path_classes,omitempty"`
+	// Roots are JSON pointers to entry schemas (e.g., "#/$defs/PublicAPI");
+	// when set, only the definitions reachable from them through $refs are
+	// linted and the others are reported as unreachable-from-roots info
+	Roots []string `json:"roots,omitempty"`
+	// MaxDefinitions is the budget for $defs and definitions per document
+	// (0: no limit)
+	MaxDefinitions int `json:"max_definitions,omitempty"`
+	// MaxObjectProperties is the budget for properties per object (0: no limit)
+	MaxObjectProperties int `json:"max_object_properties,omitempty"`
+	// MaxSchemaBytes is the budget for the size of the linted data,
+	// including bundled definitions (0: no limit)
+	MaxSchemaBytes int `json:"max_schema_bytes,omitempty"`
+	// MinSharedProperties is the number of properties a cluster repeated
+	// verbatim across definitions needs to be reported (default: 3)
+	MinSharedProperties int `json:"min_shared_properties,omitempty"`
+	// MinSharingDefinitions is the number of definitions that must repeat a
+	// property cluster for it to be reported (default: 3)
+	MinSharingDefinitions int `json:"min_sharing_definitions,omitempty"`
+	// RequireObjectRoots requires the root schema, the definitions, or all
+	// of them to be type: object or a $ref (default: none)
+	RequireObjectRoots ObjectRootPolicy `json:"require_object_roots,omitempty"`
+	// RequireSchemaDeclaration reports root schemas without $schema
+	RequireSchemaDeclaration bool `json:"require_schema_declaration,omitempty"`
+	// AllowedDrafts are the $schema URIs root schemas may declare (empty:
+	// any)
+	AllowedDrafts []string `json:"allowed_drafts,omitempty"`
+	// DefaultDraft is the $schema URI that fix inserts in root schemas
+	// without one (default: draft 2020-12)
+	DefaultDraft string `json:"default_draft,omitempty"`
+	// RequireAdditionalProperties reports object schemas that omit
+	// additionalProperties
+	RequireAdditionalProperties bool `json:"require_additional_properties,omitempty"`
+	// DefaultAdditionalProperties is the additionalProperties value that
+	// fix inserts in object schemas without one (default: none, not fixed)
+	DefaultAdditionalProperties *bool `json:"default_additional_properties,omitempty"`
+	// AllowedKeywords are glob patterns for unknown keys that are not
+	// reported as unknown-keyword, such as accepted vendor extensions
+	// (default: x-*)
+	AllowedKeywords []string `json:"allowed_keywords,omitempty"`
+	// ProfileRules records the execution time of each rule in
+	// Result.Timing
+	ProfileRules bool `json:"profile_rules,omitempty"`
+	// TracerProvider records OpenTelemetry spans for lint runs and their
+	// parse, resolve, and rule phases (default: none)
+	TracerProvider trace.TracerProvider `json:"-"`
+	// Resolver resolves $refs out of the linted document for the rules
+	// that follow $refs (default: none; such $refs stay unresolved)
+	Resolver Resolver `json:"-"`
+	// Concurrency is the number of goroutines linting the definitions of a
+	// document (0: GOMAXPROCS; 1: one at a time)
+	Concurrency int `json:"concurrency,omitempty"`
+	// Categories limits the findings to rules in these categories (e.g.,
+	// "unions", "typing"); custom rules without a category always run
+	Categories []Category `json:"categories,omitempty"`
+	// OnlyRules runs only the rules reporting these codes, built-in or
+	// custom, and keeps only their findings, such as to test one rule;
+	// the union and allOf rules still run to lint nested schemas, but
+	// their findings are dropped (default: all rules)
+	OnlyRules []IssueCode `json:"only_rules,omitempty"`
 }
 
 // DefaultConfig returns the default linter configuration.
 func DefaultConfig() Config {
 	return Config{
-		Profile:               ProfileDefault,
-		PropertyCase:          CaseCamel,
-		MaxUnionVariants:      10,
-		MaxUnionNestingDepth:  2,
-		DiscriminatorFields:   []string{"component_type", "type", "kind"},
-		MaxObjectNestingDepth: 2,
-		MaxArrayNestingDepth:  1,
+		Profile:                   ProfileDefault,
+		PropertyCase:              CaseCamel,
+		MaxUnionVariants:          10,
+		MaxUnionNestingDepth:      2,
+		DiscriminatorFields:       []string{"component_type", "type", "kind"},
+		MaxObjectNestingDepth:     2,
+		MaxArrayNestingDepth:      1,
+		MaxEnumValues:             100,
+		StabilityPolicy:           DefaultStabilityPolicy(),
+		TimestampNamePatterns:     []string{"*_at", "*Date", "*_time"},
+		IDNamePatterns:            []string{"*_id", "uuid"},
+		MoneyNamePatterns:         []string{"*amount", "*Amount", "*price", "*Price", "*balance", "*Balance"},
+		MoneyPolicy:               MoneyAny,
+		UnitSuffixes:              DefaultUnitSuffixes(),
+		UnanchoredPatternSeverity: SeverityWarning,
+		VersionNamePattern:        DefaultVersionNamePattern,
+		VersionIDPattern:          DefaultVersionIDPattern,
+		MinSharedProperties:       3,
+		MinSharingDefinitions:     3,
+		AllowedKeywords:           []string{"x-*"},
+		DefaultDraft:              "https://json-schema.org/draft/2020-12/schema",
 	}
 }
 
+// Validate returns an error if the profile, property case, strictness, a
+// rule category, or a selected rule is unknown, or if an assertion, name pattern, or path
+// class is invalid.
+func (c Config) Validate() error {
+	switch c.Profile {
+	case ProfileDefault, ProfileScale, ProfileNavigable:
+	default:
+		return fmt.Errorf("unknown profile: %s", c.Profile)
+	}
+	switch c.PropertyCase {
+	case CaseNone, CaseCamel, CaseSnake, CaseKebab, CasePascal, CaseAuto:
+	default:
+		return fmt.Errorf("unknown property case: %s", c.PropertyCase)
+	}
+	switch c.DefinitionCase {
+	case "", CaseNone, CaseCamel, CaseSnake, CaseKebab, CasePascal:
+	default:
+		return fmt.Errorf("unknown definition case: %s", c.DefinitionCase)
+	}
+	switch c.EnumCase {
+	case "", CaseNone, CaseCamel, CaseSnake, CaseKebab, CasePascal, CaseScreamingSnake:
+	default:
+		return fmt.Errorf("unknown enum case: %s", c.EnumCase)
+	}
+	for _, a := range c.Assertions {
+		if _, err := a.Rule(); err != nil {
+			return err
+		}
+	}
+	if err := validateNamePatterns(c.TimestampNamePatterns); err != nil {
+		return err
+	}
+	if err := validateNamePatterns(c.IDNamePatterns); err != nil {
+		return err
+	}
+	if err := validateNamePatterns(c.MoneyNamePatterns); err != nil {
+		return err
+	}
+	switch c.MoneyPolicy {
+	case "", MoneyAny, MoneyDecimalString, MoneyMinorUnits:
+	default:
+		return fmt.Errorf("unknown money policy: %s", c.MoneyPolicy)
+	}
+	switch c.RequireObjectRoots {
+	case "", ObjectRootsNone, ObjectRootsDocument, ObjectRootsDefinitions, ObjectRootsAll:
+	default:
+		return fmt.Errorf("unknown object root policy: %s", c.RequireObjectRoots)
+	}
+	if err := validateUnitSuffixes(c.UnitSuffixes); err != nil {
+		return err
+	}
+	for _, pattern := range []string{c.VersionNamePattern, c.VersionIDPattern} {
+		if _, err := compileVersionPattern(pattern); err != nil {
+			return err
+		}
+	}
+	if err := validateNamePatterns(c.VersionExemptions); err != nil {
+		return err
+	}
+	if err := validateNamePatterns(c.AllowedKeywords); err != nil {
+		return err
+	}
+	if c.DefaultDraft != "" && len(c.AllowedDrafts) > 0 && !draftAllowed(c.DefaultDraft, c.AllowedDrafts) {
+		return fmt.Errorf("default draft %s is not among the allowed drafts", c.DefaultDraft)
+	}
+	if c.Concurrency < 0 {
+		return fmt.Errorf("concurrency must not be negative: %d", c.Concurrency)
+	}
+	for _, root := range c.Roots {
+		if !strings.HasPrefix(root, "#") {
+			return fmt.Errorf("root %q is not a local JSON pointer (e.g., #/$defs/Name)", root)
+		}
+	}
+	switch c.UnanchoredPatternSeverity {
+	case "", SeverityError, SeverityWarning, SeverityInfo:
+	default:
+		return fmt.Errorf("unknown unanchored pattern severity: %s", c.UnanchoredPatternSeverity)
+	}
+	if c.ErrorSchema != "" && !strings.HasPrefix(c.ErrorSchema, "#") {
+		return fmt.Errorf("error schema %q is not a local JSON pointer (e.g., #/$defs/Error)", c.ErrorSchema)
+	}
+	if _, ok := strictnessPresets[c.Strictness]; c.Strictness != "" && !ok {
+		return fmt.Errorf("unknown strictness: %s", c.Strictness)
+	}
+	for _, category := range c.Categories {
+		if !slices.Contains(Categories(), category) {
+			return fmt.Errorf("unknown rule category: %s", category)
+		}
+	}
+	for _, code := range c.OnlyRules {
+		if !c.hasRule(code) {
+			return fmt.Errorf("unknown rule: %s", code)
+		}
+	}
+	for level, severity := range c.StabilityPolicy {
+		switch severity {
+		case "", SeverityError, SeverityWarning, SeverityInfo:
+		default:
+			return fmt.Errorf("unknown severity %q for stability %q", severity, level)
+		}
+	}
+	return validatePathClasses(c.PathClasses)
+}
+
+// hasRule reports whether code is reported by a built-in rule, a custom
+// rule, or an assertion.
+func (c Config) hasRule(code IssueCode) bool {
+	if _, ok := LookupRule(code); ok {
+		return true
+	}
+	for _, rule := range c.Rules {
+		if rule.Info().Code == code {
+			return true
+		}
+	}
+	for _, a := range c.Assertions {
+		if a.Code == code {
+			return true
+		}
+	}
+	return false
+}
+
 // IsScaleProfile returns true if the scale profile is active.
 func (c Config) IsScaleProfile() bool {
 	return c.Profile == ProfileScale
@@ -78,13 +382,86 @@ func (c Config) IsNavigableProfile() bool {
 }
 
 // Linter checks JSON Schemas for Go compatibility issues.
+//
+// A Linter is immutable after construction and is safe for concurrent use by
+// multiple goroutines; all per-run state lives in the returned Result.
 type Linter struct {
-	config Config
+	config      Config
+	rules       []Rule
+	versionName *regexp.Regexp
+	versionID   *regexp.Regexp
+	tracer      trace.Tracer
+	// assertions are the compiled Config.Assertions, and invalidAssertions
+	// the errors of those that failed to compile, reported at each
+	// document root
+	assertions        []*celAssertion
+	invalidAssertions []error
+	// fsys is the file system LintFile reads from, or nil for the
+	// operating system's
+	fsys fs.FS
+	// inferredCase is set when config.PropertyCase was inferred from the
+	// document for CaseAuto
+	inferredCase bool
+	// configHash identifies the configuration in Result.Metadata
+	configHash string
 }
 
-// New creates a new Linter with the given configuration.
+// New creates a new Linter with the given configuration; see NewLinter for
+// functional options.
+// Assertions that fail to compile are reported as errors at the root of
+// each linted document, and version patterns that fail to compile are
+// skipped; use Config.Validate, or NewLinter, to reject them up front.
 func New(config Config) *Linter {
-	return &Linter{config: config}
+	// Copy slices so later changes by the caller cannot race with linting.
+	config.DiscriminatorFields = append([]string{}, config.DiscriminatorFields...)
+	config.Assertions = append([]Assertion{}, config.Assertions...)
+	config.Rules = append([]Rule{}, config.Rules...)
+	config.TimestampNamePatterns = append([]string{}, config.TimestampNamePatterns...)
+	config.IDNamePatterns = append([]string{}, config.IDNamePatterns...)
+	config.MoneyNamePatterns = append([]string{}, config.MoneyNamePatterns...)
+	config.VersionExemptions = append([]string{}, config.VersionExemptions...)
+	config.IgnoreIDPrefixes = append([]string{}, config.IgnoreIDPrefixes...)
+	config.Roots = append([]string{}, config.Roots...)
+	config.Categories = append([]Category{}, config.Categories...)
+	config.OnlyRules = append([]IssueCode{}, config.OnlyRules...)
+	config.AllowedKeywords = append([]string{}, config.AllowedKeywords...)
+	config.AllowedDrafts = append([]string{}, config.AllowedDrafts...)
+	config.Acronyms = append([]string{}, config.Acronyms...)
+	policy := make(map[string]Severity, len(config.StabilityPolicy))
+	for level, severity := range config.StabilityPolicy {
+		policy[level] = severity
+	}
+	config.StabilityPolicy = policy
+	classes := make([]PathClass, len(config.PathClasses))
+	for i, class := range config.PathClasses {
+		classes[i] = PathClass{Name: class.Name, Patterns: append([]string{}, class.Patterns...)}
+		if class.Severities != nil {
+			classes[i].Severities = make(map[Severity]Severity, len(class.Severities))
+			for from, to := range class.Severities {
+				classes[i].Severities[from] = to
+			}
+		}
+	}
+	config.PathClasses = classes
+	suffixes := make(map[string][]string, len(config.UnitSuffixes))
+	for word, units := range config.UnitSuffixes {
+		suffixes[word] = append([]string{}, units...)
+	}
+	config.UnitSuffixes = suffixes
+
+	var assertions []*celAssertion
+	var invalid []error
+	for _, a := range config.Assertions {
+		compiled, err := a.compile()
+		if err != nil {
+			invalid = append(invalid, err)
+			continue
+		}
+		assertions = append(assertions, compiled)
+	}
+	versionName, _ := compileVersionPattern(config.VersionNamePattern)
+	versionID, _ := compileVersionPattern(config.VersionIDPattern)
+	return &Linter{config: config, rules: config.Rules, assertions: assertions, invalidAssertions: invalid, versionName: versionName, versionID: versionID, tracer: newTracer(config.TracerProvider), configHash: configHash(config)}
 }
 
 // NewWithDefaults creates a new Linter with default configuration.
@@ -92,132 +469,445 @@ func NewWithDefaults() *Linter {
 	return New(DefaultConfig())
 }
 
-// LintFile lints a JSON Schema file.
-func (l *Linter) LintFile(path string) (*Result, error) {
-	data, err := os.ReadFile(path)
+// Check lints JSON Schema data with the given configuration and returns the
+// issues found. It is a stateless alternative to Linter.Lint for callers that
+// aggregate results themselves, and is safe for concurrent use, including
+// with the same Config.
+func Check(data []byte, config Config) ([]Issue, error) {
+	result, err := New(config).Lint(data)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read file: %w", err)
+		return nil, err
 	}
+	return result.Issues, nil
+}
 
-	result, err := l.Lint(data)
+// Lint lints JSON Schema data. The data may be a single schema document, a
+// JSON array of schema documents, or newline-delimited JSON schemas; composite
+// documents are linted independently with paths prefixed by their index (e.g., "[3]").
+// A byte order mark, comments, and trailing commas are accepted; see NormalizeJSON.
+// Errors for schemas that cannot be linted are *LintError values wrapping
+// ErrParse, ErrUnresolvedRef, or ErrUnsupportedDraft.
+func (l *Linter) Lint(data []byte) (*Result, error) {
+	return l.LintContext(context.Background(), data)
+}
+
+// LintContext lints data like Lint. With a Config.TracerProvider, the run
+// is recorded as a "schemakit.lint" span, a child of the span in ctx, with
+// a child span for each rule and phase.
+func (l *Linter) LintContext(ctx context.Context, data []byte) (*Result, error) {
+	start := time.Now()
+	ctx, span := l.tracer.Start(ctx, "schemakit.lint", trace.WithAttributes(attribute.Int("schemakit.bytes", len(data))))
+	defer span.End()
+	result, err := l.lint(data)
 	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
 		return nil, err
 	}
-	result.SchemaPath = path
+	span.SetAttributes(attribute.Int("schemakit.issues", len(result.Issues)))
+	l.traceRules(ctx, start, result.Timing)
+	if !l.config.ProfileRules {
+		result.Timing = nil
+	}
 	return result, nil
 }
 
-// Lint lints JSON Schema data.
-func (l *Linter) Lint(data []byte) (*Result, error) {
-	var schema Schema
-	if err := json.Unmarshal(data, &schema); err != nil {
-		return nil, fmt.Errorf("failed to parse JSON Schema: %w", err)
+// lint lints data, with the timing of its rules if they are profiled or
+// traced.
+func (l *Linter) lint(data []byte) (*Result, error) {
+	p := l.newProfiler()
+	start := time.Now()
+	metadata := l.metadata(start)
+	data, err := NormalizeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	schemas, composite, err := ParseDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+	p.add("parse", time.Since(start))
+
+	start = time.Now()
+	duplicates, err := duplicateKeys(data, composite)
+	if err != nil {
+		return nil, err
 	}
+	p.add("duplicate-key", time.Since(start))
 
 	result := &Result{
-		Issues: []Issue{},
+		Issues:   []Issue{},
+		profiler: p,
 	}
 
-	// Lint the root schema
-	l.lintSchema(&schema, "$", result, 0)
+	l.lintSize(len(data), result)
 
-	// Lint definitions ($defs)
-	for name, def := range schema.Defs {
-		path := fmt.Sprintf("$/$defs/%s", name)
-		l.lintSchema(def, path, result, 0)
+	roots := make([]Path, len(schemas))
+	for i := range schemas {
+		roots[i] = documentPath(i, composite)
+	}
+	var pagination, errorShapes map[string][]Issue
+	if l.runs("inconsistent-pagination") {
+		p.run("inconsistent-pagination", func() { pagination = l.lintPagination(schemas, roots) })
+	}
+	if l.runs("inconsistent-error-shape") {
+		p.run("inconsistent-error-shape", func() { errorShapes = l.lintErrorShapes(schemas, roots) })
 	}
 
-	// Lint legacy definitions (definitions)
-	for name, def := range schema.Definitions {
-		path := fmt.Sprintf("$/definitions/%s", name)
-		l.lintSchema(def, path, result, 0)
+	for i, schema := range schemas {
+		root := roots[i]
+		if err := checkDraft(schema); err != nil {
+			return nil, err
+		}
+		parsed := slices.Concat(duplicates[root.Root], pagination[root.Root], errorShapes[root.Root])
+		if err := l.lintDocument(schema, root, result, parsed); err != nil {
+			return nil, err
+		}
 	}
 
+	result.Timing, result.profiler = p.timing(), nil
+	result.Metadata = metadata
 	return result, nil
 }
 
-func (l *Linter) lintSchema(schema *Schema, path string, result *Result, unionDepth int) {
+// lintSize checks the size of the linted data, in bytes, against the
+// budget.
+func (l *Linter) lintSize(size int, result *Result) {
+	if l.config.MaxSchemaBytes > 0 && size > l.config.MaxSchemaBytes {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeSchemaTooLarge,
+			Severity:   SeverityError,
+			Path:       rootPath,
+			Message:    fmt.Sprintf("Schema is %d bytes (budget: %d)", size, l.config.MaxSchemaBytes),
+			Suggestion: "Split the schema into smaller files that reference each other",
+		})
+		l.filterRules(result, 0)
+	}
+}
+
+// duplicateKeys returns the duplicate-key errors in data, grouped by the
+// root path of the document they occur in.
+func duplicateKeys(data []byte, composite bool) (map[string][]Issue, error) {
+	issues, err := scanJSON(data, composite)
+	if err != nil {
+		return nil, err
+	}
+	byRoot := make(map[string][]Issue)
+	for _, issue := range issues {
+		if issue.Code != CodeDuplicateKey {
+			continue
+		}
+		byRoot[issue.Path.Root] = append(byRoot[issue.Path.Root], issue)
+	}
+	return byRoot, nil
+}
+
+// lintDocument lints a single schema document and its definitions. parsed
+// holds issues found before linting the document, such as duplicate keys in
+// its source text and list responses or error schemas that are inconsistent
+// with the rest of the schema set.
+// With roots configured, only the definitions they reach are linted.
+func (l *Linter) lintDocument(schema *Schema, root Path, result *Result, parsed []Issue) error {
+	if schema == nil {
+		return nil
+	}
+	if l.config.PropertyCase == CaseAuto {
+		return l.withInferredCase(sampleCase(schema)).lintDocument(schema, root, result, parsed)
+	}
+	var excluded map[string]bool
+	var unreachable []Issue
+	var err error
+	result.profiler.run("resolve", func() { excluded, unreachable, err = l.rootScope(schema, root) })
+	if err != nil {
+		return err
+	}
+	start := len(result.Issues)
+	result.Issues = append(result.Issues, parsed...)
+	vendored := l.ignoredDefinitions(schema, root)
+	ignored := make(map[string]bool, len(vendored)+len(excluded))
+	for path := range vendored {
+		ignored[path] = true
+	}
+	for path := range excluded {
+		ignored[path] = true
+	}
+
+	// Report the assertions that failed to compile
+	for _, err := range l.invalidAssertions {
+		result.Issues = append(result.Issues, Issue{
+			Code:     CodeInvalidAssertion,
+			Severity: SeverityError,
+			Path:     root,
+			Message:  err.Error(),
+		})
+	}
+
+	// Lint the root schema
+	result.doc, result.root = schema, root
+	defer func() { result.doc, result.celViews = nil, nil }()
+	if !ignored[root.String()] {
+		l.lintSchema(schema, root, result, 0, false)
+	}
+
+	// Lint definitions ($defs and legacy definitions)
+	l.lintDefinitions(schema, root, ignored, result)
+
+	// Check that the document and its definitions admit an instance
+	l.run(result, "unsatisfiable-schema", func() { l.lintUnsatisfiable(schema, root, result) })
+
+	// Check that union variants match the enum of their discriminator
+	l.run(result, "discriminator-closure", func() { l.lintDiscriminatorClosure(schema, root, result) })
+
+	// Lint allOf inheritance as the merged object it describes
+	l.run(result, "inheritance-conflict", func() { l.lintInheritance(schema, root, result, start) })
+
+	// Check the definition and property counts against the budgets
+	l.run(result, "budgets", func() { l.lintBudgets(schema, root, result) })
+
+	// Check the $schema declaration of the document
+	l.run(result, "schema-declaration", func() { l.lintSchemaDeclaration(schema, root, result) })
+
+	// Check that entry schemas are objects
+	l.run(result, "non-object-root", func() { l.lintObjectRoots(schema, root, ignored, result) })
+
+	// Check that definition names are ASCII
+	if !l.config.UnicodeNames {
+		l.run(result, "non-ascii-name", func() { l.lintNonASCIIDefinitions(schema, root, ignored, result) })
+	}
+
+	// Check the naming convention of definitions
+	if l.config.DefinitionCase != "" && l.config.DefinitionCase != CaseNone {
+		l.run(result, "definition-name-case", func() { l.lintDefinitionCase(schema, root, ignored, result) })
+	}
+
+	// Check that definition titles match their names
+	l.run(result, "title-name-mismatch", func() { l.lintDefinitionTitles(schema, root, ignored, result) })
+
+	// Check the version markers of definition names and $ids
+	if l.config.DetectVersionNaming {
+		l.run(result, "version-naming", func() { l.lintVersionNaming(schema, root, result) })
+	}
+
+	// Check that $ids are absolute and unique
+	l.run(result, "ids", func() { l.lintIDs(schema, root, ignored, result) })
+
+	// Suggest shared bases for properties repeated across definitions
+	l.run(result, "repeated-properties", func() { l.lintRepeatedProperties(schema, root, ignored, result) })
+
+	// Check that annotations can be read
+	l.run(result, "invalid-annotation", func() { lintAnnotations(schema, root, result) })
+
+	// Drop findings in vendored definitions reached through $refs
+	dropIgnored(ignored, vendored, root, result, start)
+
+	// Escalate or demote findings by x-stability
+	l.applyStabilityPolicy(schema, root, result, start)
+
+	// Attribute findings to the teams named by x-owner
+	assignOwners(schema, root, result, start)
+
+	// Report each finding once, at its definition, with the $refs using it
+	dedupeIssues(result, start)
+	result.profiler.run("resolve", func() { attributeReferences(schema, root, result, start) })
+
+	// Report the definitions left out by the roots after the findings
+	result.Issues = append(result.Issues, unreachable...)
+
+	// Keep only the findings of the selected rules and categories
+	l.filterRules(result, start)
+
+	// Set aside the findings suppressed by x-schemalint annotations
+	suppressInline(schema, root, result, start)
+	return nil
+}
+
+// lintSchema lints a schema node and its subschemas. unionDepth is the
+// number of enclosing unions, counted through properties and array items;
+// arrayItems is true if the node is the items schema of an array.
+func (l *Linter) lintSchema(schema *Schema, path Path, result *Result, unionDepth int, arrayItems bool) {
 	if schema == nil {
 		return
 	}
 
 	// Scale profile: strict checks for static type compatibility
 	if l.config.IsScaleProfile() {
-		l.lintScaleProfile(schema, path, result)
+		l.run(result, "scale-profile", func() { l.lintScaleProfile(schema, path, result) })
 	}
 
 	// Navigable profile: checks for human-reviewable, AI-friendly schemas
 	if l.config.IsNavigableProfile() {
-		l.lintNavigableProfile(schema, path, result)
+		l.run(result, "navigable-profile", func() { l.lintNavigableProfile(schema, path, result) })
+	}
+
+	// Check for keywords that have no effect on the declared type
+	l.run(result, "dead-keyword", func() { l.lintDeadKeywords(schema, path, result) })
+
+	// Check for unknown keys that are likely misspelled keywords
+	l.run(result, "keyword-typo", func() { l.lintKeywordTypos(schema, path, result) })
+
+	// Check for other unknown keys
+	l.run(result, "unknown-keyword", func() { l.lintUnknownKeywords(schema, path, result) })
+
+	// Check for patterns that target languages' regex engines reject
+	l.run(result, "unportable-pattern", func() { l.lintPatternPortability(schema, path, result) })
+
+	// Check for patterns that match anywhere in the string
+	l.run(result, "unanchored-pattern", func() { l.lintPatternAnchoring(schema, path, result) })
+
+	// Check enum size
+	if l.config.MaxEnumValues > 0 && len(schema.Enum) > l.config.MaxEnumValues {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeLargeEnum,
+			Severity:   SeverityWarning,
+			Path:       path.Child("enum"),
+			Message:    fmt.Sprintf("Enum has %d values (threshold: %d)", len(schema.Enum), l.config.MaxEnumValues),
+			Suggestion: "Model as a string with a documented registry of values instead of an enum",
+		})
+	}
+
+	// Check for enums mixing scalars with objects
+	if len(schema.Enum) > 0 {
+		l.run(result, "mixed-enum", func() { l.lintMixedEnum(schema, path, result) })
+	}
+
+	// Check for untyped data/payload/value envelopes
+	l.run(result, "generic-container", func() { l.lintGenericContainer(schema, path, result) })
+
+	// Check for timestamps and IDs typed as plain strings
+	l.run(result, "stringly-typed", func() { l.lintStringlyTyped(schema, path, result) })
+
+	// Check for money amounts typed as floating-point numbers
+	l.run(result, "float-money", func() { l.lintMoney(schema, path, result) })
+
+	// Check for binary data without contentEncoding, and content keywords
+	// on types other than string
+	l.run(result, "content-encoding", func() { l.lintContentEncoding(schema, path, result) })
+
+	// Check for booleans encoded as 0/1 or "true"/"false" enums
+	l.run(result, "boolean-enum", func() { l.lintBooleanEnum(schema, path, result) })
+
+	// Check for value sets left in prose
+	if l.config.DetectProseEnums {
+		l.run(result, "prose-enum", func() { l.lintProseEnum(schema, path, result) })
+	}
+
+	// Check for properties that can be both absent and null
+	if l.config.DetectNullableOptional {
+		l.run(result, "nullable-optional", func() { l.lintNullableOptional(schema, path, result) })
+	}
+
+	// Check for durations and sizes without unit suffixes
+	if l.config.DetectUnitSuffixes {
+		l.run(result, "missing-unit-suffix", func() { l.lintUnitSuffix(schema, path, result) })
 	}
 
 	// Check for union types
 	if len(schema.AnyOf) > 0 {
-		l.lintUnion(schema.AnyOf, path+"/anyOf", result, unionDepth, "anyOf")
+		l.run(result, "unions", func() { l.lintUnion(schema.AnyOf, path.Child("anyOf"), result, unionDepth, "anyOf", arrayItems) })
 	}
 	if len(schema.OneOf) > 0 {
-		l.lintUnion(schema.OneOf, path+"/oneOf", result, unionDepth, "oneOf")
+		l.run(result, "unions", func() { l.lintUnion(schema.OneOf, path.Child("oneOf"), result, unionDepth, "oneOf", arrayItems) })
 	}
 
 	// Check properties
 	for propName, propSchema := range schema.Properties {
-		propPath := fmt.Sprintf("%s/properties/%s", path, propName)
-		l.lintSchema(propSchema, propPath, result, unionDepth)
+		propPath := path.Child("properties", propName)
+		l.lintSchema(propSchema, propPath, result, unionDepth, false)
 	}
 
 	// Check items
 	if schema.Items != nil {
-		l.lintSchema(schema.Items, path+"/items", result, unionDepth)
+		l.lintSchema(schema.Items, path.Child("items"), result, unionDepth, true)
+	}
+
+	// Check tuple elements, and that tuples are closed
+	for i, item := range schema.PrefixItems {
+		l.lintSchema(item, prefixItemPath(schema, path, i), result, unionDepth, false)
+	}
+	if schema.legacyTuple && schema.AdditionalItems != nil {
+		l.lintSchema(schema.AdditionalItems, path.Child("additionalItems"), result, unionDepth, true)
+	}
+	if len(schema.PrefixItems) > 0 {
+		l.run(result, "open-tuple", func() { l.lintOpenTuple(schema, path, result) })
+	}
+
+	// Check contains, which generated types cannot express
+	if schema.Contains != nil {
+		l.run(result, "contains-constraint", func() { l.lintContains(schema, path, result) })
+		l.lintSchema(schema.Contains, path.Child("contains"), result, unionDepth, false)
 	}
 
 	// Check additionalProperties
 	if schema.AdditionalPropertiesSchema != nil {
-		l.lintSchema(schema.AdditionalPropertiesSchema, path+"/additionalProperties", result, unionDepth)
+		l.lintSchema(schema.AdditionalPropertiesSchema, path.Child("additionalProperties"), result, unionDepth, false)
+	}
+
+	// Check the keys and values of maps
+	if schema.AdditionalPropertiesSchema != nil && len(schema.Properties) == 0 {
+		l.run(result, "maps", func() { l.lintMap(schema, path, result) })
+	}
+
+	// Check for objects that leave additionalProperties implicit
+	if l.config.RequireAdditionalProperties {
+		l.run(result, "implicit-additional-properties", func() { l.lintImplicitAdditionalProperties(schema, path, result) })
 	}
 
 	// Check property naming convention
 	if l.config.PropertyCase != CaseNone {
-		l.lintProperties(schema, path, result)
+		l.run(result, "invalid-property-case", func() { l.lintProperties(schema, path, result) })
+	}
+	if !l.config.UnicodeNames {
+		l.run(result, "non-ascii-name", func() { l.lintNonASCIIProperties(schema, path, result) })
+	}
+
+	// Check enum member naming convention
+	if len(schema.Enum) > 0 && (l.config.enumCase() != CaseNone || l.config.ConsistentEnumCase) {
+		l.run(result, "enum-member-case", func() { l.lintEnumCase(schema, path, result) })
+	}
+
+	// Apply custom rules
+	for _, rule := range l.rules {
+		l.run(result, string(rule.Info().Code), func() {
+			result.Issues = append(result.Issues, rule.Check(schema, path)...)
+		})
+	}
+	if len(l.assertions) > 0 {
+		l.lintAssertions(schema, path, result)
 	}
 }
 
 // lintProperties checks the casing of property names.
-func (l *Linter) lintProperties(schema *Schema, path string, result *Result) {
+func (l *Linter) lintProperties(schema *Schema, path Path, result *Result) {
 	for propName := range schema.Properties {
-		isValid := false
-		switch l.config.PropertyCase {
-		case CaseCamel:
-			isValid = isCamelCase(propName)
-		case CaseSnake:
-			isValid = isSnakeCase(propName)
-		case CaseKebab:
-			isValid = isKebabCase(propName)
-		case CasePascal:
-			isValid = isPascalCase(propName)
-		}
-
-		if !isValid {
+		if !l.config.UnicodeNames && !isASCII(propName) {
+			// Reported as non-ascii-name
+			continue
+		}
+		if !l.config.followsCase(propName) {
+			message := fmt.Sprintf("Property '%s' is not in %s", propName, l.config.PropertyCase)
+			if l.inferredCase {
+				message += ", the convention of most properties in the document"
+			}
+			suggestion := fmt.Sprintf("Rename property to follow the %s convention", l.config.PropertyCase)
+			if name := toCase(propName, l.config.PropertyCase, l.config.Acronyms); name != propName && l.config.followsCase(name) {
+				suggestion = fmt.Sprintf("Rename property to '%s' to follow the %s convention", name, l.config.PropertyCase)
+			}
 			result.Issues = append(result.Issues, Issue{
 				Code:       CodeInvalidPropertyCase,
 				Severity:   SeverityError,
-				Path:       fmt.Sprintf("%s/properties/%s", path, propName),
-				Message:    fmt.Sprintf("Property '%s' is not in %s", propName, l.config.PropertyCase),
-				Suggestion: fmt.Sprintf("Rename property to follow the %s convention", l.config.PropertyCase),
+				Path:       path.Child("properties", propName),
+				Message:    message,
+				Suggestion: suggestion,
 			})
 		}
 	}
 }
 
-// isCamelCase checks if a string is in camelCase.
+// isCamelCase checks if a string is in camelCase. Letters of any script
+// count: letters without case, such as CJK ideographs, may start a word.
 func isCamelCase(s string) bool {
-	if s == "" {
-		return true
-	}
-	if s[0] < 'a' || s[0] > 'z' {
-		return false
-	}
-	for _, r := range s {
-		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+	for i, r := range []rune(s) {
+		if i == 0 && !isLowerLetter(r) || !unicode.IsLetter(r) && !unicode.IsDigit(r) {
 			return false
 		}
 	}
@@ -227,7 +917,7 @@ func isCamelCase(s string) bool {
 // isSnakeCase checks if a string is in snake_case.
 func isSnakeCase(s string) bool {
 	for _, r := range s {
-		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' {
+		if !isLowerLetter(r) && !unicode.IsDigit(r) && r != '_' {
 			return false
 		}
 	}
@@ -237,7 +927,7 @@ func isSnakeCase(s string) bool {
 // isKebabCase checks if a string is in kebab-case.
 func isKebabCase(s string) bool {
 	for _, r := range s {
-		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' {
+		if !isLowerLetter(r) && !unicode.IsDigit(r) && r != '-' {
 			return false
 		}
 	}
@@ -246,28 +936,44 @@ func isKebabCase(s string) bool {
 
 // isPascalCase checks if a string is in PascalCase.
 func isPascalCase(s string) bool {
-	if s == "" {
-		return true
-	}
-	if s[0] < 'A' || s[0] > 'Z' {
-		return false
+	for i, r := range []rune(s) {
+		if i == 0 && !isUpperLetter(r) || !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			return false
+		}
 	}
+	return true
+}
+
+// isScreamingSnakeCase checks if a string is in SCREAMING_SNAKE_CASE.
+func isScreamingSnakeCase(s string) bool {
 	for _, r := range s {
-		if (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (r < '0' || r > '9') {
+		if !isUpperLetter(r) && !unicode.IsDigit(r) && r != '_' {
 			return false
 		}
 	}
 	return true
 }
 
+// isLowerLetter reports whether r is a lowercase letter or a letter
+// without case.
+func isLowerLetter(r rune) bool {
+	return unicode.IsLetter(r) && !unicode.IsUpper(r) && !unicode.IsTitle(r)
+}
+
+// isUpperLetter reports whether r is an uppercase or titlecase letter, or a
+// letter without case.
+func isUpperLetter(r rune) bool {
+	return unicode.IsLetter(r) && !unicode.IsLower(r)
+}
+
 // lintScaleProfile applies strict checks for the scale profile.
-func (l *Linter) lintScaleProfile(schema *Schema, path string, result *Result) {
+func (l *Linter) lintScaleProfile(schema *Schema, path Path, result *Result) {
 	// Disallow composition keywords (anyOf, oneOf, allOf)
 	if len(schema.AnyOf) > 0 {
 		result.Issues = append(result.Issues, Issue{
 			Code:       CodeCompositionDisallowed,
 			Severity:   SeverityError,
-			Path:       path + "/anyOf",
+			Path:       path.Child("anyOf"),
 			Message:    "anyOf is disallowed in scale profile",
 			Suggestion: "Use separate schema definitions instead of unions",
 		})
@@ -276,7 +982,7 @@ func (l *Linter) lintScaleProfile(schema *Schema, path string, result *Result) {
 		result.Issues = append(result.Issues, Issue{
 			Code:       CodeCompositionDisallowed,
 			Severity:   SeverityError,
-			Path:       path + "/oneOf",
+			Path:       path.Child("oneOf"),
 			Message:    "oneOf is disallowed in scale profile",
 			Suggestion: "Use separate schema definitions instead of unions",
 		})
@@ -285,12 +991,23 @@ func (l *Linter) lintScaleProfile(schema *Schema, path string, result *Result) {
 		result.Issues = append(result.Issues, Issue{
 			Code:       CodeCompositionDisallowed,
 			Severity:   SeverityError,
-			Path:       path + "/allOf",
+			Path:       path.Child("allOf"),
 			Message:    "allOf is disallowed in scale profile",
 			Suggestion: "Flatten the schema structure instead of using composition",
 		})
 	}
 
+	// Disallow dynamic references, which depend on the evaluation path
+	if schema.DynamicRef != "" {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeDynamicRefDisallowed,
+			Severity:   SeverityError,
+			Path:       path.Child("$dynamicRef"),
+			Message:    "$dynamicRef is disallowed in scale profile",
+			Suggestion: "Replace $dynamicRef with a $ref to a concrete definition",
+		})
+	}
+
 	// Disallow additionalProperties: true
 	if schema.AdditionalProperties != nil && *schema.AdditionalProperties {
 		result.Issues = append(result.Issues, Issue{
@@ -334,7 +1051,7 @@ func (l *Linter) lintScaleProfile(schema *Schema, path string, result *Result) {
 // - Arrays of objects should not nest arrays of objects
 // - Cross-references should be shallow (single-hop)
 // - Each object should be locally comprehensible
-func (l *Linter) lintNavigableProfile(schema *Schema, path string, result *Result) {
+func (l *Linter) lintNavigableProfile(schema *Schema, path Path, result *Result) {
 	// Check object nesting depth
 	depth := l.countNestingDepth(path, "properties")
 	maxDepth := l.config.MaxObjectNestingDepth
@@ -377,10 +1094,9 @@ func (l *Linter) lintNavigableProfile(schema *Schema, path string, result *Resul
 }
 
 // countNestingDepth counts how many times a path segment appears in the path.
-func (l *Linter) countNestingDepth(path, segment string) int {
+func (l *Linter) countNestingDepth(path Path, segment string) int {
 	count := 0
-	parts := splitPath(path)
-	for _, part := range parts {
+	for _, part := range path.Segments {
 		if part == segment {
 			count++
 		}
@@ -388,26 +1104,8 @@ func (l *Linter) countNestingDepth(path, segment string) int {
 	return count
 }
 
-// splitPath splits a JSON pointer path into segments.
-func splitPath(path string) []string {
-	if path == "" || path == "/" {
-		return nil
-	}
-	// Remove leading slash
-	if len(path) > 0 && path[0] == '/' {
-		path = path[1:]
-	}
-	result := []string{}
-	for _, part := range strings.Split(path, "/") {
-		if part != "" {
-			result = append(result, part)
-		}
-	}
-	return result
-}
-
 // isArrayOfArraysOfObjects checks if a schema is an array containing arrays of objects.
-func (l *Linter) isArrayOfArraysOfObjects(schema *Schema, path string) bool {
+func (l *Linter) isArrayOfArraysOfObjects(schema *Schema, path Path) bool {
 	if schema.Type != "array" || schema.Items == nil {
 		return false
 	}
@@ -433,54 +1131,105 @@ func (l *Linter) hasIDField(schema *Schema) bool {
 	return false
 }
 
-func (l *Linter) lintUnion(variants []*Schema, path string, result *Result, unionDepth int, unionType string) {
+// lintUnion checks the variants of an anyOf or oneOf union. Unions that are
+// the items of an array are reported as heterogeneous arrays.
+func (l *Linter) lintUnion(variants []*Schema, path Path, result *Result, unionDepth int, unionType string, arrayItems bool) {
+	label, noun := unionType+" union", "Union"
+	if arrayItems {
+		label, noun = "Array item "+label, "Array item union"
+	}
+
 	// Skip nullable patterns (anyOf with null)
 	if l.isNullablePattern(variants) {
 		return
 	}
 
-	// Skip if all variants are $refs (need resolution to verify discriminators)
-	if l.allRefs(variants) {
+	// Unions of constants are enums and need no discriminator
+	if l.lintConstUnion(variants, path, unionType, result) {
 		return
 	}
 
+	// Variants extending a base schema that declares the discriminator
+	base := l.baseDiscriminator(result.doc, result.root, variants)
+	if base != nil {
+		l.verifyBaseDiscriminator(result.doc, result.root, variants, base, path, result)
+	}
+
+	// Resolve $ref variants to verify their discriminators; skip the
+	// verification if a $ref does not resolve
+	resolved, variantPaths, unresolved := l.resolveVariants(result, variants, path)
+	if base == nil && len(unresolved) > 0 {
+		severity := SeverityInfo
+		if l.config.StrictUnresolved {
+			severity = SeverityError
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeUnresolvedUnion,
+			Severity:   severity,
+			Path:       path,
+			Message:    fmt.Sprintf("%s variant $refs %s do not resolve; discriminator verification was skipped", label, strings.Join(unresolved, ", ")),
+			Suggestion: "Define the referenced schemas in the document, or configure a Resolver for $refs to other documents",
+		})
+	}
+	verify := base == nil && len(unresolved) == 0
+
 	// Check union size
 	if len(variants) > l.config.MaxUnionVariants {
 		result.Issues = append(result.Issues, Issue{
 			Code:       CodeLargeUnion,
 			Severity:   SeverityWarning,
 			Path:       path,
-			Message:    fmt.Sprintf("Union has %d variants (threshold: %d)", len(variants), l.config.MaxUnionVariants),
+			Message:    fmt.Sprintf("%s has %d variants (threshold: %d)", noun, len(variants), l.config.MaxUnionVariants),
 			Suggestion: "Consider splitting into smaller, more focused unions",
 		})
 	}
 
 	// Check nesting depth
 	if unionDepth >= l.config.MaxUnionNestingDepth {
+		suggestion := "Flatten the union hierarchy for better Go compatibility"
+		if arrayItems {
+			suggestion = "Flatten the union hierarchy or give the array a single item type; nested arrays of unions need a custom unmarshaller at every level"
+		}
 		result.Issues = append(result.Issues, Issue{
 			Code:       CodeNestedUnion,
 			Severity:   SeverityWarning,
 			Path:       path,
-			Message:    fmt.Sprintf("Union nested %d levels deep (threshold: %d)", unionDepth+1, l.config.MaxUnionNestingDepth),
-			Suggestion: "Flatten the union hierarchy for better Go compatibility",
+			Message:    fmt.Sprintf("%s nested %d levels deep (threshold: %d)", noun, unionDepth+1, l.config.MaxUnionNestingDepth),
+			Suggestion: suggestion,
 		})
 	}
 
 	// Check for discriminator
-	discriminator := l.findDiscriminator(variants)
-	if discriminator == nil && len(variants) > 1 && !l.isReferencePattern(variants) {
+	var discriminator *discriminatorInfo
+	if verify {
+		discriminator = l.findDiscriminator(resolved)
+	}
+	if discriminator == nil && verify && len(variants) > 1 && !l.isReferencePattern(variants) {
+		message := fmt.Sprintf("%s has no discriminator field", label)
+		if arrayItems {
+			message += "; a heterogeneous array requires custom unmarshalling in Go"
+		}
+		suggestion := l.proposeDiscriminator(resolved)
+		if suggestion == "" {
+			suggestion = "Add a const property (e.g., 'type' or 'kind') to each variant with a unique value"
+		}
 		result.Issues = append(result.Issues, Issue{
 			Code:       CodeUnionNoDiscriminator,
 			Severity:   SeverityError,
 			Path:       path,
-			Message:    fmt.Sprintf("%s union has no discriminator field", unionType),
-			Suggestion: "Add a const property (e.g., 'type' or 'kind') to each variant with a unique value",
+			Message:    message,
+			Suggestion: suggestion,
 		})
 	}
 
 	// If we found a discriminator, verify all variants have it
 	if discriminator != nil {
-		l.verifyDiscriminator(variants, discriminator, path, result)
+		l.verifyDiscriminator(resolved, variantPaths, discriminator, result)
+	}
+
+	// Without one, check that no variant shadows a later one
+	if discriminator == nil && verify && unionType == "anyOf" {
+		l.lintVariantOrder(variants, path, result)
 	}
 
 	// Check for additionalProperties on union variants
@@ -492,7 +1241,7 @@ func (l *Linter) lintUnion(variants []*Schema, path string, result *Result, unio
 			result.Issues = append(result.Issues, Issue{
 				Code:       CodeAdditionalProps,
 				Severity:   SeverityWarning,
-				Path:       fmt.Sprintf("%s/%d", path, i),
+				Path:       path.Index(i),
 				Message:    "Union variant has additionalProperties: true",
 				Suggestion: "Set additionalProperties: false to avoid ambiguous JSON decoding",
 			})
@@ -502,23 +1251,38 @@ func (l *Linter) lintUnion(variants []*Schema, path string, result *Result, unio
 	// Recursively lint nested schemas in variants
 	for i, variant := range variants {
 		if variant != nil && variant.Ref == "" {
-			variantPath := fmt.Sprintf("%s/%d", path, i)
-			l.lintSchema(variant, variantPath, result, unionDepth+1)
+			variantPath := path.Index(i)
+			l.lintSchema(variant, variantPath, result, unionDepth+1, false)
 		}
 	}
 }
 
-// allRefs checks if all variants are $ref references.
-func (l *Linter) allRefs(variants []*Schema) bool {
-	for _, v := range variants {
-		if v == nil {
-			continue
-		}
-		if v.Ref == "" {
-			return false
+// resolveVariants returns the union variants with $ref variants replaced
+// by the schemas they resolve to, following chains of $refs, and the path
+// of each variant: its index in the union, or the path of the schema a
+// $ref resolves to. $refs that do not resolve are left in place and
+// returned as unresolved.
+func (l *Linter) resolveVariants(result *Result, variants []*Schema, path Path) (resolved []*Schema, paths []Path, unresolved []string) {
+	resolved = make([]*Schema, len(variants))
+	paths = make([]Path, len(variants))
+	for i, v := range variants {
+		resolved[i], paths[i] = v, path.Index(i)
+		seen := make(map[*Schema]bool)
+		for v != nil && v.Ref != "" && !seen[v] {
+			seen[v] = true
+			target, targetPath, ok := (*Schema)(nil), Path{}, false
+			if result.doc != nil {
+				target, targetPath, ok = l.resolveRef(result.doc, result.root, v.Ref)
+			}
+			if !ok || target == nil {
+				unresolved = append(unresolved, v.Ref)
+				break
+			}
+			v = target
+			resolved[i], paths[i] = target, targetPath
 		}
 	}
-	return true
+	return resolved, paths, unresolved
 }
 
 // isNullablePattern checks if this is a simple nullable pattern: anyOf [T, null]
@@ -618,12 +1382,109 @@ func (l *Linter) findDiscriminator(variants []*Schema) *discriminatorInfo {
 	return nil
 }
 
+// proposeDiscriminator suggests turning an existing string property shared
+// by every variant into the discriminator. Configured discriminator field
+// names are preferred, then other names in sorted order. If each variant
+// has distinct known values for the property (a single-value enum, a
+// default, or examples), they are included in the proposal. It returns ""
+// if the variants share no string property.
+func (l *Linter) proposeDiscriminator(variants []*Schema) string {
+	var inline []*Schema
+	for _, v := range variants {
+		if v != nil && v.Ref == "" {
+			inline = append(inline, v)
+		}
+	}
+	if len(inline) < 2 {
+		return ""
+	}
+
+	var shared []string
+	for _, name := range sortedKeys(inline[0].Properties) {
+		common := true
+		for _, v := range inline {
+			if !isStringProperty(v.Properties[name]) {
+				common = false
+				break
+			}
+		}
+		if common {
+			shared = append(shared, name)
+		}
+	}
+	if len(shared) == 0 {
+		return ""
+	}
+
+	candidates := make([]string, 0, len(shared))
+	for _, field := range l.config.DiscriminatorFields {
+		for _, name := range shared {
+			if name == field {
+				candidates = append(candidates, name)
+			}
+		}
+	}
+	candidates = append(candidates, shared...)
+
+	for _, name := range candidates {
+		if values := distinctKnownValues(inline, name); values != nil {
+			return fmt.Sprintf("Add const values to existing property '%s': %s", name, strings.Join(values, "|"))
+		}
+	}
+	return fmt.Sprintf("Add a unique const value to existing property '%s' in each variant", candidates[0])
+}
+
+// isStringProperty returns true if the property schema is a string type.
+func isStringProperty(s *Schema) bool {
+	if s == nil || s.IsBooleanSchema || s.IsRef() {
+		return false
+	}
+	return schemaKind(s) == "string"
+}
+
+// distinctKnownValues returns one known string value of the property for
+// each variant, or nil unless every variant has a value and all differ.
+func distinctKnownValues(variants []*Schema, name string) []string {
+	values := make([]string, 0, len(variants))
+	seen := make(map[string]bool, len(variants))
+	for _, v := range variants {
+		value, ok := knownValue(v.Properties[name])
+		if !ok || seen[value] {
+			return nil
+		}
+		seen[value] = true
+		values = append(values, value)
+	}
+	return values
+}
+
+// knownValue returns the value a property is documented to hold in one
+// variant: its only enum value, its default, or its first example.
+func knownValue(s *Schema) (string, bool) {
+	var candidates []any
+	if len(s.Enum) == 1 {
+		candidates = append(candidates, s.Enum[0])
+	}
+	candidates = append(candidates, s.Default)
+	if len(s.Examples) > 0 {
+		candidates = append(candidates, s.Examples[0])
+	}
+	for _, c := range candidates {
+		if str, ok := c.(string); ok && str != "" {
+			return str, true
+		}
+	}
+	return "", false
+}
+
 type discriminatorInfo struct {
 	fieldName string
 	values    map[string]int
 }
 
-func (l *Linter) verifyDiscriminator(variants []*Schema, disc *discriminatorInfo, path string, result *Result) {
+// verifyDiscriminator checks that each variant, at the path of the same
+// index, has a unique const value for the discriminator.
+func (l *Linter) verifyDiscriminator(variants []*Schema, paths []Path, disc *discriminatorInfo, result *Result) {
 	seenValues := make(map[string]bool)
 
 	for i, variant := range variants {
@@ -636,7 +1497,7 @@ func (l *Linter) verifyDiscriminator(variants []*Schema, disc *discriminatorInfo
 			result.Issues = append(result.Issues, Issue{
 				Code:       CodeMissingConst,
 				Severity:   SeverityError,
-				Path:       fmt.Sprintf("%s/%d", path, i),
+				Path:       paths[i],
 				Message:    fmt.Sprintf("Variant missing discriminator property '%s'", disc.fieldName),
 				Suggestion: fmt.Sprintf("Add '%s' property with a const value to this variant", disc.fieldName),
 			})
@@ -647,7 +1508,7 @@ func (l *Linter) verifyDiscriminator(variants []*Schema, disc *discriminatorInfo
 			result.Issues = append(result.Issues, Issue{
 				Code:       CodeMissingConst,
 				Severity:   SeverityError,
-				Path:       fmt.Sprintf("%s/%d/properties/%s", path, i, disc.fieldName),
+				Path:       paths[i].Child("properties", disc.fieldName),
 				Message:    fmt.Sprintf("Discriminator property '%s' has no const value", disc.fieldName),
 				Suggestion: fmt.Sprintf("Add 'const' to the '%s' property with a unique string value", disc.fieldName),
 			})
@@ -663,7 +1524,7 @@ func (l *Linter) verifyDiscriminator(variants []*Schema, disc *discriminatorInfo
 			result.Issues = append(result.Issues, Issue{
 				Code:       CodeDuplicateConstValue,
 				Severity:   SeverityError,
-				Path:       fmt.Sprintf("%s/%d/properties/%s", path, i, disc.fieldName),
+				Path:       paths[i].Child("properties", disc.fieldName),
 				Message:    fmt.Sprintf("Duplicate discriminator value '%s'", strVal),
 				Suggestion: "Each variant must have a unique const value for the discriminator",
 			})
@@ -1,55 +1,18 @@
 package linter
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"testing"
 )
 
-func TestLintNullablePattern(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"NullableString": {
-				"anyOf": [
-					{"type": "string"},
-					{"type": "null"}
-				]
-			}
-		}
-	}`
-
-	l := NewWithDefaults()
-	result, err := l.Lint([]byte(schema))
-	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
+func TestLintLargeEnum(t *testing.T) {
+	values := make([]string, 101)
+	for i := range values {
+		values[i] = fmt.Sprintf("%q", fmt.Sprintf("v%d", i))
 	}
-
-	if len(result.Issues) != 0 {
-		t.Errorf("Expected no issues for nullable pattern, got %d: %v", len(result.Issues), result.Issues)
-	}
-}
-
-func TestLintUnionWithDiscriminator(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"Animal": {
-				"anyOf": [
-					{
-						"type": "object",
-						"properties": {
-							"type": {"const": "dog"},
-							"name": {"type": "string"}
-						}
-					},
-					{
-						"type": "object",
-						"properties": {
-							"type": {"const": "cat"},
-							"name": {"type": "string"}
-						}
-					}
-				]
-			}
-		}
-	}`
+	schema := `{"$defs": {"Country": {"type": "string", "enum": [` + strings.Join(values, ",") + `]}}}`
 
 	l := NewWithDefaults()
 	result, err := l.Lint([]byte(schema))
@@ -57,134 +20,27 @@ func TestLintUnionWithDiscriminator(t *testing.T) {
 		t.Fatalf("Failed to lint: %v", err)
 	}
 
-	if result.HasErrors() {
-		t.Errorf("Expected no errors for union with discriminator, got: %v", result.Issues)
-	}
-}
-
-func TestLintUnionWithoutDiscriminator(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"BadUnion": {
-				"anyOf": [
-					{
-						"type": "object",
-						"properties": {
-							"name": {"type": "string"}
-						}
-					},
-					{
-						"type": "object",
-						"properties": {
-							"title": {"type": "string"}
-						}
-					}
-				]
-			}
-		}
-	}`
-
-	l := NewWithDefaults()
-	result, err := l.Lint([]byte(schema))
-	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
-	}
-
-	if !result.HasErrors() {
-		t.Error("Expected error for union without discriminator")
-	}
-
 	found := false
 	for _, issue := range result.Issues {
-		if issue.Code == CodeUnionNoDiscriminator {
+		if issue.Code == CodeLargeEnum && strings.Contains(issue.Message, "101 values") {
 			found = true
-			break
 		}
 	}
 	if !found {
-		t.Error("Expected union-no-discriminator error")
-	}
-}
-
-func TestLintLargeUnion(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"LargeUnion": {
-				"oneOf": [
-					{"type": "object", "properties": {"type": {"const": "v1"}}},
-					{"type": "object", "properties": {"type": {"const": "v2"}}},
-					{"type": "object", "properties": {"type": {"const": "v3"}}},
-					{"type": "object", "properties": {"type": {"const": "v4"}}},
-					{"type": "object", "properties": {"type": {"const": "v5"}}},
-					{"type": "object", "properties": {"type": {"const": "v6"}}},
-					{"type": "object", "properties": {"type": {"const": "v7"}}},
-					{"type": "object", "properties": {"type": {"const": "v8"}}},
-					{"type": "object", "properties": {"type": {"const": "v9"}}},
-					{"type": "object", "properties": {"type": {"const": "v10"}}},
-					{"type": "object", "properties": {"type": {"const": "v11"}}}
-				]
-			}
-		}
-	}`
-
-	l := NewWithDefaults()
-	result, err := l.Lint([]byte(schema))
-	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
+		t.Errorf("Expected large-enum warning with count, got: %v", result.Issues)
 	}
 
-	found := false
-	for _, issue := range result.Issues {
-		if issue.Code == CodeLargeUnion && issue.Severity == SeverityWarning {
-			found = true
-			break
-		}
-	}
-	if !found {
-		t.Error("Expected large-union warning")
-	}
-}
-
-func TestLintAdditionalProperties(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"OpenUnion": {
-				"anyOf": [
-					{
-						"type": "object",
-						"properties": {
-							"type": {"const": "open"}
-						},
-						"additionalProperties": true
-					},
-					{
-						"type": "object",
-						"properties": {
-							"type": {"const": "closed"}
-						},
-						"additionalProperties": false
-					}
-				]
-			}
-		}
-	}`
-
-	l := NewWithDefaults()
-	result, err := l.Lint([]byte(schema))
+	config := DefaultConfig()
+	config.MaxEnumValues = 200
+	result, err = New(config).Lint([]byte(schema))
 	if err != nil {
 		t.Fatalf("Failed to lint: %v", err)
 	}
-
-	found := false
 	for _, issue := range result.Issues {
-		if issue.Code == CodeAdditionalProps {
-			found = true
-			break
+		if issue.Code == CodeLargeEnum {
+			t.Errorf("Did not expect large-enum with threshold 200")
 		}
 	}
-	if !found {
-		t.Error("Expected additional-properties warning")
-	}
 }
 
 func TestLintAllRefs(t *testing.T) {
@@ -219,49 +75,25 @@ func TestLintAllRefs(t *testing.T) {
 
 	// Unions with all $refs should be skipped (no error for Animal)
 	for _, issue := range result.Issues {
-		if issue.Path == "$/$defs/Animal/anyOf" && issue.Code == CodeUnionNoDiscriminator {
+		if issue.Path.String() == "$/$defs/Animal/anyOf" && issue.Code == CodeUnionNoDiscriminator {
 			t.Error("Should not report error for all-refs union")
 		}
 	}
 }
 
-func TestResultCounts(t *testing.T) {
-	result := Result{
-		Issues: []Issue{
-			{Severity: SeverityError},
-			{Severity: SeverityError},
-			{Severity: SeverityWarning},
-			{Severity: SeverityInfo},
-		},
-	}
-
-	if result.ErrorCount() != 2 {
-		t.Errorf("Expected 2 errors, got %d", result.ErrorCount())
-	}
-	if result.WarningCount() != 1 {
-		t.Errorf("Expected 1 warning, got %d", result.WarningCount())
-	}
-	if !result.HasErrors() {
-		t.Error("Expected HasErrors to be true")
-	}
-}
-
-func TestScaleProfileDisallowsAnyOf(t *testing.T) {
+func TestLintAllRefsUnresolvedUnion(t *testing.T) {
 	schema := `{
 		"$defs": {
 			"Animal": {
 				"anyOf": [
-					{"type": "object", "properties": {"type": {"const": "dog"}}},
-					{"type": "object", "properties": {"type": {"const": "cat"}}}
+					{"$ref": "#/$defs/Dog"},
+					{"$ref": "#/$defs/Cat"}
 				]
 			}
 		}
 	}`
 
-	config := DefaultConfig()
-	config.Profile = ProfileScale
-	l := New(config)
-
+	l := NewWithDefaults()
 	result, err := l.Lint([]byte(schema))
 	if err != nil {
 		t.Fatalf("Failed to lint: %v", err)
@@ -269,383 +101,176 @@ func TestScaleProfileDisallowsAnyOf(t *testing.T) {
 
 	found := false
 	for _, issue := range result.Issues {
-		if issue.Code == CodeCompositionDisallowed && issue.Severity == SeverityError {
+		if issue.Code == CodeUnresolvedUnion && issue.Severity == SeverityInfo {
 			found = true
-			break
 		}
 	}
 	if !found {
-		t.Error("Expected composition-disallowed error for anyOf")
+		t.Error("Expected unresolved-union info for all-refs union")
+	}
+	if result.HasErrors() {
+		t.Errorf("Expected no errors without strict mode, got: %v", result.Issues)
 	}
-}
-
-func TestScaleProfileDisallowsOneOf(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"Animal": {
-				"oneOf": [
-					{"type": "object", "properties": {"type": {"const": "dog"}}},
-					{"type": "object", "properties": {"type": {"const": "cat"}}}
-				]
-			}
-		}
-	}`
 
 	config := DefaultConfig()
-	config.Profile = ProfileScale
-	l := New(config)
-
-	result, err := l.Lint([]byte(schema))
+	config.StrictUnresolved = true
+	result, err = New(config).Lint([]byte(schema))
 	if err != nil {
 		t.Fatalf("Failed to lint: %v", err)
 	}
-
-	found := false
-	for _, issue := range result.Issues {
-		if issue.Code == CodeCompositionDisallowed && issue.Severity == SeverityError {
-			found = true
-			break
-		}
-	}
-	if !found {
-		t.Error("Expected composition-disallowed error for oneOf")
+	if !result.HasErrors() {
+		t.Error("Expected unresolved-union to be an error in strict mode")
 	}
 }
 
-func TestScaleProfileDisallowsAllOf(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"Combined": {
-				"allOf": [
-					{"type": "object", "properties": {"name": {"type": "string"}}},
-					{"type": "object", "properties": {"age": {"type": "integer"}}}
-				]
+func TestLintCompositeDocuments(t *testing.T) {
+	badUnion := `{"$defs": {"Foo": {"anyOf": [
+		{"type": "object", "properties": {"name": {"type": "string"}}},
+		{"type": "object", "properties": {"title": {"type": "string"}}}
+	]}}}`
+
+	tests := []struct {
+		name string
+		data string
+	}{
+		{"array", "[{\"type\": \"string\"}, " + badUnion + "]"},
+		{"ndjson", "{\"type\": \"string\"}\n" + strings.ReplaceAll(badUnion, "\n", "") + "\n"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := NewWithDefaults().Lint([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("Failed to lint: %v", err)
 			}
-		}
-	}`
 
-	config := DefaultConfig()
-	config.Profile = ProfileScale
-	l := New(config)
-
-	result, err := l.Lint([]byte(schema))
-	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
-	}
-
-	found := false
-	for _, issue := range result.Issues {
-		if issue.Code == CodeCompositionDisallowed && issue.Severity == SeverityError {
-			found = true
-			break
-		}
-	}
-	if !found {
-		t.Error("Expected composition-disallowed error for allOf")
+			found := false
+			for _, issue := range result.Issues {
+				if issue.Code == CodeUnionNoDiscriminator && issue.Path.String() == "[1]/$defs/Foo/anyOf" {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("Expected union-no-discriminator at [1]/$defs/Foo/anyOf, got: %v", result.Issues)
+			}
+		})
 	}
 }
 
-func TestScaleProfileDisallowsAdditionalProperties(t *testing.T) {
+func TestLintDuplicateKeys(t *testing.T) {
 	schema := `{
-		"type": "object",
-		"properties": {
-			"name": {"type": "string"}
-		},
-		"additionalProperties": true
-	}`
-
-	config := DefaultConfig()
-	config.Profile = ProfileScale
-	l := New(config)
+  "type": "object",
+  "properties": {"a": {"type": "string"}},
+  "properties": {"b": {"type": "string"}}
+}`
 
-	result, err := l.Lint([]byte(schema))
+	result, err := NewWithDefaults().Lint([]byte(schema))
 	if err != nil {
 		t.Fatalf("Failed to lint: %v", err)
 	}
 
-	found := false
-	for _, issue := range result.Issues {
-		if issue.Code == CodeAdditionalPropsDisallowed && issue.Severity == SeverityError {
-			found = true
-			break
-		}
+	if len(result.Issues) != 1 {
+		t.Fatalf("Expected 1 issue, got: %v", result.Issues)
 	}
-	if !found {
-		t.Error("Expected additional-properties-disallowed error")
-	}
-}
-
-func TestScaleProfileRequiresType(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"Person": {
-				"properties": {
-					"name": {"type": "string"}
-				}
-			}
-		}
-	}`
-
-	config := DefaultConfig()
-	config.Profile = ProfileScale
-	l := New(config)
-
-	result, err := l.Lint([]byte(schema))
-	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
+	issue := result.Issues[0]
+	if issue.Code != CodeDuplicateKey || issue.Severity != SeverityError || issue.Path.String() != "$/properties" {
+		t.Errorf("Expected duplicate-key error at $/properties, got: %s", issue)
 	}
-
-	found := false
-	for _, issue := range result.Issues {
-		if issue.Code == CodeMissingType && issue.Severity == SeverityError {
-			found = true
-			break
-		}
+	if issue.Line != 4 || issue.Column != 3 {
+		t.Errorf("Expected position 4:3, got %d:%d", issue.Line, issue.Column)
 	}
-	if !found {
-		t.Error("Expected missing-type error")
+	if !strings.Contains(result.GitHubAnnotations(), ",line=4,col=3::") {
+		t.Errorf("Expected position in annotation, got: %s", result.GitHubAnnotations())
 	}
 }
 
-func TestScaleProfileDisallowsMixedTypes(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"StringOrNumber": {
-				"type": ["string", "number"]
-			}
-		}
-	}`
-
-	config := DefaultConfig()
-	config.Profile = ProfileScale
-	l := New(config)
+func TestCheck(t *testing.T) {
+	schema := `{"$defs": {"Count": {"type": "integer", "minLength": 1}}}`
 
-	result, err := l.Lint([]byte(schema))
+	issues, err := Check([]byte(schema), DefaultConfig())
 	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
+		t.Fatalf("Check() error: %v", err)
 	}
-
-	found := false
-	for _, issue := range result.Issues {
-		if issue.Code == CodeMixedTypeDisallowed && issue.Severity == SeverityError {
-			found = true
-			break
-		}
+	if len(issues) != 1 || issues[0].Code != CodeDeadKeyword {
+		t.Errorf("Expected one dead-keyword issue, got: %v", issues)
 	}
-	if !found {
-		t.Error("Expected mixed-type-disallowed error")
-	}
-}
-
-func TestDefaultProfileAllowsComposition(t *testing.T) {
-	schema := `{
-		"$defs": {
-			"Animal": {
-				"anyOf": [
-					{
-						"type": "object",
-						"properties": {"type": {"const": "dog"}}
-					},
-					{
-						"type": "object",
-						"properties": {"type": {"const": "cat"}}
-					}
-				]
-			}
-		}
-	}`
 
-	l := NewWithDefaults()
-	result, err := l.Lint([]byte(schema))
-	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
-	}
-
-	for _, issue := range result.Issues {
-		if issue.Code == CodeCompositionDisallowed {
-			t.Error("Default profile should not report composition-disallowed")
-		}
+	if _, err := Check([]byte("{"), DefaultConfig()); err == nil {
+		t.Error("Expected error for invalid JSON")
 	}
 }
 
-func TestScaleProfileValidSchema(t *testing.T) {
-	schema := `{
-		"$schema": "https://json-schema.org/draft/2020-12/schema",
-		"type": "object",
-		"properties": {
-			"name": {"type": "string"},
-			"age": {"type": "integer"}
+func TestResultCounts(t *testing.T) {
+	result := Result{
+		Issues: []Issue{
+			{Severity: SeverityError},
+			{Severity: SeverityError},
+			{Severity: SeverityWarning},
+			{Severity: SeverityInfo},
 		},
-		"additionalProperties": false
-	}`
-
-	config := DefaultConfig()
-	config.Profile = ProfileScale
-	l := New(config)
-
-	result, err := l.Lint([]byte(schema))
-	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
 	}
 
-	if result.HasErrors() {
-		t.Errorf("Expected no errors for valid scale profile schema, got: %v", result.Issues)
-	}
-}
-
-// Navigable profile tests
-
-func TestNavigableProfileDeepNesting(t *testing.T) {
-	// Schema with 3 levels of object nesting (exceeds default max of 2)
-	schema := `{
-		"type": "object",
-		"properties": {
-			"level1": {
-				"type": "object",
-				"properties": {
-					"level2": {
-						"type": "object",
-						"properties": {
-							"level3": {
-								"type": "object",
-								"properties": {
-									"value": { "type": "string" }
-								}
-							}
-						}
-					}
-				}
-			}
-		}
-	}`
-
-	config := DefaultConfig()
-	config.Profile = ProfileNavigable
-	l := New(config)
-
-	result, err := l.Lint([]byte(schema))
-	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
+	if result.ErrorCount() != 2 {
+		t.Errorf("Expected 2 errors, got %d", result.ErrorCount())
 	}
-
-	// Should have deep nesting error
-	found := false
-	for _, issue := range result.Issues {
-		if issue.Code == CodeDeepNesting {
-			found = true
-			break
-		}
+	if result.WarningCount() != 1 {
+		t.Errorf("Expected 1 warning, got %d", result.WarningCount())
 	}
-	if !found {
-		t.Errorf("Expected deep-nesting error for schema with 3 levels of nesting")
+	if !result.HasErrors() {
+		t.Error("Expected HasErrors to be true")
 	}
 }
 
-func TestNavigableProfileValidSchema(t *testing.T) {
-	// Schema with 2 levels of nesting (should pass)
-	schema := `{
+func TestParseSchemaBigIntegers(t *testing.T) {
+	schema, err := ParseSchema([]byte(`{
 		"type": "object",
 		"properties": {
-			"timeline": {
-				"type": "array",
-				"items": {
-					"type": "object",
-					"properties": {
-						"event_id": { "type": "string" },
-						"description": { "type": "string" }
-					}
-				}
-			}
+			"id": {"type": "integer", "const": 9007199254740993, "default": 9223372036854775807},
+			"ratio": {"type": "number", "enum": [0.5, 1e3]}
 		}
-	}`
-
-	config := DefaultConfig()
-	config.Profile = ProfileNavigable
-	config.PropertyCase = CaseSnake
-	l := New(config)
-
-	result, err := l.Lint([]byte(schema))
+	}`))
 	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
+		t.Fatalf("Failed to parse: %v", err)
 	}
-
-	if result.HasErrors() {
-		t.Errorf("Expected no errors for valid navigable schema, got: %v", result.Issues)
+	id := schema.Properties["id"]
+	if got := fmt.Sprint(id.Const); got != "9007199254740993" {
+		t.Errorf("Expected const 9007199254740993, got %s", got)
 	}
-}
-
-func TestNavigableProfileMissingID(t *testing.T) {
-	// Array of objects without ID field
-	schema := `{
-		"type": "object",
-		"properties": {
-			"items": {
-				"type": "array",
-				"items": {
-					"type": "object",
-					"properties": {
-						"name": { "type": "string" },
-						"value": { "type": "number" }
-					}
-				}
-			}
-		}
-	}`
-
-	config := DefaultConfig()
-	config.Profile = ProfileNavigable
-	l := New(config)
-
-	result, err := l.Lint([]byte(schema))
-	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
+	if got := fmt.Sprint(id.Default); got != "9223372036854775807" {
+		t.Errorf("Expected default 9223372036854775807, got %s", got)
 	}
-
-	// Should have missing ID warning
-	found := false
-	for _, issue := range result.Issues {
-		if issue.Code == CodeMissingID {
-			found = true
-			break
-		}
+	if kind := constKind(id.Const); kind != "integer" {
+		t.Errorf("Expected a big const to be an integer, got %q", kind)
 	}
-	if !found {
-		t.Errorf("Expected missing-id-field warning for array items without ID")
+	ratio := schema.Properties["ratio"]
+	if kinds := []string{constKind(ratio.Enum[0]), constKind(ratio.Enum[1])}; kinds[0] != "number" || kinds[1] != "integer" {
+		t.Errorf("Expected enum kinds [number integer], got %v", kinds)
 	}
 }
 
-func TestNavigableProfileWithIDField(t *testing.T) {
-	// Array of objects with ID field (should not warn)
-	schema := `{
-		"type": "object",
-		"properties": {
-			"events": {
-				"type": "array",
-				"items": {
-					"type": "object",
-					"properties": {
-						"event_id": { "type": "string" },
-						"description": { "type": "string" }
-					}
-				}
-			}
+func TestParseSchemaNull(t *testing.T) {
+	for _, tt := range []struct {
+		data string
+		want string
+	}{
+		{`null`, "null is not a valid schema"},
+		{`{"oneOf": [null]}`, "oneOf/0: null is not a valid schema"},
+		{`{"properties": {"a/b": null}}`, "properties/a~1b: null is not a valid schema"},
+		{`{"items": null}`, "items: null is not a valid schema"},
+		{`{"items": [{"type": "string"}, null]}`, "items/1: null is not a valid schema"},
+		{`{"$defs": {"Pet": {"anyOf": [{"type": "string"}, null]}}}`, "anyOf/1: null is not a valid schema"},
+	} {
+		_, err := ParseSchema([]byte(tt.data))
+		if !errors.Is(err, ErrParse) || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseSchema(%s) error = %v, want a parse error containing %q", tt.data, err, tt.want)
 		}
-	}`
-
-	config := DefaultConfig()
-	config.Profile = ProfileNavigable
-	config.PropertyCase = CaseSnake
-	l := New(config)
+	}
 
-	result, err := l.Lint([]byte(schema))
+	schema, err := ParseSchema([]byte(`{"oneOf": [true, false]}`))
 	if err != nil {
-		t.Fatalf("Failed to lint: %v", err)
+		t.Fatalf("Failed to parse boolean subschemas: %v", err)
 	}
-
-	// Should not have missing ID warning
-	for _, issue := range result.Issues {
-		if issue.Code == CodeMissingID {
-			t.Errorf("Did not expect missing-id-field warning when event_id is present")
-		}
+	if !schema.OneOf[0].IsBooleanSchema || !schema.OneOf[0].BooleanValue || schema.OneOf[1].BooleanValue {
+		t.Errorf("Expected the boolean schemas true and false, got %+v", schema.OneOf)
 	}
 }
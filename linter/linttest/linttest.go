@@ -0,0 +1,214 @@
+// Package linttest provides helpers for testing lint rules: schema fixture
+// builders, single-rule runners, and assertions on issue codes and paths.
+//
+// A typical table-driven rule test:
+//
+//	linttest.Run(t, []linttest.Case{{
+//		Name:   "union without discriminator",
+//		Schema: linttest.Defs(linttest.S{"Pet": linttest.AnyOf(linttest.Object(nil), linttest.Object(nil))}),
+//		Want:   []linttest.Want{{Code: linter.CodeUnionNoDiscriminator, Path: "$/$defs/Pet/anyOf"}},
+//	}})
+package linttest
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// S is a schema fixture: a JSON object built from Go maps.
+type S map[string]any
+
+// With returns a copy of the schema with the keyword set to value.
+func (s S) With(keyword string, value any) S {
+	out := make(S, len(s)+1)
+	for k, v := range s {
+		out[k] = v
+	}
+	out[keyword] = value
+	return out
+}
+
+// JSON returns the schema encoded as JSON.
+func (s S) JSON() []byte {
+	data, err := json.Marshal(s)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+// Type returns a schema with only a type keyword.
+func Type(t string) S { return S{"type": t} }
+
+// String returns a string schema.
+func String() S { return Type("string") }
+
+// Integer returns an integer schema.
+func Integer() S { return Type("integer") }
+
+// Const returns a schema with a const value.
+func Const(v any) S { return S{"const": v} }
+
+// Ref returns a $ref to a $defs entry.
+func Ref(def string) S { return S{"$ref": "#/$defs/" + def} }
+
+// Object returns an object schema with the given properties.
+func Object(props S) S {
+	s := S{"type": "object"}
+	if props != nil {
+		s["properties"] = props
+	}
+	return s
+}
+
+// Array returns an array schema with the given items schema.
+func Array(items S) S { return S{"type": "array", "items": items} }
+
+// AnyOf returns an anyOf union of the variants.
+func AnyOf(variants ...S) S { return S{"anyOf": variants} }
+
+// OneOf returns a oneOf union of the variants.
+func OneOf(variants ...S) S { return S{"oneOf": variants} }
+
+// AllOf returns an allOf composition of the schemas.
+func AllOf(schemas ...S) S { return S{"allOf": schemas} }
+
+// Defs returns a document with the given $defs.
+func Defs(defs S) S { return S{"$defs": defs} }
+
+// Variant returns an object union variant discriminated by field = value,
+// with optional extra properties.
+func Variant(field, value string, props S) S {
+	all := S{field: Const(value)}
+	for k, v := range props {
+		all[k] = v
+	}
+	return Object(all)
+}
+
+// Want is an expected issue. Empty Path or Severity match any value.
+type Want struct {
+	Code     linter.IssueCode
+	Path     string
+	Severity linter.Severity
+}
+
+func (w Want) matches(issue linter.Issue) bool {
+	return issue.Code == w.Code &&
+		(w.Path == "" || issue.Path.String() == w.Path) &&
+		(w.Severity == "" || issue.Severity == w.Severity)
+}
+
+// Case is a table-driven lint test case.
+type Case struct {
+	Name string
+	// Schema is a JSON string, []byte, or S fixture.
+	Schema any
+	// Config is the linter configuration (default: linter.DefaultConfig()).
+	Config *linter.Config
+	// Rule, if set, runs only the rule reporting this code; see
+	// linter.Config.OnlyRules.
+	Rule linter.IssueCode
+	// Want are issues that must be reported.
+	Want []Want
+	// NotWant are issue codes that must not be reported.
+	NotWant []linter.IssueCode
+	// NoErrors requires that no error-severity issues are reported.
+	NoErrors bool
+	// NoIssues requires that no issues at all are reported.
+	NoIssues bool
+}
+
+// Run runs table-driven lint test cases as subtests.
+func Run(t *testing.T, cases []Case) {
+	t.Helper()
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			config := linter.DefaultConfig()
+			if tc.Config != nil {
+				config = *tc.Config
+			}
+			if tc.Rule != "" {
+				config.OnlyRules = []linter.IssueCode{tc.Rule}
+			}
+			result := Lint(t, tc.Schema, config)
+			for _, want := range tc.Want {
+				ExpectIssue(t, result, want)
+			}
+			for _, code := range tc.NotWant {
+				ExpectNoIssue(t, result, code)
+			}
+			if tc.NoErrors && result.HasErrors() {
+				t.Errorf("expected no errors, got: %v", result.Issues)
+			}
+			if tc.NoIssues && len(result.Issues) != 0 {
+				t.Errorf("expected no issues, got %d: %v", len(result.Issues), result.Issues)
+			}
+		})
+	}
+}
+
+// Lint lints the schema fixture and fails the test on error.
+func Lint(t testing.TB, schema any, config linter.Config) *linter.Result {
+	t.Helper()
+	result, err := linter.New(config).Lint(fixtureBytes(t, schema))
+	if err != nil {
+		t.Fatalf("failed to lint: %v", err)
+	}
+	return result
+}
+
+// RunRule lints the schema running only the rule reporting code, with
+// the other settings of config, and returns its issues.
+func RunRule(t testing.TB, code linter.IssueCode, schema any, config linter.Config) []linter.Issue {
+	t.Helper()
+	config.OnlyRules = []linter.IssueCode{code}
+	return Lint(t, schema, config).Issues
+}
+
+// RunCustomRule runs a single custom rule over the schema, without the
+// built-in rules, and returns the issues it reports.
+func RunCustomRule(t testing.TB, rule linter.Rule, schema any) []linter.Issue {
+	t.Helper()
+	config := linter.DefaultConfig()
+	config.Rules = []linter.Rule{rule}
+	return RunRule(t, rule.Info().Code, schema, config)
+}
+
+// ExpectIssue fails the test unless the result contains a matching issue.
+func ExpectIssue(t testing.TB, result *linter.Result, want Want) {
+	t.Helper()
+	for _, issue := range result.Issues {
+		if want.matches(issue) {
+			return
+		}
+	}
+	t.Errorf("expected %s issue (path %q, severity %q), got: %v", want.Code, want.Path, want.Severity, result.Issues)
+}
+
+// ExpectNoIssue fails the test if the result contains an issue with the code.
+func ExpectNoIssue(t testing.TB, result *linter.Result, code linter.IssueCode) {
+	t.Helper()
+	for _, issue := range result.Issues {
+		if issue.Code == code {
+			t.Errorf("did not expect %s issue, got: %v", code, issue)
+		}
+	}
+}
+
+func fixtureBytes(t testing.TB, schema any) []byte {
+	t.Helper()
+	switch s := schema.(type) {
+	case string:
+		return []byte(s)
+	case []byte:
+		return s
+	case S:
+		return s.JSON()
+	default:
+		t.Fatalf("unsupported schema fixture type %T", schema)
+		return nil
+	}
+}
@@ -0,0 +1,54 @@
+package linttest
+
+import (
+	"testing"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+func TestRunCustomRule(t *testing.T) {
+	rule := linter.NewRule(
+		linter.RuleInfo{Code: "missing-title", Severity: linter.SeverityWarning},
+		func(schema *linter.Schema, path linter.Path) []linter.Issue {
+			if schema.Type == "object" && schema.Title == "" {
+				return []linter.Issue{{Code: "missing-title", Severity: linter.SeverityWarning, Path: path}}
+			}
+			return nil
+		},
+	)
+
+	issues := RunCustomRule(t, rule, Defs(S{"Pet": Object(S{"name": String()})}))
+	if len(issues) != 1 || issues[0].Path.String() != "$/$defs/Pet" {
+		t.Errorf("Expected one missing-title issue at $/$defs/Pet, got: %v", issues)
+	}
+}
+
+func TestRunRule(t *testing.T) {
+	issues := RunRule(t, linter.CodeInvalidPropertyCase,
+		Object(S{"first_name": String(), "lastName": String()}), linter.DefaultConfig())
+	if len(issues) != 1 || issues[0].Path.String() != "$/properties/first_name" {
+		t.Errorf("Expected one invalid-property-case issue, got: %v", issues)
+	}
+}
+
+func TestRunCase(t *testing.T) {
+	Run(t, []Case{{
+		Name:     "only the selected rule",
+		Schema:   Object(S{"first_name": String(), "created_at": String()}),
+		Rule:     linter.CodeStringlyTypedTimestamp,
+		Want:     []Want{{Code: linter.CodeStringlyTypedTimestamp, Path: "$/properties/created_at"}},
+		NotWant:  []linter.IssueCode{linter.CodeInvalidPropertyCase},
+		NoErrors: true,
+	}})
+}
+
+func TestWith(t *testing.T) {
+	base := String()
+	withFormat := base.With("format", "email")
+	if _, ok := base["format"]; ok {
+		t.Error("With should not modify the receiver")
+	}
+	if got := string(withFormat.JSON()); got != `{"format":"email","type":"string"}` {
+		t.Errorf("Unexpected JSON: %s", got)
+	}
+}
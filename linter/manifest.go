@@ -0,0 +1,221 @@
+package linter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/url"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Manifest lists schema locations to lint together, such as schemas
+// scattered across repositories and schema registries.
+type Manifest struct {
+	Sources []Source `json:"sources"`
+	// Dir is the slash-separated directory, within the file system passed
+	// to Crawl, that relative source paths are resolved against (default:
+	// its root). It is usually the directory of the manifest file.
+	Dir string `json:"-"`
+}
+
+// FetchFunc reads the schema of a URL or registry source of a manifest.
+// For a registry source, it returns the schema of the subject version,
+// not the registry response.
+type FetchFunc func(ctx context.Context, s Source) ([]byte, error)
+
+// Source is a schema location in a manifest. Exactly one of Path, URL, or
+// Registry (with Subject) is set.
+type Source struct {
+	// Name identifies the source in results (default: its location).
+	Name string `json:"name,omitempty"`
+	// Path is a schema file or glob pattern (e.g., "schemas/*.json");
+	// each matching file is a separate result.
+	Path string `json:"path,omitempty"`
+	// URL is fetched with an HTTP GET.
+	URL string `json:"url,omitempty"`
+	// Registry is the base URL of a Confluent-compatible schema registry,
+	// from which the schema of Subject is fetched.
+	Registry string `json:"registry,omitempty"`
+	Subject  string `json:"subject,omitempty"`
+	// Version is the subject version (default: latest).
+	Version string `json:"version,omitempty"`
+}
+
+// ParseManifest reads a JSON manifest and validates it. Dir is left
+// unset; callers reading the manifest from a file set it to the file's
+// directory.
+func ParseManifest(r io.Reader) (*Manifest, error) {
+	var m Manifest
+	if err := json.NewDecoder(r).Decode(&m); err != nil {
+		return nil, fmt.Errorf("failed to parse manifest: %w", err)
+	}
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid manifest: %w", err)
+	}
+	return &m, nil
+}
+
+// Validate returns an error if a source does not set exactly one location.
+func (m *Manifest) Validate() error {
+	if len(m.Sources) == 0 {
+		return errors.New("manifest lists no sources")
+	}
+	for i, s := range m.Sources {
+		n := 0
+		for _, set := range []bool{s.Path != "", s.URL != "", s.Registry != ""} {
+			if set {
+				n++
+			}
+		}
+		if n != 1 {
+			return fmt.Errorf("source %d must set exactly one of path, url, or registry", i)
+		}
+		if s.Registry != "" && s.Subject == "" {
+			return fmt.Errorf("source %d sets a registry without a subject", i)
+		}
+	}
+	return nil
+}
+
+// Location returns the path, URL, or registry subject of the source.
+func (s Source) Location() string {
+	switch {
+	case s.Path != "":
+		return s.Path
+	case s.URL != "":
+		return s.URL
+	default:
+		return s.RegistryURL()
+	}
+}
+
+// RegistryURL returns the registry endpoint of the subject version.
+func (s Source) RegistryURL() string {
+	version := s.Version
+	if version == "" {
+		version = "latest"
+	}
+	return fmt.Sprintf("%s/subjects/%s/versions/%s",
+		strings.TrimSuffix(s.Registry, "/"), url.PathEscape(s.Subject), url.PathEscape(version))
+}
+
+// Crawl lints every source of the manifest, one result per schema, in
+// manifest order. Path sources are read from fsys, relative to Dir, and
+// URL and registry sources with fetch. It only reads: files are not
+// modified and registries are only queried. A source that cannot be read
+// or parsed is reported as a source-unreadable error in its result, so
+// one unavailable source does not stop the run. File and registry schemas
+// are checked against IDTemplate, and root $ids declared by two schemas
+// are reported.
+func (l *Linter) Crawl(ctx context.Context, m *Manifest, fsys fs.FS, fetch FetchFunc) []Result {
+	var results []Result
+	for _, s := range m.Sources {
+		if s.Path != "" {
+			results = append(results, l.crawlFiles(fsys, m.Dir, s)...)
+			continue
+		}
+		name := s.Name
+		if name == "" {
+			name = s.Location()
+		}
+		var data []byte
+		err := errors.New("no fetcher for URL and registry sources")
+		if fetch != nil {
+			data, err = fetch(ctx, s)
+		}
+		results = append(results, l.crawlResult(name, IDLocation{Subject: s.Subject}, data, err))
+	}
+
+	crawled := make([]*Result, len(results))
+	for i := range results {
+		crawled[i] = &results[i]
+	}
+	l.CheckDuplicateIDs(crawled)
+	return results
+}
+
+// crawlFiles lints the files of fsys matching a path source. Files are
+// named by their path relative to dir, and files of an absolute pattern by
+// their absolute path.
+func (l *Linter) crawlFiles(fsys fs.FS, dir string, s Source) []Result {
+	abs := path.IsAbs(s.Path)
+	pattern := strings.TrimPrefix(s.Path, "/")
+	if !abs {
+		pattern = path.Join(dir, s.Path)
+	}
+	var matches []string
+	err := errors.New("no file system to read path sources from")
+	if fsys != nil {
+		matches, err = fs.Glob(fsys, pattern)
+	}
+	if err == nil && len(matches) == 0 {
+		err = fmt.Errorf("no files match %s", s.Path)
+	}
+	if err != nil {
+		name := s.Name
+		if name == "" {
+			name = s.Path
+		}
+		return []Result{l.crawlResult(name, IDLocation{}, nil, err)}
+	}
+
+	results := make([]Result, 0, len(matches))
+	for _, match := range matches {
+		// {dir} in IDTemplate is relative to the manifest
+		rel := relPath(dir, match)
+		file := rel
+		if abs {
+			file = "/" + match
+		}
+		name := file
+		if s.Name != "" && len(matches) == 1 {
+			name = s.Name
+		} else if s.Name != "" {
+			name = s.Name + ":" + file
+		}
+		data, err := fs.ReadFile(fsys, match)
+		results = append(results, l.crawlResult(name, IDLocation{Path: rel}, data, err))
+	}
+	return results
+}
+
+// relPath returns the slash-separated path of target relative to base, or
+// target if it has none.
+func relPath(base, target string) string {
+	rel, err := filepath.Rel(filepath.FromSlash(path.Clean(base)), filepath.FromSlash(target))
+	if err != nil {
+		return target
+	}
+	return filepath.ToSlash(rel)
+}
+
+// crawlResult lints data read from a source, or reports why it could not
+// be read.
+func (l *Linter) crawlResult(name string, loc IDLocation, data []byte, err error) Result {
+	if err == nil {
+		var result *Result
+		if result, err = l.Lint(data); err == nil {
+			result.SchemaPath = name
+			l.CheckIDLocation(result, loc)
+			if loc.Path != "" {
+				l.ApplyPathClass(result, loc.Path)
+			}
+			return *result
+		}
+	}
+	return Result{
+		SchemaPath: name,
+		Issues: []Issue{{
+			Code:       CodeSourceUnreadable,
+			Severity:   SeverityError,
+			Path:       rootPath,
+			Message:    err.Error(),
+			Suggestion: "Check that the source location in the manifest is correct and reachable",
+		}},
+	}
+}
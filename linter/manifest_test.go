@@ -0,0 +1,109 @@
+package linter
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"testing/fstest"
+)
+
+func TestCrawl(t *testing.T) {
+	fsys := fstest.MapFS{
+		"repo/schemas/a.json":  {Data: []byte(`{"type": "object", "properties": {"userName": {"type": "string"}}}`)},
+		"repo/schemas/b.json":  {Data: []byte(`{"type": "object", "properties": {"user_name": {"type": "string"}}}`)},
+		"other/orders.json":    {Data: []byte(`{"type": "string"}`)},
+		"repo/schemas/bad.txt": {Data: []byte(`not json`)},
+	}
+	sources := map[string]string{
+		"https://example.com/billing.json":                             `{"type": "string"}`,
+		"http://registry:8081/subjects/payments-value/versions/latest": `{"type": "object", "properties": {"amount_due": {"type": "number"}}}`,
+	}
+	fetch := func(_ context.Context, s Source) ([]byte, error) {
+		if data, ok := sources[s.Location()]; ok {
+			return []byte(data), nil
+		}
+		return nil, errors.New("not found")
+	}
+
+	m, err := ParseManifest(strings.NewReader(`{"sources": [
+		{"path": "*.json"},
+		{"name": "orders", "path": "../../other/*.json"},
+		{"path": "/other/orders.json"},
+		{"name": "billing", "url": "https://example.com/billing.json"},
+		{"name": "payments", "registry": "http://registry:8081", "subject": "payments-value"},
+		{"name": "missing", "url": "https://example.com/missing.json"},
+		{"name": "unmatched", "path": "none/*.json"},
+		{"path": "bad.txt"}
+	]}`))
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	m.Dir = "repo/schemas"
+
+	results := NewWithDefaults().Crawl(context.Background(), m, fsys, fetch)
+	want := []struct {
+		name string
+		code IssueCode
+	}{
+		{"a.json", ""},
+		{"b.json", CodeInvalidPropertyCase},
+		{"orders", ""},
+		{"/other/orders.json", ""},
+		{"billing", ""},
+		{"payments", CodeInvalidPropertyCase},
+		{"missing", CodeSourceUnreadable},
+		{"unmatched", CodeSourceUnreadable},
+		{"bad.txt", CodeSourceUnreadable},
+	}
+	if len(results) != len(want) {
+		t.Fatalf("Expected %d results, got %d: %+v", len(want), len(results), results)
+	}
+	for i, w := range want {
+		r := results[i]
+		if r.SchemaPath != w.name {
+			t.Errorf("Result %d: expected schema path %s, got %s", i, w.name, r.SchemaPath)
+		}
+		if w.code == "" {
+			if len(r.Issues) != 0 {
+				t.Errorf("%s: expected no issues, got %v", w.name, r.Issues)
+			}
+		} else if len(r.Issues) != 1 || r.Issues[0].Code != w.code {
+			t.Errorf("%s: expected one %s issue, got %v", w.name, w.code, r.Issues)
+		}
+	}
+}
+
+func TestCrawlWithoutReaders(t *testing.T) {
+	m := &Manifest{Sources: []Source{{Path: "a.json"}, {URL: "https://example.com/a.json"}}}
+	for _, r := range NewWithDefaults().Crawl(context.Background(), m, nil, nil) {
+		if len(r.Issues) != 1 || r.Issues[0].Code != CodeSourceUnreadable {
+			t.Errorf("%s: expected one %s issue, got %v", r.SchemaPath, CodeSourceUnreadable, r.Issues)
+		}
+	}
+}
+
+func TestParseManifest(t *testing.T) {
+	for _, data := range []string{
+		`{"sources": [`,
+		`{"sources": []}`,
+		`{"sources": [{"path": "a.json", "url": "https://example.com/a.json"}]}`,
+	} {
+		if _, err := ParseManifest(strings.NewReader(data)); err == nil {
+			t.Errorf("Expected error for manifest %s", data)
+		}
+	}
+}
+
+func TestManifestValidate(t *testing.T) {
+	for _, m := range []Manifest{
+		{},
+		{Sources: []Source{{}}},
+		{Sources: []Source{{Path: "a.json", URL: "https://example.com/a.json"}}},
+		{Sources: []Source{{Registry: "http://registry:8081"}}},
+	} {
+		if err := m.Validate(); err == nil {
+			t.Errorf("Expected error for manifest %+v", m)
+		}
+	}
+}
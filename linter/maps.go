@@ -0,0 +1,65 @@
+package linter
+
+import "fmt"
+
+// lintMap checks a map-like schema, one with a schema for
+// additionalProperties and no fixed properties: its keys should be
+// constrained by propertyNames, and its values should not be a union
+// without a discriminator, which every value must be trial-decoded as.
+func (l *Linter) lintMap(schema *Schema, path Path, result *Result) {
+	if !constrainsKeys(schema.PropertyNames) {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeUnconstrainedMapKeys,
+			Severity:   SeverityWarning,
+			Path:       path,
+			Message:    "Map has no propertyNames constraint, so any string is a valid key",
+			Suggestion: "Describe the keys with propertyNames, e.g., {\"pattern\": \"^[a-z][a-z0-9_]*$\"}, a format, or an enum",
+		})
+	}
+
+	value := schema.AdditionalPropertiesSchema
+	if value.IsRef() && result.doc != nil {
+		if target, _, ok := l.resolveRef(result.doc, result.root, value.Ref); ok && target != nil {
+			value = target
+		}
+	}
+	variants, unionType := value.AnyOf, "anyOf"
+	if len(variants) == 0 {
+		variants, unionType = value.OneOf, "oneOf"
+	}
+	if len(variants) < 2 || l.isNullablePattern(variants) || l.isReferencePattern(variants) {
+		return
+	}
+	if l.baseDiscriminator(result.doc, result.root, variants) != nil {
+		return
+	}
+	resolved := make([]*Schema, len(variants))
+	for i, v := range variants {
+		resolved[i] = v
+		if v != nil && v.IsRef() && result.doc != nil {
+			if target, _, ok := l.resolveRef(result.doc, result.root, v.Ref); ok && target != nil {
+				resolved[i] = target
+			}
+		}
+	}
+	if l.findDiscriminator(resolved) != nil {
+		return
+	}
+	result.Issues = append(result.Issues, Issue{
+		Code:       CodeMapOfUnion,
+		Severity:   SeverityWarning,
+		Path:       path.Child("additionalProperties"),
+		Message:    fmt.Sprintf("The %s union of the map values has no discriminator, so each value must be trial-decoded", unionType),
+		Suggestion: "Add a const discriminator property to each variant, or use a single value type",
+	})
+}
+
+// constrainsKeys reports whether a propertyNames schema restricts the keys
+// of a map: with a pattern, a format, an enum or const, or a $ref to a key
+// type.
+func constrainsKeys(names *Schema) bool {
+	if names == nil || names.IsBooleanSchema {
+		return false
+	}
+	return names.Pattern != "" || names.Format != "" || len(names.Enum) > 0 || names.Const != nil || names.IsRef()
+}
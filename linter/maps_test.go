@@ -0,0 +1,76 @@
+package linter
+
+import "testing"
+
+func TestUnconstrainedMapKeys(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   int
+	}{
+		{"map without propertyNames", `{"type": "object", "additionalProperties": {"type": "string"}}`, 1},
+		{"propertyNames pattern", `{"type": "object", "additionalProperties": {"type": "string"}, "propertyNames": {"pattern": "^[a-z]+$"}}`, 0},
+		{"propertyNames format", `{"type": "object", "additionalProperties": {"type": "string"}, "propertyNames": {"format": "uuid"}}`, 0},
+		{"propertyNames without constraint", `{"type": "object", "additionalProperties": {"type": "string"}, "propertyNames": {"type": "string"}}`, 1},
+		{"fixed properties", `{"type": "object", "properties": {"id": {"type": "string"}}, "additionalProperties": {"type": "string"}}`, 0},
+		{"closed object", `{"type": "object", "additionalProperties": false}`, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := codeIssues(t, DefaultConfig(), tt.schema, CodeUnconstrainedMapKeys); len(got) != tt.want {
+				t.Errorf("Expected %d issues, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestMapOfUnion(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   int
+	}{
+		{"union without discriminator", `{
+			"type": "object",
+			"additionalProperties": {"oneOf": [
+				{"type": "object", "properties": {"a": {"type": "string"}}},
+				{"type": "object", "properties": {"b": {"type": "string"}}}
+			]}
+		}`, 1},
+		{"union with discriminator", `{
+			"type": "object",
+			"additionalProperties": {"oneOf": [
+				{"type": "object", "properties": {"type": {"const": "a"}}},
+				{"type": "object", "properties": {"type": {"const": "b"}}}
+			]}
+		}`, 0},
+		{"referenced union of referenced variants", `{
+			"type": "object",
+			"additionalProperties": {"$ref": "#/$defs/Value"},
+			"$defs": {
+				"Value": {"anyOf": [{"$ref": "#/$defs/A"}, {"$ref": "#/$defs/B"}]},
+				"A": {"type": "object", "properties": {"kind": {"const": "a"}}},
+				"B": {"type": "object", "properties": {"kind": {"const": "b"}}}
+			}
+		}`, 0},
+		{"referenced union without discriminator", `{
+			"type": "object",
+			"additionalProperties": {"$ref": "#/$defs/Value"},
+			"$defs": {
+				"Value": {"anyOf": [{"type": "string"}, {"type": "integer"}]}
+			}
+		}`, 1},
+		{"nullable values", `{"type": "object", "additionalProperties": {"anyOf": [{"type": "string"}, {"type": "null"}]}}`, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := codeIssues(t, DefaultConfig(), tt.schema, CodeMapOfUnion)
+			if len(got) != tt.want {
+				t.Fatalf("Expected %d issues, got %v", tt.want, got)
+			}
+			if tt.want > 0 && got[0].Path.String() != "$/additionalProperties" {
+				t.Errorf("Expected the issue at $/additionalProperties, got %s", got[0].Path)
+			}
+		})
+	}
+}
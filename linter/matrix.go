@@ -0,0 +1,203 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Environment is one deployed version of a schema (e.g., the one in
+// production), by label.
+type Environment struct {
+	Label  string
+	Schema *Schema
+}
+
+// MatrixRow is a definition or property that differs across environments.
+type MatrixRow struct {
+	Path string `json:"path"`
+	// Cells describe the path in each environment, in label order: a
+	// definition is "defined", a property its type and constraints (e.g.,
+	// "string, format: email, required"), and a missing one is "". A
+	// property in an environment without its definition is "-".
+	Cells []string `json:"cells"`
+}
+
+// Matrix is the difference between several versions of the same schema.
+type Matrix struct {
+	Labels []string `json:"labels"`
+	// Missing are definitions that some environments do not define.
+	Missing []MatrixRow `json:"missing"`
+	// Drift are properties declared differently or missing in some of the
+	// environments that define their object.
+	Drift []MatrixRow `json:"drift"`
+}
+
+// CompareEnvironments compares the definitions of several versions of a
+// schema and the properties of their objects, including the root. A
+// property missing from a definition that some environments do not define
+// is not drift; the definition is reported as missing instead.
+func CompareEnvironments(envs []Environment) Matrix {
+	m := Matrix{Labels: make([]string, len(envs)), Missing: []MatrixRow{}, Drift: []MatrixRow{}}
+	for i, env := range envs {
+		m.Labels[i] = env.Label
+	}
+
+	defs := make([]map[string]*Schema, len(envs))
+	var paths []string
+	for i, env := range envs {
+		defs[i] = environmentDefinitions(env.Schema)
+		for _, path := range sortedKeys(defs[i]) {
+			if !slices.Contains(paths, path) {
+				paths = append(paths, path)
+			}
+		}
+	}
+	slices.Sort(paths)
+
+	for _, path := range paths {
+		row := MatrixRow{Path: path, Cells: make([]string, len(envs))}
+		var present []int
+		for i := range envs {
+			if defs[i][path] != nil {
+				row.Cells[i] = "defined"
+				present = append(present, i)
+			}
+		}
+		if len(present) < len(envs) {
+			m.Missing = append(m.Missing, row)
+		}
+
+		var names []string
+		for _, i := range present {
+			for _, name := range sortedKeys(defs[i][path].Properties) {
+				if !slices.Contains(names, name) {
+					names = append(names, name)
+				}
+			}
+		}
+		slices.Sort(names)
+		for _, name := range names {
+			row := MatrixRow{Path: path + "/properties/" + escapePointer(name), Cells: make([]string, len(envs))}
+			for i := range envs {
+				row.Cells[i] = "-"
+			}
+			drift := false
+			for _, i := range present {
+				row.Cells[i] = describeField(defs[i][path], name)
+				drift = drift || row.Cells[i] != row.Cells[present[0]]
+			}
+			if drift {
+				m.Drift = append(m.Drift, row)
+			}
+		}
+	}
+	return m
+}
+
+// environmentDefinitions returns the root and definitions of a schema by
+// path (e.g., "$", "$/$defs/User").
+func environmentDefinitions(schema *Schema) map[string]*Schema {
+	defs := make(map[string]*Schema)
+	if schema == nil {
+		return defs
+	}
+	defs["$"] = schema
+	for name, def := range schema.Defs {
+		if def != nil {
+			defs["$/$defs/"+name] = def
+		}
+	}
+	for name, def := range schema.Definitions {
+		if def != nil {
+			defs["$/definitions/"+name] = def
+		}
+	}
+	return defs
+}
+
+// describeField describes a property of an object by its type, format,
+// enum, requiredness, and nullability, or returns "" if the object does
+// not declare it. A $ref is described by its target.
+func describeField(s *Schema, name string) string {
+	p, ok := s.Properties[name]
+	if !ok {
+		return ""
+	}
+	var parts []string
+	switch {
+	case p == nil:
+		parts = append(parts, "any")
+	case p.IsRef():
+		parts = append(parts, "$ref "+p.Ref)
+	default:
+		kind := schemaKind(p)
+		if kind == "" {
+			kind = "any"
+		}
+		parts = append(parts, kind)
+		if p.Format != "" {
+			parts = append(parts, "format: "+p.Format)
+		}
+		if len(p.Enum) > 0 {
+			values := make([]string, len(p.Enum))
+			for i, v := range p.Enum {
+				values[i] = fmt.Sprint(v)
+			}
+			parts = append(parts, "enum: "+strings.Join(values, "|"))
+		}
+	}
+	if slices.Contains(s.Required, name) {
+		parts = append(parts, "required")
+	}
+	if p != nil && slices.Contains(p.TypeList, "null") {
+		parts = append(parts, "nullable")
+	}
+	return strings.Join(parts, ", ")
+}
+
+// HasDifferences reports whether the environments differ.
+func (m Matrix) HasDifferences() bool {
+	return len(m.Missing) > 0 || len(m.Drift) > 0
+}
+
+// JSON returns the matrix as JSON.
+func (m Matrix) JSON() ([]byte, error) {
+	return json.MarshalIndent(m, "", "  ")
+}
+
+// String returns the matrix as Markdown tables of missing definitions and
+// property drift, with a column per environment.
+func (m Matrix) String() string {
+	var sb strings.Builder
+	sections := []struct {
+		title string
+		rows  []MatrixRow
+	}{
+		{"Missing definitions", m.Missing},
+		{"Property drift", m.Drift},
+	}
+	for _, section := range sections {
+		if len(section.rows) == 0 {
+			continue
+		}
+		fmt.Fprintf(&sb, "## %s (%d)\n\n", section.title, len(section.rows))
+		fmt.Fprintf(&sb, "| Path | %s |\n", strings.Join(m.Labels, " | "))
+		fmt.Fprintf(&sb, "|------|%s\n", strings.Repeat("------|", len(m.Labels)))
+		for _, row := range section.rows {
+			cells := make([]string, len(row.Cells))
+			for i, cell := range row.Cells {
+				if cell == "" {
+					cell = "missing"
+				}
+				cells[i] = strings.ReplaceAll(cell, "|", `\|`)
+			}
+			fmt.Fprintf(&sb, "| %s | %s |\n", strings.ReplaceAll(row.Path, "|", `\|`), strings.Join(cells, " | "))
+		}
+		sb.WriteString("\n")
+	}
+	fmt.Fprintf(&sb, "Summary: %d missing definition(s), %d property difference(s) across %s\n",
+		len(m.Missing), len(m.Drift), strings.Join(m.Labels, ", "))
+	return sb.String()
+}
@@ -0,0 +1,52 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompareEnvironments(t *testing.T) {
+	parse := func(s string) *Schema {
+		schema, err := ParseSchema([]byte(s))
+		if err != nil {
+			t.Fatalf("Failed to parse: %v", err)
+		}
+		return schema
+	}
+	prod := parse(`{"$defs": {
+		"User": {"type": "object", "properties": {"id": {"type": "string"}, "email": {"type": "string"}}, "required": ["id"]}
+	}}`)
+	staging := parse(`{"$defs": {
+		"User": {"type": "object", "properties": {"id": {"type": "string"}, "email": {"type": "string", "format": "email"}}, "required": ["id"]},
+		"Team": {"type": "object", "properties": {"name": {"type": "string"}}}
+	}}`)
+	dev := parse(`{"$defs": {
+		"User": {"type": "object", "properties": {"id": {"type": "integer"}}, "required": ["id"]},
+		"Team": {"type": "object", "properties": {"name": {"type": "string"}}}
+	}}`)
+
+	m := CompareEnvironments([]Environment{{"prod", prod}, {"staging", staging}, {"dev", dev}})
+	if len(m.Missing) != 1 || m.Missing[0].Path != "$/$defs/Team" ||
+		strings.Join(m.Missing[0].Cells, ",") != ",defined,defined" {
+		t.Errorf("Expected Team to be missing in prod, got %v", m.Missing)
+	}
+	want := []MatrixRow{
+		{Path: "$/$defs/User/properties/email", Cells: []string{"string", "string, format: email", ""}},
+		{Path: "$/$defs/User/properties/id", Cells: []string{"string, required", "string, required", "integer, required"}},
+	}
+	if len(m.Drift) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, m.Drift)
+	}
+	for i := range want {
+		if m.Drift[i].Path != want[i].Path || strings.Join(m.Drift[i].Cells, ";") != strings.Join(want[i].Cells, ";") {
+			t.Errorf("Expected %v, got %v", want[i], m.Drift[i])
+		}
+	}
+	if !strings.Contains(m.String(), "| $/$defs/User/properties/email | string | string, format: email | missing |") {
+		t.Errorf("Expected a drift table row, got:\n%s", m.String())
+	}
+
+	if m := CompareEnvironments([]Environment{{"prod", prod}, {"copy", prod}}); m.HasDifferences() {
+		t.Errorf("Expected no differences, got %v", m)
+	}
+}
@@ -0,0 +1,17 @@
+//go:build !(darwin || dragonfly || freebsd || linux || netbsd || openbsd)
+
+package linter
+
+import (
+	"fmt"
+	"os"
+)
+
+// mapFile reads the file at path, on platforms without memory mapping.
+func mapFile(path string) ([]byte, func() error, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read file: %w", err)
+	}
+	return data, func() error { return nil }, nil
+}
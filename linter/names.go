@@ -0,0 +1,53 @@
+package linter
+
+import (
+	"fmt"
+	"unicode"
+)
+
+// lintNonASCIIProperties flags property names with non-ASCII characters:
+// many code generators map property names to identifiers of languages that
+// only allow ASCII in them.
+func (l *Linter) lintNonASCIIProperties(schema *Schema, path Path, result *Result) {
+	for _, name := range sortedKeys(schema.Properties) {
+		if !isASCII(name) {
+			result.Issues = append(result.Issues, nonASCIIIssue("Property", name, path.Child("properties", name)))
+		}
+	}
+}
+
+// lintNonASCIIDefinitions flags definition names with non-ASCII
+// characters, which generators use as type names.
+func (l *Linter) lintNonASCIIDefinitions(schema *Schema, root Path, ignored map[string]bool, result *Result) {
+	check := func(defs map[string]*Schema, keyword string) {
+		for _, name := range sortedKeys(defs) {
+			if path := root.Child(keyword, name); !ignored[path.String()] && !isASCII(name) {
+				result.Issues = append(result.Issues, nonASCIIIssue("Definition", name, path))
+			}
+		}
+	}
+	check(schema.Defs, "$defs")
+	check(schema.Definitions, "definitions")
+}
+
+// nonASCIIIssue returns the non-ascii-name issue of a property or
+// definition name.
+func nonASCIIIssue(kind, name string, path Path) Issue {
+	return Issue{
+		Code:       CodeNonASCIIName,
+		Severity:   SeverityWarning,
+		Path:       path,
+		Message:    fmt.Sprintf("%s name '%s' has non-ASCII characters, which generators for languages such as Protobuf, Avro, and SQL reject in identifiers", kind, name),
+		Suggestion: "Rename it with ASCII characters, or set unicode_names to accept such names",
+	}
+}
+
+// isASCII reports whether s has only ASCII characters.
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r > unicode.MaxASCII {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,70 @@
+package linter
+
+import "testing"
+
+func TestNonASCIINames(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {"prénom": {"type": "string"}, "userName": {"type": "string"}},
+		"$defs": {"Benutzerkonto": {"type": "object"}, "Größe": {"type": "object"}}
+	}`)
+	codes := func(config Config) map[string]IssueCode {
+		result, err := New(config).Lint(schema)
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		found := make(map[string]IssueCode)
+		for _, issue := range result.Issues {
+			if issue.Code == CodeNonASCIIName || issue.Code == CodeInvalidPropertyCase {
+				found[issue.Path.String()] = issue.Code
+			}
+		}
+		return found
+	}
+
+	got := codes(DefaultConfig())
+	want := map[string]IssueCode{
+		"$/properties/prénom": CodeNonASCIIName,
+		"$/$defs/Größe":       CodeNonASCIIName,
+	}
+	if len(got) != len(want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	for path, code := range want {
+		if got[path] != code {
+			t.Errorf("Expected %s at %s, got %v", code, path, got)
+		}
+	}
+
+	config := DefaultConfig()
+	config.UnicodeNames = true
+	if got := codes(config); len(got) != 0 {
+		t.Errorf("Expected non-ASCII names to be accepted, got %v", got)
+	}
+	config.PropertyCase = CasePascal
+	if got := codes(config); got["$/properties/prénom"] != CodeInvalidPropertyCase {
+		t.Errorf("Expected the case of a non-ASCII name to be checked, got %v", got)
+	}
+}
+
+func TestUnicodeCase(t *testing.T) {
+	tests := []struct {
+		name       string
+		convention PropertyCase
+		want       bool
+	}{
+		{"prénomUtilisateur", CaseCamel, true},
+		{"Élément", CaseCamel, false},
+		{"Élément", CasePascal, true},
+		{"名前", CaseCamel, true},
+		{"名前", CasePascal, true},
+		{"größe_in_cm", CaseSnake, true},
+		{"Größe_in_cm", CaseSnake, false},
+		{"straße-name", CaseKebab, true},
+	}
+	for _, tt := range tests {
+		if got := matchesCase(tt.name, tt.convention); got != tt.want {
+			t.Errorf("matchesCase(%q, %s) = %v, want %v", tt.name, tt.convention, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,200 @@
+// Package notify delivers lint results after a run, such as to the chat
+// channel of the teams that own the schemas. It is separate from the
+// linter package so that the linter does not depend on net/http.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// Notifier delivers lint results after a run.
+type Notifier interface {
+	Notify(ctx context.Context, results []linter.Result) error
+}
+
+// Format is the payload format posted by a Webhook.
+type Format string
+
+const (
+	// FormatJSON posts a Summary as JSON.
+	FormatJSON Format = "json"
+	// FormatSlack posts a Slack message with blocks, for Slack incoming
+	// webhooks and compatible services.
+	FormatSlack Format = "slack"
+)
+
+// maxIssues is the number of issues listed per schema in a Slack message;
+// the counts always cover all issues.
+const maxIssues = 5
+
+// slackMaxText is the maximum length in characters of the text of a Slack
+// section block; Slack rejects the whole message if a block is longer.
+const slackMaxText = 3000
+
+// slackEscaper escapes the characters Slack mrkdwn reserves for links,
+// mentions, and entities.
+var slackEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+// Webhook posts a summary of lint results to a webhook URL.
+type Webhook struct {
+	URL    string
+	Format Format
+	// Client is the HTTP client used to post (default: a client with a
+	// 10 second timeout).
+	Client *http.Client
+}
+
+// Summary is the JSON payload posted by a Webhook: issue counts for the
+// run, and for each schema, by owner.
+type Summary struct {
+	Schemas  int             `json:"schemas"`
+	Errors   int             `json:"errors"`
+	Warnings int             `json:"warnings"`
+	Results  []SchemaSummary `json:"results"`
+}
+
+// SchemaSummary is the issue counts of one schema.
+type SchemaSummary struct {
+	SchemaPath string         `json:"schema_path"`
+	Errors     int            `json:"errors"`
+	Warnings   int            `json:"warnings"`
+	Owners     []OwnerSummary `json:"owners,omitempty"`
+}
+
+// OwnerSummary is the issue counts attributed to one owner.
+type OwnerSummary struct {
+	Owner    string `json:"owner"`
+	Errors   int    `json:"errors"`
+	Warnings int    `json:"warnings"`
+}
+
+// Summarize returns the issue counts of the results, by schema and owner.
+func Summarize(results []linter.Result) Summary {
+	s := Summary{Schemas: len(results), Results: []SchemaSummary{}}
+	for _, r := range results {
+		schema := SchemaSummary{SchemaPath: r.SchemaPath, Errors: r.ErrorCount(), Warnings: r.WarningCount()}
+		for _, group := range r.GroupByOwner() {
+			sub := linter.Result{Issues: group.Issues}
+			schema.Owners = append(schema.Owners, OwnerSummary{
+				Owner:    group.Owner,
+				Errors:   sub.ErrorCount(),
+				Warnings: sub.WarningCount(),
+			})
+		}
+		s.Errors += schema.Errors
+		s.Warnings += schema.Warnings
+		s.Results = append(s.Results, schema)
+	}
+	return s
+}
+
+// Notify posts the summary of the results to the webhook. A response
+// status other than 2xx is an error.
+func (n *Webhook) Notify(ctx context.Context, results []linter.Result) error {
+	var payload any
+	switch n.Format {
+	case FormatJSON, "":
+		payload = Summarize(results)
+	case FormatSlack:
+		payload = slackMessage(results)
+	default:
+		return fmt.Errorf("unknown webhook format: %s", n.Format)
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode notification: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.URL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create notification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	client := n.Client
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post notification: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("notification webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+// slackMessage returns a Slack message with a header line for the run and
+// a section per schema with issues, listing its owners and first issues.
+// Schema paths, messages, and owners are escaped, and each section is cut
+// to the Slack block limit, dropping issue lines before truncating text.
+func slackMessage(results []linter.Result) map[string]any {
+	summary := Summarize(results)
+	text := fmt.Sprintf("schemakit lint: %d error(s), %d warning(s) in %d schema(s)",
+		summary.Errors, summary.Warnings, summary.Schemas)
+	blocks := []map[string]any{slackSection(fmt.Sprintf("*%s*", text))}
+
+	for i, r := range results {
+		if len(r.Issues) == 0 {
+			continue
+		}
+		schema := summary.Results[i]
+		var sb strings.Builder
+		fmt.Fprintf(&sb, "*%s*: %d error(s), %d warning(s)", slackEscaper.Replace(r.SchemaPath), schema.Errors, schema.Warnings)
+		for j, issue := range r.Issues {
+			line := fmt.Sprintf("\n• [%s] `%s` %s", issue.Severity, slackEscaper.Replace(issue.Path.String()), slackEscaper.Replace(issue.Message))
+			more := fmt.Sprintf("\n…and %d more", len(r.Issues)-j)
+			if j == maxIssues || utf8.RuneCountInString(sb.String()+line+more) > slackMaxText {
+				sb.WriteString(more)
+				break
+			}
+			sb.WriteString(line)
+		}
+		blocks = append(blocks, slackSection(sb.String()))
+
+		owners := make([]string, 0, len(schema.Owners))
+		for _, owner := range schema.Owners {
+			owners = append(owners, fmt.Sprintf("%s: %d error(s), %d warning(s)",
+				slackEscaper.Replace(owner.Owner), owner.Errors, owner.Warnings))
+		}
+		blocks = append(blocks, map[string]any{
+			"type":     "context",
+			"elements": []map[string]any{{"type": "mrkdwn", "text": slackTruncate(strings.Join(owners, " · "))}},
+		})
+	}
+	return map[string]any{"text": text, "blocks": blocks}
+}
+
+func slackSection(text string) map[string]any {
+	return map[string]any{
+		"type": "section",
+		"text": map[string]any{"type": "mrkdwn", "text": slackTruncate(text)},
+	}
+}
+
+// slackTruncate cuts escaped mrkdwn text to slackMaxText characters, ending
+// it with an ellipsis and never splitting an entity such as &amp;.
+func slackTruncate(text string) string {
+	if utf8.RuneCountInString(text) <= slackMaxText {
+		return text
+	}
+	runes := []rune(text)
+	cut := string(runes[:slackMaxText-1])
+	if amp := strings.LastIndexByte(cut, '&'); amp > strings.LastIndexByte(cut, ';') {
+		cut = cut[:amp]
+	}
+	return cut + "…"
+}
@@ -0,0 +1,126 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+func TestWebhook(t *testing.T) {
+	results := []linter.Result{
+		{SchemaPath: "pets.json", Issues: []linter.Issue{
+			{Code: linter.CodeUnionNoDiscriminator, Severity: linter.SeverityError, Path: linter.ParsePath("$/oneOf"), Message: "no discriminator", Owner: "team-pets"},
+			{Code: linter.CodeLargeEnum, Severity: linter.SeverityWarning, Path: linter.ParsePath("$/enum"), Message: "large enum"},
+		}},
+		{SchemaPath: "orders.json", Issues: []linter.Issue{}},
+	}
+
+	var got []byte
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if ct := r.Header.Get("Content-Type"); ct != "application/json" {
+			t.Errorf("Expected JSON content type, got %q", ct)
+		}
+		got, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	n := &Webhook{URL: server.URL, Format: FormatJSON}
+	if err := n.Notify(context.Background(), results); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+	var summary Summary
+	if err := json.Unmarshal(got, &summary); err != nil {
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	if summary.Schemas != 2 || summary.Errors != 1 || summary.Warnings != 1 {
+		t.Errorf("Unexpected summary: %+v", summary)
+	}
+	if owners := summary.Results[0].Owners; len(owners) != 2 || owners[0].Owner != "team-pets" || owners[0].Errors != 1 {
+		t.Errorf("Unexpected owners: %+v", owners)
+	}
+
+	n.Format = FormatSlack
+	if err := n.Notify(context.Background(), results); err != nil {
+		t.Fatalf("Notify failed: %v", err)
+	}
+	var message struct {
+		Text   string           `json:"text"`
+		Blocks []map[string]any `json:"blocks"`
+	}
+	if err := json.Unmarshal(got, &message); err != nil {
+		t.Fatalf("Failed to decode payload: %v", err)
+	}
+	if !strings.Contains(message.Text, "1 error(s), 1 warning(s) in 2 schema(s)") {
+		t.Errorf("Unexpected text: %q", message.Text)
+	}
+	// Header, and a section and owner context for the schema with issues
+	if len(message.Blocks) != 3 {
+		t.Errorf("Expected 3 blocks, got %d: %v", len(message.Blocks), message.Blocks)
+	}
+}
+
+func TestWebhookStatus(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Error(w, "gone", http.StatusGone)
+	}))
+	defer server.Close()
+
+	n := &Webhook{URL: server.URL}
+	if err := n.Notify(context.Background(), nil); err == nil || !strings.Contains(err.Error(), "410") {
+		t.Errorf("Expected a status error, got %v", err)
+	}
+}
+
+func TestSlackMessageEscaping(t *testing.T) {
+	results := []linter.Result{{SchemaPath: "a&b<c>.json", Issues: []linter.Issue{
+		{Severity: linter.SeverityError, Path: linter.ParsePath("$/properties/<x>"), Message: "use <!channel> & co", Owner: "<team>"},
+	}}}
+	message := slackMessage(results)
+	blocks := message["blocks"].([]map[string]any)
+
+	section := blocks[1]["text"].(map[string]any)["text"].(string)
+	want := "*a&amp;b&lt;c&gt;.json*: 1 error(s), 0 warning(s)\n• [error] `$/properties/&lt;x&gt;` use &lt;!channel&gt; &amp; co"
+	if section != want {
+		t.Errorf("section = %q, want %q", section, want)
+	}
+	owners := blocks[2]["elements"].([]map[string]any)[0]["text"].(string)
+	if owners != "&lt;team&gt;: 1 error(s), 0 warning(s)" {
+		t.Errorf("owners = %q", owners)
+	}
+}
+
+func TestSlackMessageTruncation(t *testing.T) {
+	long := strings.Repeat("x", 1000)
+	issues := make([]linter.Issue, 4)
+	for i := range issues {
+		issues[i] = linter.Issue{Severity: linter.SeverityWarning, Path: linter.NewPath("$"), Message: long}
+	}
+	results := []linter.Result{
+		{SchemaPath: "many.json", Issues: issues},
+		{SchemaPath: strings.Repeat("&", 2000) + ".json", Issues: issues[:1]},
+	}
+	blocks := slackMessage(results)["blocks"].([]map[string]any)
+
+	many := blocks[1]["text"].(map[string]any)["text"].(string)
+	if n := utf8.RuneCountInString(many); n > slackMaxText {
+		t.Errorf("section has %d characters, limit %d", n, slackMaxText)
+	}
+	if !strings.HasSuffix(many, "\n…and 2 more") {
+		t.Errorf("section does not end with the omitted issue count: %q", many[len(many)-40:])
+	}
+
+	path := blocks[3]["text"].(map[string]any)["text"].(string)
+	if n := utf8.RuneCountInString(path); n > slackMaxText {
+		t.Errorf("section has %d characters, limit %d", n, slackMaxText)
+	}
+	if !strings.HasSuffix(path, "&amp;…") {
+		t.Errorf("section is not cut between entities: %q", path[len(path)-20:])
+	}
+}
@@ -0,0 +1,263 @@
+package linter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ExtractedSchema is a standalone JSON Schema extracted from an OpenAPI
+// component. Name is the component name; references to other components
+// are rewritten to the sibling files <Name>.json.
+type ExtractedSchema struct {
+	Name string
+	Data []byte
+}
+
+// ExtractOpenAPI extracts each schema under components/schemas of an
+// OpenAPI 3.0 document (YAML or JSON) as a JSON Schema 2020-12 document,
+// in document order. The OpenAPI 3.0 dialect is rewritten to standard
+// JSON Schema:
+//
+//   - nullable: true adds "null" to the type (or enum), or wraps a schema
+//     without a type in an anyOf with {"type": "null"}
+//   - example becomes examples
+//   - boolean exclusiveMinimum/exclusiveMaximum become numeric bounds
+//   - format: byte adds contentEncoding: base64
+//   - #/components/schemas/ $refs point to the sibling file
+//
+// A title naming the component is added if missing, so generators can
+// name the type.
+func ExtractOpenAPI(data []byte) ([]ExtractedSchema, error) {
+	var root yaml.Node
+	if err := yaml.Unmarshal(data, &root); err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+	if len(root.Content) == 0 {
+		return nil, errors.New("failed to parse OpenAPI document: empty document")
+	}
+	doc, err := orderedFromYAML(root.Content[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI document: %w", err)
+	}
+	obj, ok := doc.(*jsonObject)
+	if !ok {
+		return nil, errors.New("OpenAPI document is not an object")
+	}
+
+	version, _ := obj.get("openapi")
+	if v, _ := version.(string); !strings.HasPrefix(v, "3.0") {
+		return nil, fmt.Errorf("unsupported OpenAPI version %v (supported: 3.0.x)", version)
+	}
+
+	var schemas *jsonObject
+	if components, ok := obj.get("components"); ok {
+		if components, ok := components.(*jsonObject); ok {
+			s, _ := components.get("schemas")
+			schemas, _ = s.(*jsonObject)
+		}
+	}
+	if schemas == nil || len(schemas.members) == 0 {
+		return nil, errors.New("OpenAPI document has no components/schemas")
+	}
+
+	extracted := make([]ExtractedSchema, 0, len(schemas.members))
+	for _, member := range schemas.members {
+		path := NewPath("$", "components", "schemas", member.Key)
+		schema, err := convertOpenAPISchema(member.Value, path)
+		if err != nil {
+			return nil, err
+		}
+		if s, ok := schema.(*jsonObject); ok {
+			s.members = append([]jsonMember{{Key: "$schema", Value: Draft202012}}, s.members...)
+			if s.index("title") < 0 {
+				s.members = append(s.members[:1], append([]jsonMember{{Key: "title", Value: member.Key}}, s.members[1:]...)...)
+			}
+		}
+
+		var buf bytes.Buffer
+		enc := json.NewEncoder(&buf)
+		enc.SetEscapeHTML(false)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(schema); err != nil {
+			return nil, fmt.Errorf("%s: failed to serialize schema: %w", path, err)
+		}
+		extracted = append(extracted, ExtractedSchema{Name: member.Key, Data: buf.Bytes()})
+	}
+	return extracted, nil
+}
+
+// Keywords of an OpenAPI 3.0 Schema Object whose values are a schema, a map
+// of schemas, or an array of schemas.
+var (
+	openAPISubschemaKeywords      = []string{"items", "additionalProperties", "not"}
+	openAPISubschemaMapKeywords   = []string{"properties"}
+	openAPISubschemaArrayKeywords = []string{"allOf", "anyOf", "oneOf"}
+)
+
+// convertOpenAPISchema rewrites an OpenAPI 3.0 Schema Object and its
+// subschemas as JSON Schema, returning the converted schema.
+func convertOpenAPISchema(v any, path Path) (any, error) {
+	obj, ok := v.(*jsonObject)
+	if !ok {
+		return v, nil
+	}
+
+	if ref, ok := obj.get("$ref"); ok {
+		if s, ok := ref.(string); ok {
+			if rest, ok := strings.CutPrefix(s, "#/components/schemas/"); ok {
+				name, pointer, _ := strings.Cut(rest, "/")
+				target := name + ".json"
+				if pointer != "" {
+					target += "#/" + pointer
+				}
+				obj.set("$ref", target)
+			}
+		}
+		// Siblings of $ref are ignored in OpenAPI 3.0
+		return obj, nil
+	}
+
+	for _, kw := range openAPISubschemaKeywords {
+		if sub, ok := obj.get(kw); ok {
+			converted, err := convertOpenAPISchema(sub, path.Child(kw))
+			if err != nil {
+				return nil, err
+			}
+			obj.set(kw, converted)
+		}
+	}
+	for _, kw := range openAPISubschemaMapKeywords {
+		m, _ := obj.get(kw)
+		if m, ok := m.(*jsonObject); ok {
+			for i, member := range m.members {
+				converted, err := convertOpenAPISchema(member.Value, path.Child(kw, member.Key))
+				if err != nil {
+					return nil, err
+				}
+				m.members[i].Value = converted
+			}
+		}
+	}
+	for _, kw := range openAPISubschemaArrayKeywords {
+		a, _ := obj.get(kw)
+		if a, ok := a.([]any); ok {
+			for i, sub := range a {
+				converted, err := convertOpenAPISchema(sub, path.Child(kw).Index(i))
+				if err != nil {
+					return nil, err
+				}
+				a[i] = converted
+			}
+		}
+	}
+
+	if example, ok := obj.get("example"); ok {
+		if obj.index("examples") >= 0 {
+			return nil, fmt.Errorf("%s: both example and examples are set", path)
+		}
+		obj.replace("example", jsonMember{Key: "examples", Value: []any{example}})
+	}
+
+	convertExclusiveBound(obj, "exclusiveMinimum", "minimum")
+	convertExclusiveBound(obj, "exclusiveMaximum", "maximum")
+
+	if format, _ := obj.get("format"); format == "byte" && obj.index("contentEncoding") < 0 {
+		obj.set("contentEncoding", "base64")
+	}
+
+	nullable, _ := obj.get("nullable")
+	obj.replace("nullable")
+	if nullable != true {
+		return obj, nil
+	}
+	return nullableSchema(obj), nil
+}
+
+// convertExclusiveBound rewrites an OpenAPI 3.0 boolean exclusive bound
+// as the numeric bound of JSON Schema.
+func convertExclusiveBound(obj *jsonObject, exclusive, inclusive string) {
+	flag, ok := obj.get(exclusive)
+	if !ok {
+		return
+	}
+	b, isBool := flag.(bool)
+	if !isBool {
+		return
+	}
+	obj.replace(exclusive)
+	if b {
+		obj.rename(inclusive, exclusive)
+	}
+}
+
+// nullableSchema returns the schema extended to also accept null.
+func nullableSchema(obj *jsonObject) any {
+	if enum, ok := obj.get("enum"); ok {
+		if values, ok := enum.([]any); ok && !slices.Contains(values, nil) {
+			obj.set("enum", append(values, nil))
+		}
+	}
+	switch t, _ := obj.get("type"); t := t.(type) {
+	case string:
+		obj.set("type", []any{t, "null"})
+		return obj
+	case []any:
+		if !slices.Contains(t, "null") {
+			obj.set("type", append(t, "null"))
+		}
+		return obj
+	}
+	return &jsonObject{members: []jsonMember{{
+		Key: "anyOf",
+		Value: []any{
+			obj,
+			&jsonObject{members: []jsonMember{{Key: "type", Value: "null"}}},
+		},
+	}}}
+}
+
+// orderedFromYAML converts a YAML node to the values used by Convert:
+// *jsonObject for mappings, []any for sequences, and scalars. Timestamps
+// are kept as strings, as JSON has no timestamp type.
+func orderedFromYAML(node *yaml.Node) (any, error) {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return orderedFromYAML(node.Alias)
+	case yaml.MappingNode:
+		obj := &jsonObject{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			value, err := orderedFromYAML(node.Content[i+1])
+			if err != nil {
+				return nil, err
+			}
+			obj.set(node.Content[i].Value, value)
+		}
+		return obj, nil
+	case yaml.SequenceNode:
+		arr := make([]any, 0, len(node.Content))
+		for _, child := range node.Content {
+			value, err := orderedFromYAML(child)
+			if err != nil {
+				return nil, err
+			}
+			arr = append(arr, value)
+		}
+		return arr, nil
+	case yaml.ScalarNode:
+		if node.Tag == "!!timestamp" {
+			return node.Value, nil
+		}
+		var v any
+		if err := node.Decode(&v); err != nil {
+			return nil, fmt.Errorf("line %d: %w", node.Line, err)
+		}
+		return v, nil
+	}
+	return nil, fmt.Errorf("line %d: unsupported YAML node", node.Line)
+}
@@ -0,0 +1,137 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestExtractOpenAPI(t *testing.T) {
+	doc := `openapi: 3.0.3
+info: {title: Pets, version: "1"}
+paths: {}
+components:
+  schemas:
+    Pet:
+      type: object
+      required: [name]
+      properties:
+        name:
+          type: string
+          nullable: true
+          example: Rex
+        born:
+          type: string
+          format: date
+          example: 2020-01-02
+        age:
+          type: integer
+          minimum: 0
+          exclusiveMinimum: true
+        owner:
+          nullable: true
+          allOf:
+            - $ref: '#/components/schemas/Owner'
+        photo:
+          type: string
+          format: byte
+    Owner:
+      title: Pet Owner
+      type: object
+      properties:
+        status:
+          type: string
+          enum: [active, retired]
+          nullable: true
+`
+	schemas, err := ExtractOpenAPI([]byte(doc))
+	if err != nil {
+		t.Fatalf("Failed to extract: %v", err)
+	}
+	if len(schemas) != 2 || schemas[0].Name != "Pet" || schemas[1].Name != "Owner" {
+		t.Fatalf("Expected Pet and Owner, got %v", schemas)
+	}
+
+	want := `{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "title": "Pet",
+  "type": "object",
+  "required": [
+    "name"
+  ],
+  "properties": {
+    "name": {
+      "type": [
+        "string",
+        "null"
+      ],
+      "examples": [
+        "Rex"
+      ]
+    },
+    "born": {
+      "type": "string",
+      "format": "date",
+      "examples": [
+        "2020-01-02"
+      ]
+    },
+    "age": {
+      "type": "integer",
+      "exclusiveMinimum": 0
+    },
+    "owner": {
+      "anyOf": [
+        {
+          "allOf": [
+            {
+              "$ref": "Owner.json"
+            }
+          ]
+        },
+        {
+          "type": "null"
+        }
+      ]
+    },
+    "photo": {
+      "type": "string",
+      "format": "byte",
+      "contentEncoding": "base64"
+    }
+  }
+}
+`
+	if got := string(schemas[0].Data); got != want {
+		t.Errorf("Unexpected Pet schema:\n%s", got)
+	}
+
+	owner := string(schemas[1].Data)
+	if !strings.Contains(owner, `"title": "Pet Owner"`) || strings.Contains(owner, `"title": "Owner"`) {
+		t.Errorf("Expected the existing title to be kept, got:\n%s", owner)
+	}
+	if !strings.Contains(owner, "\"retired\",\n        null\n") {
+		t.Errorf("Expected null in the nullable enum, got:\n%s", owner)
+	}
+}
+
+func TestExtractOpenAPIErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		doc  string
+		want string
+	}{
+		{"swagger", `swagger: "2.0"`, "unsupported OpenAPI version"},
+		{"openapi 3.1", `openapi: 3.1.0`, "unsupported OpenAPI version"},
+		{"no schemas", `openapi: 3.0.0`, "no components/schemas"},
+		{"example clash", "openapi: 3.0.0\ncomponents: {schemas: {A: {example: 1, examples: [2]}}}", "both example and examples"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ExtractOpenAPI([]byte(tt.doc))
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("Expected error containing %q, got: %v", tt.want, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,34 @@
+package linter
+
+import "slices"
+
+// lintImplicitAdditionalProperties checks that an object schema declares
+// additionalProperties, with RequireAdditionalProperties. Validators accept
+// unknown properties when it is omitted, while some generators emit closed
+// types, so the schema should state which is intended. Schemas with a $ref
+// or allOf are skipped: their openness comes from the schemas they combine,
+// and additionalProperties: false beside them rejects those schemas'
+// properties.
+func (l *Linter) lintImplicitAdditionalProperties(schema *Schema, path Path, result *Result) {
+	if schema.IsBooleanSchema || schema.Ref != "" || len(schema.AllOf) > 0 {
+		return
+	}
+	isObject := schema.Type == "object" || slices.Contains(schema.TypeList, "object") ||
+		schema.Type == "" && len(schema.TypeList) == 0 && len(schema.Properties) > 0
+	if !isObject || schema.AdditionalProperties != nil || schema.AdditionalPropertiesSchema != nil {
+		return
+	}
+	suggestion := "Set additionalProperties to false to reject unknown properties, or true to accept them"
+	if v := l.config.DefaultAdditionalProperties; v != nil && *v {
+		suggestion = "Set additionalProperties: true, or false to reject unknown properties"
+	} else if v != nil {
+		suggestion = "Set additionalProperties: false, or true to accept unknown properties"
+	}
+	result.Issues = append(result.Issues, Issue{
+		Code:       CodeImplicitAdditionalProps,
+		Severity:   SeverityWarning,
+		Path:       path.Child("additionalProperties"),
+		Message:    "Object schema does not declare additionalProperties, so whether it accepts unknown properties is implicit",
+		Suggestion: suggestion,
+	})
+}
@@ -0,0 +1,66 @@
+package linter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestImplicitAdditionalProperties(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"name": {"type": "string"},
+			"address": {"type": "object", "properties": {"city": {"type": "string"}}, "additionalProperties": false},
+			"tags": {"type": "object", "additionalProperties": {"type": "string"}},
+			"owner": {"$ref": "#/$defs/Owner"},
+			"pet": {"allOf": [{"$ref": "#/$defs/Owner"}], "properties": {"kind": {"type": "string"}}}
+		},
+		"$defs": {
+			"Owner": {"properties": {"id": {"type": "string"}}}
+		}
+	}`
+
+	if issues := codeIssues(t, DefaultConfig(), schema, CodeImplicitAdditionalProps); len(issues) != 0 {
+		t.Errorf("Expected no issues without require_additional_properties, got %v", issues)
+	}
+
+	config := DefaultConfig()
+	config.RequireAdditionalProperties = true
+	issues := codeIssues(t, config, schema, CodeImplicitAdditionalProps)
+	var paths []string
+	for _, issue := range issues {
+		paths = append(paths, issue.Path.String())
+	}
+	want := "$/$defs/Owner/additionalProperties $/additionalProperties"
+	if got := strings.Join(slices.Sorted(slices.Values(paths)), " "); got != want {
+		t.Errorf("Expected issues at %s, got %s", want, got)
+	}
+}
+
+func TestFixImplicitAdditionalProperties(t *testing.T) {
+	schema := []byte(`{"type": "object", "properties": {"name": {"type": "string"}}}`)
+	config := DefaultConfig()
+	config.RequireAdditionalProperties = true
+
+	_, fixed, err := New(config).Fix(schema)
+	if err != nil {
+		t.Fatalf("Failed to fix: %v", err)
+	}
+	if len(fixed) != 0 {
+		t.Errorf("Expected no fix without default_additional_properties, got %v", fixed)
+	}
+
+	closed := false
+	config.DefaultAdditionalProperties = &closed
+	data, fixed, err := New(config).Fix(schema)
+	if err != nil {
+		t.Fatalf("Failed to fix: %v", err)
+	}
+	if len(fixed) != 1 || !strings.Contains(string(data), `"additionalProperties": false`) {
+		t.Errorf("Expected additionalProperties: false to be inserted, got %v: %s", fixed, data)
+	}
+	if issues := codeIssues(t, config, string(data), CodeImplicitAdditionalProps); len(issues) != 0 {
+		t.Errorf("Expected no issues after the fix, got %v", issues)
+	}
+}
@@ -0,0 +1,114 @@
+package linter
+
+import (
+	"io/fs"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+// An Option configures a Linter created by NewLinter.
+type Option func(*options) error
+
+// options are the settings NewLinter collects from its options.
+type options struct {
+	config Config
+	fsys   fs.FS
+}
+
+// NewLinter creates a Linter from DefaultConfig and the given options,
+// applied in order, and validates the result. It is equivalent to New with
+// a Config, and keeps callers that set a few options unaffected as Config
+// grows.
+func NewLinter(opts ...Option) (*Linter, error) {
+	o := &options{config: DefaultConfig()}
+	for _, opt := range opts {
+		if err := opt(o); err != nil {
+			return nil, err
+		}
+	}
+	if err := o.config.Validate(); err != nil {
+		return nil, err
+	}
+	l := New(o.config)
+	l.fsys = o.fsys
+	return l, nil
+}
+
+// WithConfig replaces the configuration, including the changes of earlier
+// options, with config.
+func WithConfig(config Config) Option {
+	return func(o *options) error {
+		o.config = config
+		return nil
+	}
+}
+
+// WithProfile selects the linting profile.
+func WithProfile(profile Profile) Option {
+	return func(o *options) error {
+		o.config.Profile = profile
+		return nil
+	}
+}
+
+// WithStrictness applies the thresholds and opt-in rules of a strictness
+// level; see Config.SetStrictness.
+func WithStrictness(strictness Strictness) Option {
+	return func(o *options) error {
+		return o.config.SetStrictness(strictness)
+	}
+}
+
+// WithPropertyCase sets the casing convention for property names.
+func WithPropertyCase(propertyCase PropertyCase) Option {
+	return func(o *options) error {
+		o.config.PropertyCase = propertyCase
+		return nil
+	}
+}
+
+// WithDiscriminatorFields sets the field names to look for as union
+// discriminators.
+func WithDiscriminatorFields(fields ...string) Option {
+	return func(o *options) error {
+		o.config.DiscriminatorFields = append([]string{}, fields...)
+		return nil
+	}
+}
+
+// WithRule adds custom rules, run on every schema node after the built-in
+// rules.
+func WithRule(rules ...Rule) Option {
+	return func(o *options) error {
+		o.config.Rules = append(o.config.Rules, rules...)
+		return nil
+	}
+}
+
+// WithResolver resolves $refs out of the linted document with resolver;
+// see Config.Resolver.
+func WithResolver(resolver Resolver) Option {
+	return func(o *options) error {
+		o.config.Resolver = resolver
+		return nil
+	}
+}
+
+// WithFS reads the files of LintFile and LintFileLowMemory from fsys
+// instead of the operating system, e.g., an embed.FS or fstest.MapFS.
+// Paths are then slash-separated and unrooted, as fs.FS requires.
+func WithFS(fsys fs.FS) Option {
+	return func(o *options) error {
+		o.fsys = fsys
+		return nil
+	}
+}
+
+// WithTracerProvider records OpenTelemetry spans for lint runs; see
+// Config.TracerProvider.
+func WithTracerProvider(provider trace.TracerProvider) Option {
+	return func(o *options) error {
+		o.config.TracerProvider = provider
+		return nil
+	}
+}
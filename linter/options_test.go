@@ -0,0 +1,66 @@
+package linter
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestNewLinter(t *testing.T) {
+	rule := NewRule(RuleInfo{Code: "no-title", Severity: SeverityInfo}, func(schema *Schema, path Path) []Issue {
+		if schema.Title == "" && len(path.Segments) == 0 {
+			return []Issue{{Code: "no-title", Severity: SeverityInfo, Path: path}}
+		}
+		return nil
+	})
+	fsys := fstest.MapFS{"schemas/pet.json": {Data: []byte(`{"type": "object", "properties": {"pet_name": {"type": "string"}}}`)}}
+
+	l, err := NewLinter(
+		WithProfile(ProfileScale),
+		WithPropertyCase(CaseSnake),
+		WithDiscriminatorFields("kind"),
+		WithRule(rule),
+		WithFS(fsys),
+	)
+	if err != nil {
+		t.Fatalf("Failed to create linter: %v", err)
+	}
+	if l.config.Profile != ProfileScale || l.config.PropertyCase != CaseSnake || len(l.config.DiscriminatorFields) != 1 {
+		t.Errorf("Options not applied: %+v", l.config)
+	}
+
+	result, err := l.LintFile("schemas/pet.json")
+	if err != nil {
+		t.Fatalf("Failed to lint from the file system: %v", err)
+	}
+	codes := make(map[IssueCode]bool)
+	for _, issue := range result.Issues {
+		codes[issue.Code] = true
+	}
+	if !codes["no-title"] || codes[CodeInvalidPropertyCase] {
+		t.Errorf("Expected the custom rule and snake_case properties, got %v", codes)
+	}
+	if _, err := l.LintFileLowMemory("schemas/pet.json"); err != nil {
+		t.Errorf("Failed to lint from the file system with low memory: %v", err)
+	}
+	if _, err := l.LintFile("pet.json"); err == nil {
+		t.Error("Expected an error for a file missing from the file system")
+	}
+}
+
+func TestNewLinterInvalid(t *testing.T) {
+	if _, err := NewLinter(WithStrictness("extreme")); err == nil {
+		t.Error("Expected an error for an unknown strictness")
+	}
+	if _, err := NewLinter(WithProfile("unknown")); err == nil {
+		t.Error("Expected an error for an unknown profile")
+	}
+	config := DefaultConfig()
+	config.MaxEnumValues = 3
+	l, err := NewLinter(WithProfile(ProfileScale), WithConfig(config))
+	if err != nil {
+		t.Fatalf("Failed to create linter: %v", err)
+	}
+	if l.config.Profile != ProfileDefault || l.config.MaxEnumValues != 3 {
+		t.Errorf("Expected WithConfig to replace earlier options, got %+v", l.config)
+	}
+}
@@ -0,0 +1,18 @@
+package linter
+
+// assignOwners attributes issues to the team named by the nearest x-owner
+// annotation above their location. Issues before index start belong to
+// other documents and are left unchanged.
+func assignOwners(schema *Schema, root Path, result *Result, start int) {
+	scopes := collectScopes(schema, root, (*Schema).Owner)
+	if len(scopes) == 0 {
+		return
+	}
+
+	for i := start; i < len(result.Issues); i++ {
+		issue := &result.Issues[i]
+		if issue.Owner == "" {
+			issue.Owner = nearestScope(scopes, issue.Path)
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAssignOwners(t *testing.T) {
+	schema := `{
+		"x-owner": "platform",
+		"$defs": {
+			"Pet": {
+				"x-owner": "team-pets",
+				"type": "integer",
+				"minLength": 1
+			},
+			"Store": {
+				"type": "integer",
+				"pattern": "a"
+			}
+		}
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	want := map[string]string{
+		"$/$defs/Pet/minLength": "team-pets",
+		"$/$defs/Store/pattern": "platform",
+	}
+	for _, issue := range result.Issues {
+		if owner, ok := want[issue.Path.String()]; ok {
+			if issue.Owner != owner {
+				t.Errorf("Expected owner %q at %s, got %q", owner, issue.Path, issue.Owner)
+			}
+			delete(want, issue.Path.String())
+		}
+	}
+	for path := range want {
+		t.Errorf("Expected an issue at %s, got: %v", path, result.Issues)
+	}
+}
+
+func TestGroupByOwner(t *testing.T) {
+	result := Result{
+		Issues: []Issue{
+			{Severity: SeverityWarning, Path: ParsePath("$/a"), Owner: "zeta"},
+			{Severity: SeverityError, Path: ParsePath("$/b")},
+			{Severity: SeverityError, Path: ParsePath("$/c"), Owner: "alpha"},
+			{Severity: SeverityWarning, Path: ParsePath("$/d"), Owner: "zeta"},
+		},
+	}
+
+	groups := result.GroupByOwner()
+	var owners []string
+	for _, group := range groups {
+		owners = append(owners, group.Owner)
+	}
+	if got := strings.Join(owners, ","); got != "alpha,zeta,"+UnownedGroup {
+		t.Errorf("Expected groups alpha, zeta, unowned; got %s", got)
+	}
+	if len(groups[1].Issues) != 2 || groups[1].Issues[0].Path.String() != "$/a" {
+		t.Errorf("Expected zeta issues in order, got: %v", groups[1].Issues)
+	}
+
+	text := result.StringByOwner()
+	if !strings.Contains(text, "## zeta: 0 error(s), 2 warning(s)") {
+		t.Errorf("Expected per-owner heading, got:\n%s", text)
+	}
+}
@@ -0,0 +1,178 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// paginationEnvelope is a list response: an object with one array property
+// and pagination fields, such as a next cursor or a total count, either
+// beside the array or in a nested object.
+type paginationEnvelope struct {
+	root   Path
+	path   Path
+	items  string
+	fields []string
+}
+
+// shape describes the structure and naming of the envelope (e.g.,
+// "{data: [...], pagination.next_cursor, pagination.total}").
+func (e paginationEnvelope) shape() string {
+	return fmt.Sprintf("{%s: [...], %s}", e.items, strings.Join(e.fields, ", "))
+}
+
+// lintPagination reports list responses whose envelope differs from the
+// one used by most list responses across the documents, grouped by the
+// root path of the document they occur in. Ties go to the envelope seen
+// first.
+func (l *Linter) lintPagination(schemas []*Schema, roots []Path) map[string][]Issue {
+	var envelopes []paginationEnvelope
+	for i, schema := range schemas {
+		if schema != nil {
+			envelopes = append(envelopes, l.paginationEnvelopes(schema, roots[i])...)
+		}
+	}
+
+	shapes := make([]string, len(envelopes))
+	for i, e := range envelopes {
+		shapes[i] = e.shape()
+	}
+	canonical, n, ok := conventionalShape(shapes)
+	if !ok {
+		return nil
+	}
+
+	byRoot := make(map[string][]Issue)
+	for i, e := range envelopes {
+		if shape := shapes[i]; shape != canonical {
+			byRoot[e.root.Root] = append(byRoot[e.root.Root], Issue{
+				Code:     CodeInconsistentPagination,
+				Severity: SeverityWarning,
+				Path:     e.path,
+				Message: fmt.Sprintf("List response envelope %s differs from the envelope %s used by %d of %d list responses",
+					shape, canonical, n, len(envelopes)),
+				Suggestion: fmt.Sprintf("Use the %s envelope, ideally as one shared definition referenced with $ref", canonical),
+			})
+		}
+	}
+	return byRoot
+}
+
+// conventionalShape returns the most common of the shapes, with ties going
+// to the one seen first, and its count. It returns false if the shapes do
+// not differ.
+func conventionalShape(shapes []string) (string, int, bool) {
+	counts := make(map[string]int)
+	var distinct []string
+	for _, shape := range shapes {
+		if counts[shape] == 0 {
+			distinct = append(distinct, shape)
+		}
+		counts[shape]++
+	}
+	if len(distinct) < 2 {
+		return "", 0, false
+	}
+	canonical := distinct[0]
+	for _, shape := range distinct[1:] {
+		if counts[shape] > counts[canonical] {
+			canonical = shape
+		}
+	}
+	return canonical, counts[canonical], true
+}
+
+// paginationEnvelopes returns the list responses in the document and its
+// definitions, skipping vendored definitions.
+func (l *Linter) paginationEnvelopes(doc *Schema, root Path) []paginationEnvelope {
+	vendored := l.ignoredDefinitions(doc, root)
+	var envelopes []paginationEnvelope
+	visit := func(s *Schema, path Path, _ bool) {
+		if e, ok := l.envelopeOf(doc, root, s); ok {
+			e.root, e.path = root, path
+			envelopes = append(envelopes, e)
+		}
+	}
+	if !vendored[root.String()] {
+		walkSchema(doc, root, false, visit)
+	}
+	for _, name := range sortedKeys(doc.Defs) {
+		if path := root.Child("$defs", name); !vendored[path.String()] {
+			walkSchema(doc.Defs[name], path, false, visit)
+		}
+	}
+	for _, name := range sortedKeys(doc.Definitions) {
+		if path := root.Child("definitions", name); !vendored[path.String()] {
+			walkSchema(doc.Definitions[name], path, false, visit)
+		}
+	}
+	return envelopes
+}
+
+// envelopeOf returns the envelope of an object with exactly one array
+// property and at least one pagination field. An object whose only
+// pagination fields are totals must have no other properties, so that an
+// order with items and a total is not taken for a list response.
+// Properties that are $refs are resolved.
+func (l *Linter) envelopeOf(doc *Schema, root Path, s *Schema) (paginationEnvelope, bool) {
+	var e paginationEnvelope
+	var items []string
+	cursor, others := false, 0
+	for _, name := range sortedKeys(s.Properties) {
+		prop := s.Properties[name]
+		if prop != nil && prop.IsRef() {
+			prop, _, _ = l.resolveRef(doc, root, prop.Ref)
+		}
+		if field, isCursor := paginationField(name); field {
+			e.fields = append(e.fields, name)
+			cursor = cursor || isCursor
+			continue
+		}
+		kind := ""
+		if prop != nil {
+			kind = schemaKind(prop)
+		}
+		switch kind {
+		case "array":
+			items = append(items, name)
+		case "object":
+			n := len(e.fields)
+			for _, sub := range sortedKeys(prop.Properties) {
+				if field, isCursor := paginationField(sub); field {
+					e.fields = append(e.fields, name+"."+sub)
+					cursor = cursor || isCursor
+				}
+			}
+			if len(e.fields) == n {
+				others++
+			}
+		default:
+			others++
+		}
+	}
+	if len(items) != 1 || len(e.fields) == 0 || (!cursor && others > 0) {
+		return e, false
+	}
+	e.items = items[0]
+	return e, true
+}
+
+// paginationField reports whether a property name is a pagination field,
+// and whether it is a cursor field (e.g., next, next_cursor, pageToken,
+// has_more) rather than a total (e.g., total, total_count; total_price is
+// neither).
+func paginationField(name string) (field, cursor bool) {
+	words := lowerWords(name)
+	total := false
+	for i, word := range words {
+		switch word {
+		case "next", "prev", "previous", "cursor", "offset", "page", "pages", "more", "continuation":
+			return true, true
+		case "total":
+			total = total || len(words) == 1 || (i+1 < len(words) &&
+				slices.Contains([]string{"count", "items", "results", "records", "elements", "size"}, words[i+1]))
+		}
+	}
+	return total, false
+}
@@ -0,0 +1,74 @@
+package linter
+
+import (
+	"testing"
+)
+
+func TestLintPagination(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"UserList": {
+				"type": "object",
+				"properties": {
+					"data": {"type": "array", "items": {"type": "string"}},
+					"next_cursor": {"type": "string"}
+				}
+			},
+			"OrderList": {
+				"type": "object",
+				"properties": {
+					"data": {"type": "array", "items": {"$ref": "#/$defs/Order"}},
+					"next_cursor": {"type": "string"}
+				}
+			},
+			"ProductPage": {
+				"type": "object",
+				"properties": {
+					"items": {"type": "array", "items": {"type": "string"}},
+					"meta": {"type": "object", "properties": {"nextPage": {"type": "integer"}}}
+				}
+			},
+			"Order": {
+				"type": "object",
+				"properties": {
+					"id": {"type": "string"},
+					"items": {"type": "array", "items": {"type": "string"}},
+					"total": {"type": "integer"}
+				}
+			}
+		}
+	}`
+
+	paths := func(data string) []string {
+		result, err := NewWithDefaults().Lint([]byte(data))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		var got []string
+		for _, issue := range result.Issues {
+			if issue.Code == CodeInconsistentPagination {
+				got = append(got, issue.Path.String())
+			}
+		}
+		return got
+	}
+
+	if got := paths(schema); len(got) != 1 || got[0] != "$/$defs/ProductPage" {
+		t.Errorf("Expected inconsistent-pagination at $/$defs/ProductPage, got %v", got)
+	}
+
+	// Envelopes are compared across the documents of a composite input
+	documents := `{"properties": {"results": {"type": "array"}, "total": {"type": "integer"}}}
+{"properties": {"results": {"type": "array"}, "total_count": {"type": "integer"}}}`
+	if got := paths(documents); len(got) != 1 || got[0] != "[1]" {
+		t.Errorf("Expected inconsistent-pagination at [1], got %v", got)
+	}
+
+	consistent := `{"$defs": {
+		"A": {"properties": {"data": {"type": "array"}, "has_more": {"type": "boolean"}}},
+		"B": {"properties": {"data": {"type": "array"}, "has_more": {"type": "boolean"}}}
+	}}`
+	if got := paths(consistent); len(got) != 0 {
+		t.Errorf("Did not expect inconsistent-pagination, got %v", got)
+	}
+}
@@ -0,0 +1,64 @@
+package linter
+
+import (
+	"runtime"
+	"sync"
+	"sync/atomic"
+)
+
+// lintDefinitions lints the $defs and definitions of a document that are
+// not ignored, in up to Config.Concurrency goroutines. Each definition is
+// linted into its own result, and the results are merged in the order of
+// the definition paths, so that the issues do not depend on scheduling.
+func (l *Linter) lintDefinitions(schema *Schema, root Path, ignored map[string]bool, result *Result) {
+	type definition struct {
+		path   Path
+		schema *Schema
+	}
+	var defs []definition
+	for _, name := range sortedKeys(schema.Defs) {
+		if path := root.Child("$defs", name); !ignored[path.String()] {
+			defs = append(defs, definition{path, schema.Defs[name]})
+		}
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		if path := root.Child("definitions", name); !ignored[path.String()] {
+			defs = append(defs, definition{path, schema.Definitions[name]})
+		}
+	}
+
+	workers := l.config.Concurrency
+	if workers == 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	if workers = min(workers, len(defs)); workers <= 1 {
+		for _, def := range defs {
+			l.lintSchema(def.schema, def.path, result, 0, false)
+		}
+		return
+	}
+
+	parts := make([]*Result, len(defs))
+	var next atomic.Int64
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1)) - 1
+				if i >= len(defs) {
+					return
+				}
+				parts[i] = &Result{profiler: result.profiler.fork(), doc: result.doc, root: result.root}
+				l.lintSchema(defs[i].schema, defs[i].path, parts[i], 0, false)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, part := range parts {
+		result.Issues = append(result.Issues, part.Issues...)
+		result.profiler.merge(part.profiler)
+	}
+}
@@ -0,0 +1,46 @@
+package linter
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLintDefinitionsConcurrently(t *testing.T) {
+	var defs []string
+	for i := range 200 {
+		defs = append(defs, fmt.Sprintf(`"Def%d": {"type": "object", "properties": {"user_name": {"type": "string"}, "pet": {"anyOf": [{"type": "object"}, {"type": "object"}]}}}`, i))
+	}
+	schema := []byte(`{"type": "object", "$defs": {` + strings.Join(defs, ",") + `}, "definitions": {"Legacy": {"type": "object", "properties": {"Bad_Name": {}}}}}`)
+
+	lint := func(concurrency int) *Result {
+		config := DefaultConfig()
+		config.Concurrency = concurrency
+		result, err := New(config).Lint(schema)
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		return result
+	}
+	want := lint(1)
+	if len(want.Issues) < 200 {
+		t.Fatalf("Expected issues in every definition, got %d", len(want.Issues))
+	}
+	for range 5 {
+		if got := lint(8); !reflect.DeepEqual(got.Issues, want.Issues) {
+			t.Fatal("Expected the same issues, in the same order, as linting one definition at a time")
+		}
+	}
+	if got := lint(0); !reflect.DeepEqual(got.Issues, want.Issues) {
+		t.Error("Expected the same issues with the default concurrency")
+	}
+}
+
+func TestConcurrencyValidate(t *testing.T) {
+	config := DefaultConfig()
+	config.Concurrency = -1
+	if err := config.Validate(); err == nil {
+		t.Error("Expected an error for negative concurrency")
+	}
+}
@@ -0,0 +1,150 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Path is the location of an issue as segments: the root of the document
+// ("$", or "[i]" in a composite file) and the keys and indexes below it,
+// unescaped (e.g., Root "$" and Segments ["properties", "a/b"]). Rules
+// build paths with Child and Index, so names holding "/" or "~" never need
+// escaping until the path is rendered.
+type Path struct {
+	Root     string
+	Segments []string
+}
+
+// NewPath returns the path of the segments below the document root.
+func NewPath(root string, segments ...string) Path {
+	return Path{Root: root, Segments: segments}
+}
+
+// rootPath is the path of the root of a single-document file.
+var rootPath = NewPath("$")
+
+// documentPath returns the path of the root of document i of a file: "$",
+// or "[i]" in a composite file.
+func documentPath(i int, composite bool) Path {
+	if composite {
+		return NewPath(fmt.Sprintf("[%d]", i))
+	}
+	return rootPath
+}
+
+// ParsePath parses an issue path, such as "$/properties/a~1b", whose
+// segments are escaped as in a JSON Pointer (RFC 6901): "~1" for "/" and
+// "~0" for "~".
+func ParsePath(path string) Path {
+	root, rest, found := strings.Cut(path, "/")
+	p := Path{Root: root}
+	if !found {
+		return p
+	}
+	for _, segment := range strings.Split(rest, "/") {
+		p.Segments = append(p.Segments, unescapePointer(segment))
+	}
+	return p
+}
+
+// Child returns the path of the location reached from p through the
+// segments, such as Child("properties", name).
+func (p Path) Child(segments ...string) Path {
+	return Path{Root: p.Root, Segments: slices.Concat(p.Segments, segments)}
+}
+
+// Index returns the path of item i of the array at p.
+func (p Path) Index(i int) Path {
+	return p.Child(strconv.Itoa(i))
+}
+
+// Parent returns the path of the location containing p, or p itself at
+// the document root.
+func (p Path) Parent() Path {
+	if len(p.Segments) == 0 {
+		return p
+	}
+	return Path{Root: p.Root, Segments: slices.Clip(p.Segments[:len(p.Segments)-1])}
+}
+
+// Last returns the last segment of the path, or "" at the document root.
+func (p Path) Last() string {
+	if len(p.Segments) == 0 {
+		return ""
+	}
+	return p.Segments[len(p.Segments)-1]
+}
+
+// Equal reports whether p and q are the same location.
+func (p Path) Equal(q Path) bool {
+	return p.Root == q.Root && slices.Equal(p.Segments, q.Segments)
+}
+
+// HasPrefix reports whether p is the location prefix or a location below
+// it.
+func (p Path) HasPrefix(prefix Path) bool {
+	return p.Root == prefix.Root && len(p.Segments) >= len(prefix.Segments) &&
+		slices.Equal(p.Segments[:len(prefix.Segments)], prefix.Segments)
+}
+
+// String returns the issue path, with escaped segments.
+func (p Path) String() string {
+	return p.Root + p.Pointer()
+}
+
+// Pointer returns the JSON Pointer of the location within its document
+// (e.g., "/properties/a~1b"), or "" for the document root.
+func (p Path) Pointer() string {
+	var sb strings.Builder
+	for _, segment := range p.Segments {
+		sb.WriteString("/")
+		sb.WriteString(escapePointer(segment))
+	}
+	return sb.String()
+}
+
+// MarshalText implements encoding.TextMarshaler, so that a path is written
+// to JSON as its string.
+func (p Path) MarshalText() ([]byte, error) {
+	return []byte(p.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (p *Path) UnmarshalText(text []byte) error {
+	*p = ParsePath(string(text))
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler, adding the JSON Pointer of the
+// issue within its document as "pointer".
+func (i Issue) MarshalJSON() ([]byte, error) {
+	type issue Issue
+	return json.Marshal(struct {
+		issue
+		Pointer string `json:"pointer"`
+	}{issue(i), i.Path.Pointer()})
+}
+
+// MarshalJSON implements json.Marshaler, writing the issue as Issue does
+// with the source and reason of the suppression, which the promoted
+// Issue.MarshalJSON would leave out.
+func (s Suppression) MarshalJSON() ([]byte, error) {
+	type issue Issue
+	return json.Marshal(struct {
+		issue
+		Pointer string            `json:"pointer"`
+		Source  SuppressionSource `json:"source"`
+		Reason  string            `json:"reason,omitempty"`
+	}{issue(s.Issue), s.Path.Pointer(), s.Source, s.Reason})
+}
+
+// pointerUnescaper unescapes the segments of JSON pointers.
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// unescapePointer unescapes a JSON Pointer segment.
+func unescapePointer(segment string) string {
+	return pointerUnescaper.Replace(segment)
+}
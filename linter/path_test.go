@@ -0,0 +1,90 @@
+package linter
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestParsePath(t *testing.T) {
+	p := ParsePath("$/properties/a~1b/items/0/properties/c~0d")
+	if p.Root != "$" {
+		t.Errorf("Expected root $, got %q", p.Root)
+	}
+	want := []string{"properties", "a/b", "items", "0", "properties", "c~d"}
+	if !slices.Equal(p.Segments, want) {
+		t.Errorf("Expected segments %q, got %q", want, p.Segments)
+	}
+	if got := p.String(); got != "$/properties/a~1b/items/0/properties/c~0d" {
+		t.Errorf("Expected the path to round-trip, got %q", got)
+	}
+	if got := p.Pointer(); got != "/properties/a~1b/items/0/properties/c~0d" {
+		t.Errorf("Expected a JSON pointer, got %q", got)
+	}
+
+	root := ParsePath("[1]")
+	if root.Root != "[1]" || len(root.Segments) != 0 || root.Pointer() != "" {
+		t.Errorf("Expected a document root, got %+v", root)
+	}
+}
+
+func TestIssuePathEscaping(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {"a/b": {"type": "object", "properties": {"Bad_Name": {"type": "string"}}}}
+	}`)
+	result, err := NewWithDefaults().Lint(schema)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var found *Issue
+	for i, issue := range result.Issues {
+		if issue.Code == CodeInvalidPropertyCase && strings.Contains(issue.Message, "Bad_Name") {
+			found = &result.Issues[i]
+		}
+	}
+	if found == nil {
+		t.Fatalf("Expected a property case issue, got %v", result.Issues)
+	}
+	if found.Path.String() != "$/properties/a~1b/properties/Bad_Name" {
+		t.Errorf("Expected an escaped path, got %q", found.Path)
+	}
+	if got := found.Path.Segments[1]; got != "a/b" {
+		t.Errorf("Expected the unescaped property name, got %q", got)
+	}
+
+	data, err := json.Marshal(found)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if fields["pointer"] != "/properties/a~1b/properties/Bad_Name" {
+		t.Errorf("Expected the JSON pointer in the output, got %v", fields["pointer"])
+	}
+	if fields["path"] != found.Path.String() {
+		t.Errorf("Expected the path in the output, got %v", fields["path"])
+	}
+}
+
+func TestSuppressionJSON(t *testing.T) {
+	s := Suppression{
+		Issue:  Issue{Code: CodeLargeEnum, Severity: SeverityWarning, Path: ParsePath("$/$defs/Status/enum")},
+		Source: SuppressedInline,
+		Reason: "Legacy status codes",
+	}
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatalf("Failed to marshal: %v", err)
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(data, &fields); err != nil {
+		t.Fatalf("Failed to unmarshal: %v", err)
+	}
+	if fields["source"] != "inline" || fields["reason"] != "Legacy status codes" || fields["pointer"] != "/$defs/Status/enum" {
+		t.Errorf("Expected the source, reason, and pointer in the output, got %s", data)
+	}
+}
@@ -0,0 +1,133 @@
+package linter
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Common path classes.
+const (
+	PathClassProduction = "production"
+	PathClassTest       = "test"
+	PathClassExample    = "example"
+)
+
+// PathClass classifies schema files by path, such as test fixtures or
+// examples, to adjust the severity of their findings.
+type PathClass struct {
+	// Name is the class recorded in Result.PathClass (e.g., "test").
+	Name string `json:"name"`
+	// Patterns are slash-separated glob patterns for the files of the
+	// class, where ** matches any number of directories (e.g.,
+	// "**/testdata/**"). A pattern not starting with / or ** also matches
+	// the end of a path at a directory boundary, so "testdata/**" matches
+	// files under any testdata directory.
+	Patterns []string `json:"patterns"`
+	// Severities maps rule severities to the severities reported for files
+	// of the class (e.g., {"error": "info"} for fixtures, or
+	// {"warning": "error"} to enforce production schemas strictly);
+	// unmapped severities are kept.
+	Severities map[Severity]Severity `json:"severities,omitempty"`
+}
+
+// classifyPath returns the first class with a pattern matching file.
+func classifyPath(classes []PathClass, file string) (PathClass, bool) {
+	file = strings.TrimPrefix(path.Clean(filepath.ToSlash(file)), "./")
+	for _, class := range classes {
+		for _, pattern := range class.Patterns {
+			if matchPathPattern(pattern, file) {
+				return class, true
+			}
+		}
+	}
+	return PathClass{}, false
+}
+
+// matchPathPattern reports whether file matches a path class pattern; see
+// PathClass.Patterns.
+func matchPathPattern(pattern, file string) bool {
+	segments := strings.Split(file, "/")
+	if anchored := strings.HasPrefix(pattern, "/"); anchored || strings.HasPrefix(pattern, "**") {
+		return matchSegments(strings.Split(strings.TrimPrefix(pattern, "/"), "/"), segments)
+	}
+	patternSegments := strings.Split(pattern, "/")
+	for i := range segments {
+		if matchSegments(patternSegments, segments[i:]) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchSegments matches path segments against pattern segments, where a
+// ** segment matches any number of path segments.
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], segments[1:])
+}
+
+// validatePathClasses returns an error if a path class has no name or
+// patterns, a malformed pattern, or an unknown severity.
+func validatePathClasses(classes []PathClass) error {
+	for _, class := range classes {
+		if class.Name == "" {
+			return errors.New("path class without a name")
+		}
+		if len(class.Patterns) == 0 {
+			return fmt.Errorf("path class %s has no patterns", class.Name)
+		}
+		for _, pattern := range class.Patterns {
+			for _, segment := range strings.Split(strings.TrimPrefix(pattern, "/"), "/") {
+				if _, err := path.Match(segment, ""); err != nil {
+					return fmt.Errorf("invalid pattern %q for path class %s: %w", pattern, class.Name, err)
+				}
+			}
+		}
+		for from, to := range class.Severities {
+			for _, severity := range []Severity{from, to} {
+				switch severity {
+				case SeverityError, SeverityWarning, SeverityInfo:
+				default:
+					return fmt.Errorf("unknown severity %q for path class %s", severity, class.Name)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// ApplyPathClass classifies a result by the path of the file it was read
+// from, with the first of Config.PathClasses that has a matching pattern,
+// and adjusts the severity of its issues by the class. Results of files
+// matching no class are unchanged.
+func (l *Linter) ApplyPathClass(result *Result, file string) {
+	class, ok := classifyPath(l.config.PathClasses, file)
+	if !ok {
+		return
+	}
+	result.PathClass = class.Name
+	for i := range result.Issues {
+		if severity, ok := class.Severities[result.Issues[i].Severity]; ok {
+			result.Issues[i].Severity = severity
+		}
+	}
+}
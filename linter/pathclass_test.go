@@ -0,0 +1,87 @@
+package linter
+
+import (
+	"testing"
+	"testing/fstest"
+)
+
+func TestMatchPathPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		file    string
+		want    bool
+	}{
+		{"testdata/**", "testdata/a.json", true},
+		{"testdata/**", "pkg/store/testdata/b/c.json", true},
+		{"testdata/**", "testdata2/a.json", false},
+		{"/testdata/**", "pkg/testdata/a.json", false},
+		{"/testdata/**", "testdata/a.json", true},
+		{"**/*_test.json", "a/b/pet_test.json", true},
+		{"**/*_test.json", "pet_test.json", true},
+		{"api/*.json", "api/v1/pet.json", false},
+		{"api/**/*.json", "api/v1/pet.json", true},
+		{"api/**/*.json", "api/pet.json", true},
+		{"examples/**", "docs/api/pet.json", false},
+	}
+	for _, tt := range tests {
+		if got := matchPathPattern(tt.pattern, tt.file); got != tt.want {
+			t.Errorf("matchPathPattern(%q, %q) = %v, want %v", tt.pattern, tt.file, got, tt.want)
+		}
+	}
+}
+
+func TestPathClasses(t *testing.T) {
+	schema := []byte(`{"type": "object", "properties": {"user_name": {"type": "string"}, "point": {"type": "array", "prefixItems": [{"type": "number"}]}, "pet": {"anyOf": [{"type": "object", "properties": {"a": {"type": "string"}}}, {"type": "object", "properties": {"b": {"type": "string"}}}]}}}`)
+	fsys := fstest.MapFS{
+		"api/pet.json":                     {Data: schema},
+		"internal/store/testdata/pet.json": {Data: schema},
+		"other/pet.json":                   {Data: schema},
+	}
+	config := DefaultConfig()
+	config.PathClasses = []PathClass{
+		{Name: PathClassTest, Patterns: []string{"testdata/**"}, Severities: map[Severity]Severity{SeverityError: SeverityInfo, SeverityWarning: SeverityInfo}},
+		{Name: PathClassProduction, Patterns: []string{"/api/**"}, Severities: map[Severity]Severity{SeverityWarning: SeverityError}},
+	}
+	l, err := NewLinter(WithConfig(config), WithFS(fsys))
+	if err != nil {
+		t.Fatalf("Failed to create linter: %v", err)
+	}
+
+	lint := func(file string) *Result {
+		t.Helper()
+		result, err := l.LintFile(file)
+		if err != nil {
+			t.Fatalf("Failed to lint %s: %v", file, err)
+		}
+		return result
+	}
+	other := lint("other/pet.json")
+	if other.PathClass != "" || other.ErrorCount() == 0 || other.WarningCount() == 0 {
+		t.Fatalf("Expected errors and warnings outside the classes, got %v", other.Issues)
+	}
+	if fixture := lint("internal/store/testdata/pet.json"); fixture.PathClass != PathClassTest || fixture.ErrorCount() != 0 || fixture.WarningCount() != 0 || len(fixture.Issues) != len(other.Issues) {
+		t.Errorf("Expected only info issues in the fixture, got %q %v", fixture.PathClass, fixture.Issues)
+	}
+	if production := lint("api/pet.json"); production.PathClass != PathClassProduction || production.WarningCount() != 0 || production.ErrorCount() != other.ErrorCount()+other.WarningCount() {
+		t.Errorf("Expected warnings raised to errors in production, got %q %v", production.PathClass, production.Issues)
+	}
+}
+
+func TestValidatePathClasses(t *testing.T) {
+	tests := []struct {
+		name  string
+		class PathClass
+	}{
+		{"no name", PathClass{Patterns: []string{"testdata/**"}}},
+		{"no patterns", PathClass{Name: PathClassTest}},
+		{"bad pattern", PathClass{Name: PathClassTest, Patterns: []string{"testdata/[**"}}},
+		{"bad severity", PathClass{Name: PathClassTest, Patterns: []string{"testdata/**"}, Severities: map[Severity]Severity{SeverityError: "fatal"}}},
+	}
+	for _, tt := range tests {
+		config := DefaultConfig()
+		config.PathClasses = []PathClass{tt.class}
+		if err := config.Validate(); err == nil {
+			t.Errorf("%s: expected a validation error", tt.name)
+		}
+	}
+}
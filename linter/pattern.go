@@ -0,0 +1,267 @@
+package linter
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// patternConstruct is a regular expression construct that some target
+// languages' standard regex engines do not support.
+type patternConstruct struct {
+	name string
+	// text is the construct as written in the pattern (e.g., "(?<=")
+	text string
+	// engines are the engines that reject the construct
+	engines []string
+}
+
+// groupConstructs are the group syntaxes that are not portable, by prefix.
+// Longer prefixes come first, so that "(?<=" is not read as "(?<".
+var groupConstructs = []patternConstruct{
+	{"lookbehind", "(?<=", []string{"Go (RE2)"}},
+	{"negative lookbehind", "(?<!", []string{"Go (RE2)"}},
+	{"lookahead", "(?=", []string{"Go (RE2)"}},
+	{"negative lookahead", "(?!", []string{"Go (RE2)"}},
+	{"atomic group", "(?>", []string{"Go (RE2)", "JavaScript"}},
+	{"conditional", "(?(", []string{"Go (RE2)", "JavaScript"}},
+	{"recursion", "(?R", []string{"Go (RE2)", "JavaScript"}},
+}
+
+// unportableConstructs returns the constructs of a pattern that common
+// target languages' standard regex engines (Go's RE2, and JavaScript,
+// whose dialect JSON Schema patterns use) do not support, in order of
+// first occurrence, once each.
+func unportableConstructs(pattern string) []patternConstruct {
+	var found []patternConstruct
+	add := func(c patternConstruct) {
+		for _, f := range found {
+			if f.name == c.name {
+				return
+			}
+		}
+		found = append(found, c)
+	}
+
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		c := pattern[i]
+		switch {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			if inClass {
+				continue
+			}
+			switch e := pattern[i]; {
+			case e >= '1' && e <= '9':
+				add(patternConstruct{"backreference", pattern[i-1 : i+1], []string{"Go (RE2)"}})
+			case e == 'k' && i+1 < len(pattern) && strings.ContainsRune("<{'", rune(pattern[i+1])):
+				add(patternConstruct{"named backreference", `\k` + pattern[i+1:i+2], []string{"Go (RE2)"}})
+			case e == 'Z':
+				add(patternConstruct{`\Z anchor`, `\Z`, []string{"Go (RE2)", "JavaScript"}})
+			case e == 'A' || e == 'z':
+				add(patternConstruct{fmt.Sprintf(`\%c anchor`, e), pattern[i-1 : i+1], []string{"JavaScript"}})
+			}
+		case inClass:
+			if c == ']' {
+				inClass = false
+			}
+		case c == '[':
+			inClass = true
+			// A ] right after [ or [^ is a literal
+			if strings.HasPrefix(pattern[i+1:], "^") {
+				i++
+			}
+			if strings.HasPrefix(pattern[i+1:], "]") {
+				i++
+			}
+		case c == '(' && strings.HasPrefix(pattern[i:], "(?"):
+			for _, g := range groupConstructs {
+				if strings.HasPrefix(pattern[i:], g.text) {
+					add(g)
+					break
+				}
+			}
+			// Skip the ? so that it is not read as a quantifier
+			i++
+		case isQuantifierEnd(pattern, i) && i+1 < len(pattern) && pattern[i+1] == '+':
+			add(patternConstruct{"possessive quantifier", pattern[i : i+2], []string{"Go (RE2)", "JavaScript"}})
+			i++
+		}
+	}
+	return found
+}
+
+// isQuantifierEnd reports whether the byte at i ends a quantifier: *, +,
+// ?, or the } of {n}, {n,}, or {n,m}.
+func isQuantifierEnd(pattern string, i int) bool {
+	switch pattern[i] {
+	case '*', '+', '?':
+		return true
+	case '}':
+		open := strings.LastIndexByte(pattern[:i], '{')
+		if open < 0 || open+1 == i {
+			return false
+		}
+		min, max, _ := strings.Cut(pattern[open+1:i], ",")
+		return min != "" && strings.Trim(min, "0123456789") == "" && strings.Trim(max, "0123456789") == ""
+	}
+	return false
+}
+
+// lintPatternPortability flags patterns that use constructs common target
+// languages' standard regex engines do not support, so that validators
+// generated for them fail to compile the pattern.
+func (l *Linter) lintPatternPortability(schema *Schema, path Path, result *Result) {
+	if schema.Pattern == "" {
+		return
+	}
+	constructs := unportableConstructs(schema.Pattern)
+	if len(constructs) == 0 {
+		return
+	}
+	uses := make([]string, len(constructs))
+	for i, c := range constructs {
+		uses[i] = fmt.Sprintf("%s '%s' (not supported by %s)", c.name, c.text, strings.Join(c.engines, " or "))
+	}
+	result.Issues = append(result.Issues, Issue{
+		Code:       CodeUnportablePattern,
+		Severity:   SeverityWarning,
+		Path:       path.Child("pattern"),
+		Message:    fmt.Sprintf("Pattern %q uses %s", schema.Pattern, strings.Join(uses, ", ")),
+		Suggestion: "Rewrite the pattern without these constructs, e.g., match the context explicitly instead of a lookaround, or split the check into separate properties",
+	})
+}
+
+// patternAnchored reports whether a pattern is anchored at both ends (^ or
+// \A, and an unescaped $ or \z) without a top-level alternation, which
+// would bind looser than the anchors (e.g., ^a|b$ matches "xb").
+func patternAnchored(pattern string) bool {
+	start := strings.HasPrefix(pattern, "^") || strings.HasPrefix(pattern, `\A`)
+	end := strings.HasSuffix(pattern, "$") && !escapedAt(pattern, len(pattern)-1) ||
+		strings.HasSuffix(pattern, `\z`) && !escapedAt(pattern, len(pattern)-2)
+	return start && end && !topLevelAlternation(pattern)
+}
+
+// anchorPattern anchors a pattern at both ends, grouping a top-level
+// alternation so that the anchors apply to each alternative.
+func anchorPattern(pattern string) string {
+	inner := strings.TrimPrefix(pattern, "^")
+	if strings.HasSuffix(inner, "$") && !escapedAt(inner, len(inner)-1) {
+		inner = strings.TrimSuffix(inner, "$")
+	}
+	if topLevelAlternation(inner) {
+		inner = "(?:" + inner + ")"
+	}
+	return "^" + inner + "$"
+}
+
+// escapedAt reports whether the byte at i is escaped by an odd number of
+// backslashes.
+func escapedAt(pattern string, i int) bool {
+	n := 0
+	for j := i - 1; j >= 0 && pattern[j] == '\\'; j-- {
+		n++
+	}
+	return n%2 == 1
+}
+
+// topLevelAlternation reports whether a pattern has a | outside groups and
+// character classes.
+func topLevelAlternation(pattern string) bool {
+	depth, inClass := 0, false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\':
+			i++
+		case inClass:
+			inClass = c != ']'
+		case c == '[':
+			inClass = true
+			if strings.HasPrefix(pattern[i+1:], "^") {
+				i++
+			}
+			if strings.HasPrefix(pattern[i+1:], "]") {
+				i++
+			}
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '|' && depth == 0:
+			return true
+		}
+	}
+	return false
+}
+
+// unicodeEscape matches the ECMAScript \uXXXX escapes, which Go's regexp
+// package writes as \x{XXXX}.
+var unicodeEscape = regexp.MustCompile(`\\u([0-9A-Fa-f]{4})`)
+
+// patternError returns the error compiling a pattern, or nil if it
+// compiles. Patterns with unportable constructs, which Go's regexp package
+// rejects but other engines accept, are left to unportable-pattern.
+func patternError(pattern string) error {
+	if len(unportableConstructs(pattern)) > 0 {
+		return nil
+	}
+	var sb strings.Builder
+	last := 0
+	for _, m := range unicodeEscape.FindAllStringSubmatchIndex(pattern, -1) {
+		if escapedAt(pattern, m[0]) {
+			continue
+		}
+		sb.WriteString(pattern[last:m[0]])
+		sb.WriteString(`\x{` + pattern[m[2]:m[3]] + "}")
+		last = m[1]
+	}
+	sb.WriteString(pattern[last:])
+	_, err := regexp.Compile(sb.String())
+	return err
+}
+
+// lintPatternAnchoring flags patterns that are not anchored at both ends.
+// JSON Schema patterns match anywhere in the string, so an unanchored
+// pattern such as [0-9]{5} also accepts "abc123456". A pattern on a type
+// other than string has no effect, and is left to dead-keyword. Patterns
+// that do not compile are reported as invalid-pattern instead, since
+// anchoring them would not help.
+func (l *Linter) lintPatternAnchoring(schema *Schema, path Path, result *Result) {
+	severity := l.config.UnanchoredPatternSeverity
+	if schema.Pattern == "" {
+		return
+	}
+	if err := patternError(schema.Pattern); err != nil {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeInvalidPattern,
+			Severity:   SeverityError,
+			Path:       path.Child("pattern"),
+			Message:    fmt.Sprintf("Pattern %q is not a valid regular expression: %v", schema.Pattern, err),
+			Suggestion: "Fix the regular expression, e.g., escape literal brackets and parentheses with a backslash",
+		})
+		return
+	}
+	if patternAnchored(schema.Pattern) {
+		return
+	}
+	if types := schema.TypeList; len(types) > 0 && !slices.Contains(types, "string") ||
+		len(types) == 0 && schema.Type != "" && schema.Type != "string" {
+		return
+	}
+	if severity == "" {
+		severity = SeverityWarning
+	}
+	reason := "is not anchored, so it matches anywhere in the string"
+	if topLevelAlternation(schema.Pattern) && strings.HasPrefix(schema.Pattern, "^") {
+		reason = "has a top-level alternation, so the anchors apply only to the first and last alternatives"
+	}
+	result.Issues = append(result.Issues, Issue{
+		Code:       CodeUnanchoredPattern,
+		Severity:   severity,
+		Path:       path.Child("pattern"),
+		Message:    fmt.Sprintf("Pattern %q %s", schema.Pattern, reason),
+		Suggestion: fmt.Sprintf("Anchor the pattern as %q (run 'schemakit fix'), or set unanchored_pattern_severity if substring matching is intended", anchorPattern(schema.Pattern)),
+	})
+}
@@ -0,0 +1,158 @@
+package linter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestUnportableConstructs(t *testing.T) {
+	tests := []struct {
+		pattern string
+		want    []string
+	}{
+		{`^[a-z]+$`, nil},
+		{`^(?:ab)+?[)(?<=]{2,3}$`, nil},
+		{`^\d{3}(?<=1)\d$`, []string{"lookbehind"}},
+		{`^(?!tmp)[a-z]+(?=x)$`, []string{"negative lookahead", "lookahead"}},
+		{`^(a)\1$`, []string{"backreference"}},
+		{`^(?<word>a)\k<word>$`, []string{"named backreference"}},
+		{`^a++b{2,}+$`, []string{"possessive quantifier"}},
+		{`^(?>a|ab)c\z`, []string{"atomic group", `\z anchor`}},
+		{`\Aabc\Z`, []string{`\A anchor`, `\Z anchor`}},
+		{`^[\1\Z]\\1$`, nil},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, c := range unportableConstructs(tt.pattern) {
+			got = append(got, c.name)
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("unportableConstructs(%q) = %v, want %v", tt.pattern, got, tt.want)
+		}
+	}
+}
+
+func TestLintPatternPortability(t *testing.T) {
+	schema := `{"type": "object", "properties": {
+		"code": {"type": "string", "pattern": "^(?<=x)[A-Z]{3}$"},
+		"name": {"type": "string", "pattern": "^[a-z]+$"}
+	}}`
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var got []Issue
+	for _, issue := range result.Issues {
+		if issue.Code == CodeUnportablePattern {
+			got = append(got, issue)
+		}
+	}
+	if len(got) != 1 || got[0].Path.String() != "$/properties/code/pattern" ||
+		!strings.Contains(got[0].Message, "lookbehind '(?<=' (not supported by Go (RE2))") {
+		t.Errorf("Expected a lookbehind issue for code, got %v", got)
+	}
+}
+
+func TestPatternAnchoring(t *testing.T) {
+	tests := []struct {
+		pattern  string
+		anchored bool
+		fixed    string
+	}{
+		{`^[0-9]{5}$`, true, `^[0-9]{5}$`},
+		{`\A[a-z]+\z`, true, ``},
+		{`[0-9]{5}`, false, `^[0-9]{5}$`},
+		{`^[a-z]+`, false, `^[a-z]+$`},
+		{`[a-z]+\$`, false, `^[a-z]+\$$`},
+		{`^a|b$`, false, `^(?:a|b)$`},
+		{`^(a|b)$`, true, `^(a|b)$`},
+		{`^[|]$`, true, `^[|]$`},
+	}
+	for _, tt := range tests {
+		if got := patternAnchored(tt.pattern); got != tt.anchored {
+			t.Errorf("patternAnchored(%q) = %v, want %v", tt.pattern, got, tt.anchored)
+		}
+		if tt.fixed != "" {
+			if got := anchorPattern(tt.pattern); got != tt.fixed {
+				t.Errorf("anchorPattern(%q) = %q, want %q", tt.pattern, got, tt.fixed)
+			}
+		}
+	}
+
+	schema := `{"type": "object", "properties": {
+		"zip": {"type": "string", "pattern": "[0-9]{5}"},
+		"count": {"type": "integer", "pattern": "[0-9]"}
+	}}`
+	config := DefaultConfig()
+	config.UnanchoredPatternSeverity = SeverityError
+	l := New(config)
+	result, err := l.Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var got []Issue
+	for _, issue := range result.Issues {
+		if issue.Code == CodeUnanchoredPattern {
+			got = append(got, issue)
+		}
+	}
+	if len(got) != 1 || got[0].Path.String() != "$/properties/zip/pattern" || got[0].Severity != SeverityError {
+		t.Errorf("Expected an unanchored-pattern error for zip only, got %v", got)
+	}
+
+	fixed, issues, err := l.Fix([]byte(schema), CodeUnanchoredPattern)
+	if err != nil {
+		t.Fatalf("Failed to fix: %v", err)
+	}
+	if len(issues) != 1 || !strings.Contains(string(fixed), `"pattern": "^[0-9]{5}$"`) {
+		t.Errorf("Expected the zip pattern to be anchored, got %v:\n%s", issues, fixed)
+	}
+
+	config.UnanchoredPatternSeverity = "fatal"
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for an unknown severity")
+	}
+}
+
+func TestLintInvalidPattern(t *testing.T) {
+	for _, pattern := range []string{`^[a-z]+$`, `^\u00e9+$`, `^(?<=x)a$`} {
+		if err := patternError(pattern); err != nil {
+			t.Errorf("patternError(%q) = %v, want nil", pattern, err)
+		}
+	}
+
+	schema := `{"type": "object", "properties": {
+		"code": {"type": "string", "pattern": "["},
+		"name": {"type": "string", "pattern": "(a"}
+	}}`
+	l := NewWithDefaults()
+	result, err := l.Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var invalid []string
+	for _, issue := range result.Issues {
+		switch issue.Code {
+		case CodeInvalidPattern:
+			if issue.Severity != SeverityError {
+				t.Errorf("Expected an error, got %v", issue)
+			}
+			invalid = append(invalid, issue.Path.String())
+		case CodeUnanchoredPattern:
+			t.Errorf("Expected no anchoring suggestion for an invalid pattern, got %v", issue)
+		}
+	}
+	slices.Sort(invalid)
+	if strings.Join(invalid, ",") != "$/properties/code/pattern,$/properties/name/pattern" {
+		t.Errorf("Expected invalid-pattern issues for code and name, got %v", invalid)
+	}
+
+	fixed, _, err := l.Fix([]byte(schema), CodeUnanchoredPattern)
+	if err != nil {
+		t.Fatalf("Failed to fix: %v", err)
+	}
+	if !strings.Contains(string(fixed), `"pattern": "["`) {
+		t.Errorf("Expected the invalid pattern to be left alone, got:\n%s", fixed)
+	}
+}
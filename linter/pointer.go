@@ -0,0 +1,130 @@
+package linter
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// LintAt lints only the subschema of a single schema document at pointer
+// (e.g., "#/$defs/Order" or "#/$defs/Order/properties/items"), for quick
+// iteration on one definition of a large file. The definition holding the
+// subschema is linted with the root schema and the definitions it
+// references, so $refs resolve as in the whole document, and only the
+// issues located in the subschema are returned. Issues do not list the
+// $refs using a definition (ReferencedBy), and Config.Roots is ignored.
+// A pointer that does not resolve to a schema is a *LintError wrapping
+// ErrUnresolvedRef.
+func (l *Linter) LintAt(data []byte, pointer string) (*Result, error) {
+	if !strings.HasPrefix(pointer, "#") {
+		pointer = "#" + pointer
+	}
+	data, err := NormalizeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	schemas, composite, err := ParseDocuments(data)
+	if err != nil {
+		return nil, err
+	}
+	if composite || len(schemas) != 1 {
+		return nil, fmt.Errorf("a pointer requires a single schema document, not %d", len(schemas))
+	}
+	doc := schemas[0]
+	if err := checkDraft(doc); err != nil {
+		return nil, err
+	}
+	path, ok := resolvePointer(doc, rootPath, pointer)
+	if !ok {
+		return nil, &LintError{Err: fmt.Errorf("%w: pointer %q does not resolve to a schema", ErrUnresolvedRef, pointer)}
+	}
+	if path.Equal(rootPath) {
+		return l.Lint(data)
+	}
+	duplicates, err := duplicateKeys(data, false)
+	if err != nil {
+		return nil, err
+	}
+
+	// Infer the property case from the whole document, not the part linted
+	scoped := *l.withInferredCase(sampleCase(doc))
+	scoped.config.Roots = nil
+	metadata := l.metadata(time.Now())
+	p := l.newProfiler()
+	part := &Result{Issues: []Issue{}, profiler: p}
+	if err := scoped.lintDocument(partialDocument(doc, rootPath, definitionPath(rootPath, path)), rootPath, part, duplicates["$"]); err != nil {
+		return nil, err
+	}
+
+	result := &Result{Issues: []Issue{}, Timing: p.timing(), Metadata: metadata, ids: part.ids}
+	within := func(issue Issue) bool {
+		return issue.Path.HasPrefix(path)
+	}
+	for _, issue := range part.Issues {
+		if within(issue) {
+			issue.ReferencedBy = nil
+			result.Issues = append(result.Issues, issue)
+		}
+	}
+	for _, s := range part.Suppressed {
+		if within(s.Issue) {
+			s.Issue.ReferencedBy = nil
+			result.Suppressed = append(result.Suppressed, s)
+		}
+	}
+	return result, nil
+}
+
+// resolvePointer returns the lint path of the subschema at a local JSON
+// pointer or anchor reference. Pointers reach the root schema, its
+// definitions, and their properties, items, and variants.
+func resolvePointer(doc *Schema, root Path, pointer string) (Path, bool) {
+	if _, path, ok := resolveLocalRef(doc, root, pointer); ok {
+		return path, true
+	}
+	fragment := strings.TrimPrefix(pointer, "#")
+	if unescaped, err := url.PathUnescape(fragment); err == nil {
+		fragment = unescaped
+	}
+	want := root.Child(ParsePath("/" + strings.TrimPrefix(fragment, "/")).Segments...)
+
+	found := false
+	visit := func(_ *Schema, path Path, _ bool) {
+		found = found || path.Equal(want)
+	}
+	walkSchema(doc, root, false, visit)
+	for _, name := range sortedKeys(doc.Defs) {
+		walkSchema(doc.Defs[name], root.Child("$defs", name), false, visit)
+	}
+	for _, name := range sortedKeys(doc.Definitions) {
+		walkSchema(doc.Definitions[name], root.Child("definitions", name), false, visit)
+	}
+	return want, found
+}
+
+// partialDocument returns a copy of doc holding only the definition at
+// entry (or none for the root) and the definitions it and the root schema
+// reference, directly or indirectly.
+func partialDocument(doc *Schema, root, entry Path) *Schema {
+	reached := reachableDefinitions(doc, root, []Path{root, entry})
+	partial := *doc
+	partial.Defs, partial.Definitions = nil, nil
+	for name, def := range doc.Defs {
+		if reached[root.Child("$defs", name).String()] {
+			if partial.Defs == nil {
+				partial.Defs = make(map[string]*Schema)
+			}
+			partial.Defs[name] = def
+		}
+	}
+	for name, def := range doc.Definitions {
+		if reached[root.Child("definitions", name).String()] {
+			if partial.Definitions == nil {
+				partial.Definitions = make(map[string]*Schema)
+			}
+			partial.Definitions[name] = def
+		}
+	}
+	return &partial
+}
@@ -0,0 +1,61 @@
+package linter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestLintAt(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {"Bad_Root": {"type": "string"}},
+		"$defs": {
+			"Order": {"type": "object", "properties": {"Bad_Order": {"type": "string"}, "items": {"type": "array", "items": {"$ref": "#/$defs/Item"}}}},
+			"Item": {"type": "object", "properties": {"Bad_Item": {"type": "string"}}},
+			"Other": {"type": "object", "properties": {"Bad_Other": {"type": "string"}}}
+		}
+	}`)
+	l := NewWithDefaults()
+
+	result, err := l.LintAt(schema, "#/$defs/Order")
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var paths []string
+	for _, issue := range result.Issues {
+		if !strings.HasPrefix(issue.Path.String(), "$/$defs/Order") {
+			t.Errorf("Expected only issues in Order, got %s at %s", issue.Code, issue.Path)
+		}
+		paths = append(paths, issue.Path.String())
+	}
+	if !strings.Contains(strings.Join(paths, " "), "$/$defs/Order/properties/Bad_Order") {
+		t.Errorf("Expected the property case issue in Order, got %v", paths)
+	}
+
+	result, err = l.LintAt(schema, "/$defs/Order/properties/items")
+	if err != nil {
+		t.Fatalf("Failed to lint a nested pointer: %v", err)
+	}
+	for _, issue := range result.Issues {
+		if !strings.HasPrefix(issue.Path.String(), "$/$defs/Order/properties/items") {
+			t.Errorf("Expected only issues in items, got %s at %s", issue.Code, issue.Path)
+		}
+	}
+
+	whole, err := l.Lint(schema)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if result, err = l.LintAt(schema, "#"); err != nil || len(result.Issues) != len(whole.Issues) {
+		t.Errorf("Expected the root pointer to lint the whole document, got %v", err)
+	}
+
+	_, err = l.LintAt(schema, "#/$defs/Missing")
+	if !errors.Is(err, ErrUnresolvedRef) {
+		t.Errorf("Expected ErrUnresolvedRef for a missing definition, got %v", err)
+	}
+	if _, err := l.LintAt([]byte(`[{"type": "object"}]`), "#"); err == nil {
+		t.Error("Expected an error for a composite document")
+	}
+}
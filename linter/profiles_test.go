@@ -0,0 +1,123 @@
+package linter_test
+
+import (
+	"testing"
+
+	"github.com/grokify/schemakit/linter"
+	lt "github.com/grokify/schemakit/linter/linttest"
+)
+
+func profileConfig(profile linter.Profile, propertyCase linter.PropertyCase) *linter.Config {
+	config := linter.DefaultConfig()
+	config.Profile = profile
+	if propertyCase != "" {
+		config.PropertyCase = propertyCase
+	}
+	return &config
+}
+
+func TestScaleProfile(t *testing.T) {
+	scale := profileConfig(linter.ProfileScale, "")
+	dogOrCat := []lt.S{lt.Variant("type", "dog", nil), lt.Variant("type", "cat", nil)}
+
+	lt.Run(t, []lt.Case{
+		{
+			Name:   "disallows anyOf",
+			Schema: lt.Defs(lt.S{"Animal": lt.AnyOf(dogOrCat...)}),
+			Config: scale,
+			Want:   []lt.Want{{Code: linter.CodeCompositionDisallowed, Path: "$/$defs/Animal/anyOf", Severity: linter.SeverityError}},
+		},
+		{
+			Name:   "disallows oneOf",
+			Schema: lt.Defs(lt.S{"Animal": lt.OneOf(dogOrCat...)}),
+			Config: scale,
+			Want:   []lt.Want{{Code: linter.CodeCompositionDisallowed, Path: "$/$defs/Animal/oneOf", Severity: linter.SeverityError}},
+		},
+		{
+			Name: "disallows allOf",
+			Schema: lt.Defs(lt.S{"Combined": lt.AllOf(
+				lt.Object(lt.S{"name": lt.String()}),
+				lt.Object(lt.S{"age": lt.Integer()}),
+			)}),
+			Config: scale,
+			Want:   []lt.Want{{Code: linter.CodeCompositionDisallowed, Path: "$/$defs/Combined/allOf", Severity: linter.SeverityError}},
+		},
+		{
+			Name:   "disallows additionalProperties",
+			Schema: lt.Object(lt.S{"name": lt.String()}).With("additionalProperties", true),
+			Config: scale,
+			Want:   []lt.Want{{Code: linter.CodeAdditionalPropsDisallowed, Severity: linter.SeverityError}},
+		},
+		{
+			Name:   "requires type",
+			Schema: lt.Defs(lt.S{"Person": lt.S{"properties": lt.S{"name": lt.String()}}}),
+			Config: scale,
+			Want:   []lt.Want{{Code: linter.CodeMissingType, Path: "$/$defs/Person", Severity: linter.SeverityError}},
+		},
+		{
+			Name:   "disallows mixed types",
+			Schema: lt.Defs(lt.S{"StringOrNumber": lt.S{"type": []string{"string", "number"}}}),
+			Config: scale,
+			Want:   []lt.Want{{Code: linter.CodeMixedTypeDisallowed, Path: "$/$defs/StringOrNumber", Severity: linter.SeverityError}},
+		},
+		{
+			Name: "disallows dynamic references",
+			Schema: lt.Object(lt.S{"children": lt.S{"type": "array", "items": lt.S{"$dynamicRef": "#node"}}}).
+				With("$dynamicAnchor", "node"),
+			Config: scale,
+			Want:   []lt.Want{{Code: linter.CodeDynamicRefDisallowed, Path: "$/properties/children/items/$dynamicRef", Severity: linter.SeverityError}},
+		},
+		{
+			Name: "valid schema",
+			Schema: lt.Object(lt.S{"name": lt.String(), "age": lt.Integer()}).
+				With("$schema", "https://json-schema.org/draft/2020-12/schema").
+				With("additionalProperties", false),
+			Config:   scale,
+			NoErrors: true,
+		},
+	})
+}
+
+func TestDefaultProfileAllowsComposition(t *testing.T) {
+	lt.Run(t, []lt.Case{{
+		Name:    "anyOf",
+		Schema:  lt.Defs(lt.S{"Animal": lt.AnyOf(lt.Variant("type", "dog", nil), lt.Variant("type", "cat", nil))}),
+		NotWant: []linter.IssueCode{linter.CodeCompositionDisallowed},
+	}})
+}
+
+func TestNavigableProfile(t *testing.T) {
+	navigable := profileConfig(linter.ProfileNavigable, "")
+	navigableSnake := profileConfig(linter.ProfileNavigable, linter.CaseSnake)
+	event := lt.Object(lt.S{"event_id": lt.String(), "description": lt.String()})
+
+	lt.Run(t, []lt.Case{
+		{
+			// 3 levels of object nesting exceeds the default max of 2.
+			Name: "deep nesting",
+			Schema: lt.Object(lt.S{"level1": lt.Object(lt.S{"level2": lt.Object(lt.S{"level3": lt.Object(lt.S{
+				"value": lt.String(),
+			})})})}),
+			Config: navigable,
+			Want:   []lt.Want{{Code: linter.CodeDeepNesting}},
+		},
+		{
+			Name:     "valid schema",
+			Schema:   lt.Object(lt.S{"timeline": lt.Array(event)}),
+			Config:   navigableSnake,
+			NoErrors: true,
+		},
+		{
+			Name:   "array items without ID",
+			Schema: lt.Object(lt.S{"items": lt.Array(lt.Object(lt.S{"name": lt.String(), "value": lt.Type("number")}))}),
+			Config: navigable,
+			Want:   []lt.Want{{Code: linter.CodeMissingID}},
+		},
+		{
+			Name:    "array items with ID",
+			Schema:  lt.Object(lt.S{"events": lt.Array(event)}),
+			Config:  navigableSnake,
+			NotWant: []linter.IssueCode{linter.CodeMissingID},
+		},
+	})
+}
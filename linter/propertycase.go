@@ -0,0 +1,198 @@
+package linter
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// caseConventions are the conventions CaseAuto chooses from, in the order
+// that breaks ties.
+var caseConventions = []PropertyCase{CaseCamel, CaseSnake, CaseKebab, CasePascal}
+
+// caseSample counts the property names of a document that follow each
+// case convention, to infer the dominant one for CaseAuto.
+type caseSample map[PropertyCase]int
+
+// add counts the property names of schema and its definitions.
+func (s caseSample) add(schema *Schema) {
+	visit := func(node *Schema, _ Path, _ bool) {
+		for name := range node.Properties {
+			s.count(name, caseConventions)
+		}
+	}
+	walkSchema(schema, Path{}, false, visit)
+	for _, name := range sortedKeys(schema.Defs) {
+		walkSchema(schema.Defs[name], Path{}, false, visit)
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		walkSchema(schema.Definitions[name], Path{}, false, visit)
+	}
+}
+
+// count counts a name for each of conventions it follows.
+func (s caseSample) count(name string, conventions []PropertyCase) {
+	for _, convention := range conventions {
+		if matchesCase(name, convention) {
+			s[convention]++
+		}
+	}
+}
+
+// dominant returns the one of conventions followed by the most names, the
+// first on a tie, or CaseNone if no name was counted. Single-word names
+// such as "id" follow several conventions, so names of several words
+// decide.
+func (s caseSample) dominant(conventions []PropertyCase) PropertyCase {
+	best := CaseNone
+	for _, convention := range conventions {
+		if s[convention] > s[best] {
+			best = convention
+		}
+	}
+	return best
+}
+
+// matchesCase reports whether name follows convention.
+func matchesCase(name string, convention PropertyCase) bool {
+	switch convention {
+	case CaseCamel:
+		return isCamelCase(name)
+	case CaseSnake:
+		return isSnakeCase(name)
+	case CaseKebab:
+		return isKebabCase(name)
+	case CasePascal:
+		return isPascalCase(name)
+	case CaseScreamingSnake:
+		return isScreamingSnakeCase(name)
+	}
+	return true
+}
+
+// sampleCase counts the property names of doc.
+func sampleCase(doc *Schema) caseSample {
+	sample := make(caseSample)
+	sample.add(doc)
+	return sample
+}
+
+// withInferredCase returns, for CaseAuto, a copy of the linter enforcing
+// the dominant convention of sample, or l.
+func (l *Linter) withInferredCase(sample caseSample) *Linter {
+	if l.config.PropertyCase != CaseAuto {
+		return l
+	}
+	scoped := *l
+	scoped.config.PropertyCase = sample.dominant(caseConventions)
+	scoped.inferredCase = true
+	return &scoped
+}
+
+// followsCase reports whether a property name follows the PropertyCase of
+// the configuration, writing the words of Acronyms as such: all capitals,
+// or all lowercase as the first word of a camelCase name.
+func (c Config) followsCase(name string) bool {
+	if !matchesCase(name, c.PropertyCase) {
+		return false
+	}
+	if len(c.Acronyms) == 0 || c.PropertyCase != CaseCamel && c.PropertyCase != CasePascal {
+		return true
+	}
+	return toCase(name, c.PropertyCase, c.Acronyms) == name
+}
+
+// toCase returns name in convention, writing the words of acronyms as
+// such (e.g., "html_url" becomes "htmlURL" in camelCase with the acronym
+// URL). Words in capitals in a name that also has lowercase letters are
+// kept as acronyms.
+func toCase(name string, convention PropertyCase, acronyms []string) string {
+	words := splitWords(name, acronyms)
+	if len(words) == 0 {
+		return name
+	}
+	mixed := strings.ToUpper(name) != name
+	for i, word := range words {
+		first := i == 0 && convention == CaseCamel
+		switch {
+		case convention == CaseSnake || convention == CaseKebab || first:
+			words[i] = strings.ToLower(word)
+		case convention == CaseScreamingSnake:
+			words[i] = strings.ToUpper(word)
+		case acronym(word, acronyms) != "":
+			words[i] = acronym(word, acronyms)
+		case mixed && len(word) > 1 && strings.ToUpper(word) == word:
+			// An acronym not in the list
+		default:
+			r, size := utf8.DecodeRuneInString(word)
+			words[i] = string(unicode.ToUpper(r)) + strings.ToLower(word[size:])
+		}
+	}
+	switch convention {
+	case CaseSnake, CaseScreamingSnake:
+		return strings.Join(words, "_")
+	case CaseKebab:
+		return strings.Join(words, "-")
+	case CaseCamel, CasePascal:
+		return strings.Join(words, "")
+	}
+	return name
+}
+
+// splitWords splits a property name into its words, at underscores,
+// hyphens, and changes of case. A run of capitals is one word, up to the
+// capital starting the next word ("HTMLBody" is "HTML" and "Body"), and
+// the plural of an acronym is one word ("userIDs" is "user" and "IDs").
+func splitWords(name string, acronyms []string) []string {
+	var words []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			words = append(words, string(word))
+			word = nil
+		}
+	}
+	runes := []rune(name)
+	for i, r := range runes {
+		if r == '_' || r == '-' {
+			flush()
+			continue
+		}
+		if unicode.IsUpper(r) && len(word) > 0 {
+			prev, next := runes[i-1], rune(0)
+			if i+1 < len(runes) {
+				next = runes[i+1]
+			}
+			if !unicode.IsUpper(prev) || unicode.IsLower(next) && !pluralAcronym(string(word)+string(r), runes[i+1:], acronyms) {
+				flush()
+			}
+		}
+		word = append(word, r)
+	}
+	flush()
+	return words
+}
+
+// pluralAcronym reports whether word is an acronym followed by the "s" of
+// its plural, ending its word in rest.
+func pluralAcronym(word string, rest []rune, acronyms []string) bool {
+	if len(rest) == 0 || rest[0] != 's' || len(rest) > 1 && unicode.IsLower(rest[1]) {
+		return false
+	}
+	return acronym(word, acronyms) != ""
+}
+
+// acronym returns word written as the acronym it is, in capitals with the
+// "s" of a plural kept (e.g., "IDs" for "ids"), or "" if it is not one of
+// acronyms.
+func acronym(word string, acronyms []string) string {
+	for _, a := range acronyms {
+		switch {
+		case strings.EqualFold(word, a):
+			return strings.ToUpper(a)
+		case len(word) == len(a)+1 && strings.EqualFold(word[:len(a)], a) && word[len(a)] == 's':
+			return strings.ToUpper(a) + "s"
+		}
+	}
+	return ""
+}
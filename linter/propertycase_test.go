@@ -0,0 +1,123 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPropertyCaseAuto(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {"user_name": {"type": "string"}, "created_at": {"type": "string"}, "id": {"type": "string"}},
+		"$defs": {
+			"Order": {"type": "object", "properties": {"order_id": {"type": "string"}, "lineItems": {"type": "array"}}}
+		}
+	}`)
+	config := DefaultConfig()
+	config.PropertyCase = CaseAuto
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Expected auto to be valid: %v", err)
+	}
+	result, err := New(config).Lint(schema)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var paths []string
+	for _, issue := range result.Issues {
+		if issue.Code == CodeInvalidPropertyCase {
+			paths = append(paths, issue.Path.String())
+			if !strings.Contains(issue.Message, "snake_case, the convention of most properties") {
+				t.Errorf("Expected the inferred convention in the message, got %q", issue.Message)
+			}
+		}
+	}
+	if len(paths) != 1 || paths[0] != "$/$defs/Order/properties/lineItems" {
+		t.Errorf("Expected only the camelCase outlier, got %v", paths)
+	}
+
+	// The part linted by LintAt uses the convention of the whole document
+	result, err = New(config).LintAt(schema, "#/$defs/Order")
+	if err != nil {
+		t.Fatalf("Failed to lint at a pointer: %v", err)
+	}
+	paths = nil
+	for _, issue := range result.Issues {
+		if issue.Code == CodeInvalidPropertyCase {
+			paths = append(paths, issue.Path.String())
+		}
+	}
+	if len(paths) != 1 || paths[0] != "$/$defs/Order/properties/lineItems" {
+		t.Errorf("Expected only the outlier at the pointer, got %v", paths)
+	}
+}
+
+func TestCaseSampleDominant(t *testing.T) {
+	tests := []struct {
+		names []string
+		want  PropertyCase
+	}{
+		{nil, CaseNone},
+		{[]string{"id", "name"}, CaseCamel},
+		{[]string{"id", "first-name", "last-name", "fullName"}, CaseKebab},
+		{[]string{"Id", "FirstName", "last_name"}, CasePascal},
+	}
+	for _, tt := range tests {
+		schema := &Schema{Properties: make(map[string]*Schema)}
+		for _, name := range tt.names {
+			schema.Properties[name] = &Schema{}
+		}
+		if got := sampleCase(schema).dominant(caseConventions); got != tt.want {
+			t.Errorf("dominant(%v) = %s, want %s", tt.names, got, tt.want)
+		}
+	}
+}
+
+func TestAcronymCase(t *testing.T) {
+	acronyms := []string{"ID", "URL", "API", "HTML"}
+	tests := []struct {
+		name       string
+		convention PropertyCase
+		want       bool
+	}{
+		{"htmlURL", CaseCamel, true},
+		{"apiKey", CaseCamel, true},
+		{"userIDs", CaseCamel, true},
+		{"userId", CaseCamel, false},
+		{"htmlUrl", CaseCamel, false},
+		{"userXYZ", CaseCamel, true},
+		{"HTMLBody", CasePascal, true},
+		{"HtmlBody", CasePascal, false},
+		{"user_id", CaseSnake, true},
+	}
+	for _, tt := range tests {
+		config := Config{PropertyCase: tt.convention, Acronyms: acronyms}
+		if got := config.followsCase(tt.name); got != tt.want {
+			t.Errorf("followsCase(%q, %s) = %v, want %v", tt.name, tt.convention, got, tt.want)
+		}
+	}
+	if config := (Config{PropertyCase: CaseCamel}); !config.followsCase("userId") {
+		t.Error("Expected userId to follow camelCase without acronyms")
+	}
+}
+
+func TestToCase(t *testing.T) {
+	acronyms := []string{"ID", "URL", "HTML"}
+	tests := []struct {
+		name       string
+		convention PropertyCase
+		want       string
+	}{
+		{"html_url", CaseCamel, "htmlURL"},
+		{"user_id", CasePascal, "UserID"},
+		{"userId", CaseCamel, "userID"},
+		{"HTMLBody", CaseSnake, "html_body"},
+		{"userIDs", CaseKebab, "user-ids"},
+		{"USER_NAME", CaseCamel, "userName"},
+		{"first-name", CasePascal, "FirstName"},
+	}
+	for _, tt := range tests {
+		if got := toCase(tt.name, tt.convention, acronyms); got != tt.want {
+			t.Errorf("toCase(%q, %s) = %q, want %q", tt.name, tt.convention, got, tt.want)
+		}
+	}
+}
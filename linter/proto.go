@@ -0,0 +1,495 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// protoValue is the proto type for values with no proto3 equivalent.
+const protoValue = "google.protobuf.Value"
+
+// GenerateProto returns a proto3 preview of the schema: a message for the
+// root schema (named by its title) and each object definition, enums for
+// string enums, and oneof blocks for discriminated unions. Constructs with
+// no proto3 equivalent, such as undiscriminated unions or nested arrays,
+// become google.protobuf.Value and are reported as proto-unrepresentable
+// issues. Property order is not part of the schema model, so fields are
+// numbered in sorted name order.
+func GenerateProto(schema *Schema, pkg string) (string, []Issue) {
+	g := &protoGen{doc: schema, issues: []Issue{}, imports: make(map[string]bool), inlining: make(map[string]bool)}
+
+	var body strings.Builder
+	switch {
+	case g.declarable(schema):
+		g.declare(&body, g.rootName(), schema, rootPath, "")
+	case !isDefinitionBundle(schema):
+		g.issues = append(g.issues, Issue{
+			Code:       CodeProtoUnrepresentable,
+			Severity:   SeverityWarning,
+			Path:       rootPath,
+			Message:    "Root schema is not an object with properties, a string enum, or a discriminated union, so it has no message",
+			Suggestion: "Wrap the root value in an object property",
+		})
+	}
+	for _, defs := range []struct {
+		keyword string
+		schemas map[string]*Schema
+	}{{"$defs", schema.Defs}, {"definitions", schema.Definitions}} {
+		for _, name := range sortedKeys(defs.schemas) {
+			def := defs.schemas[name]
+			path := rootPath.Child(defs.keyword, name)
+			if g.declarable(def) {
+				g.declare(&body, protoPascal(name), def, path, "")
+			}
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString("syntax = \"proto3\";\n")
+	if pkg != "" {
+		fmt.Fprintf(&sb, "\npackage %s;\n", pkg)
+	}
+	if len(g.imports) > 0 {
+		sb.WriteString("\n")
+		imports := make([]string, 0, len(g.imports))
+		for imp := range g.imports {
+			imports = append(imports, imp)
+		}
+		sort.Strings(imports)
+		for _, imp := range imports {
+			fmt.Fprintf(&sb, "import %q;\n", imp)
+		}
+	}
+	sb.WriteString(body.String())
+	return sb.String(), g.issues
+}
+
+type protoGen struct {
+	doc     *Schema
+	issues  []Issue
+	imports map[string]bool
+	// inlining holds the definitions being inlined, to stop cycles through
+	// definitions that have no message of their own.
+	inlining map[string]bool
+}
+
+// protoDecl is a nested message or enum to declare inside a message.
+type protoDecl struct {
+	name   string
+	schema *Schema
+	path   Path
+}
+
+func (g *protoGen) unrepresentable(path Path, message string) string {
+	g.issues = append(g.issues, Issue{
+		Code:       CodeProtoUnrepresentable,
+		Severity:   SeverityWarning,
+		Path:       path,
+		Message:    message + "; using " + protoValue,
+		Suggestion: "Restructure the schema, or handle the field as a dynamic value",
+	})
+	g.imports["google/protobuf/struct.proto"] = true
+	return protoValue
+}
+
+func (g *protoGen) rootName() string {
+	if g.doc.Title != "" {
+		return protoPascal(g.doc.Title)
+	}
+	return "Root"
+}
+
+// declarable reports whether a schema is declared as a message or enum of
+// its own: objects with properties, string enums, and discriminated unions.
+func (g *protoGen) declarable(s *Schema) bool {
+	if s == nil || s.IsRef() {
+		return false
+	}
+	if isStringEnum(s) {
+		return true
+	}
+	if variants := nonNullVariants(s); len(variants) > 1 {
+		_, ok := g.discriminator(variants)
+		return ok
+	}
+	return len(s.Properties) > 0
+}
+
+// declare writes the message or enum for a declarable schema.
+func (g *protoGen) declare(sb *strings.Builder, name string, s *Schema, path Path, indent string) {
+	sb.WriteString("\n")
+	if isStringEnum(s) {
+		g.writeEnum(sb, name, s, indent)
+		return
+	}
+	if variants := nonNullVariants(s); len(variants) > 1 {
+		g.writeOneof(sb, name, s, variants, path, indent)
+		return
+	}
+	g.writeMessage(sb, name, s, path, indent)
+}
+
+func (g *protoGen) writeMessage(sb *strings.Builder, name string, s *Schema, path Path, indent string) {
+	fmt.Fprintf(sb, "%smessage %s {\n", indent, name)
+	var nested []protoDecl
+	for i, prop := range sortedKeys(s.Properties) {
+		propPath := path.Child("properties", prop)
+		typ := g.fieldType(s.Properties[prop], prop, propPath, &nested)
+		field := protoSnake(prop)
+		option := ""
+		if protoJSONName(field) != prop {
+			option = fmt.Sprintf(" [json_name = %q]", prop)
+		}
+		fmt.Fprintf(sb, "%s  %s %s = %d%s;\n", indent, typ, field, i+1, option)
+	}
+	for _, decl := range nested {
+		g.declare(sb, decl.name, decl.schema, decl.path, indent+"  ")
+	}
+	fmt.Fprintf(sb, "%s}\n", indent)
+}
+
+// writeOneof writes a message wrapping a oneof of the union's variants.
+func (g *protoGen) writeOneof(sb *strings.Builder, name string, s *Schema, variants []*Schema, path Path, indent string) {
+	disc, _ := g.discriminator(variants)
+	keyword := "anyOf"
+	if len(s.AnyOf) == 0 {
+		keyword = "oneOf"
+	}
+	fmt.Fprintf(sb, "%smessage %s {\n", indent, name)
+	fmt.Fprintf(sb, "%s  oneof %s {\n", indent, protoSnake(name))
+	var nested []protoDecl
+	for i, v := range variants {
+		target, _ := g.resolve(v)
+		value, _ := target.Properties[disc].Const.(string)
+		typ := ""
+		if ref := v.RefTarget(); ref != "" {
+			typ = g.refName(ref)
+		} else {
+			typ = protoPascal(value)
+			nested = append(nested, protoDecl{typ, v, path.Child(keyword).Index(i)})
+		}
+		fmt.Fprintf(sb, "%s    %s %s = %d;\n", indent, typ, protoSnake(value), i+1)
+	}
+	fmt.Fprintf(sb, "%s  }\n", indent)
+	for _, decl := range nested {
+		g.declare(sb, decl.name, decl.schema, decl.path, indent+"  ")
+	}
+	fmt.Fprintf(sb, "%s}\n", indent)
+}
+
+func (g *protoGen) writeEnum(sb *strings.Builder, name string, s *Schema, indent string) {
+	prefix := protoUpperSnake(name)
+	fmt.Fprintf(sb, "%senum %s {\n", indent, name)
+	fmt.Fprintf(sb, "%s  %s_UNSPECIFIED = 0;\n", indent, prefix)
+	for i, v := range s.Enum {
+		fmt.Fprintf(sb, "%s  %s_%s = %d;\n", indent, prefix, protoUpperSnake(v.(string)), i+1)
+	}
+	fmt.Fprintf(sb, "%s}\n", indent)
+}
+
+// fieldType returns the proto type of a field, adding nested messages and
+// enums it needs to nested.
+func (g *protoGen) fieldType(s *Schema, name string, path Path, nested *[]protoDecl) string {
+	if s == nil || (s.IsBooleanSchema && s.BooleanValue) {
+		return g.unrepresentable(path, "Value of any type")
+	}
+	if s.IsBooleanSchema {
+		return g.unrepresentable(path, "Schema false accepts no value")
+	}
+	if ref := s.RefTarget(); ref != "" {
+		target, ok := g.resolve(s)
+		if !ok {
+			return g.unrepresentable(path, fmt.Sprintf("Unresolved $ref %q", ref))
+		}
+		if g.declarable(target) {
+			return g.refName(ref)
+		}
+		if g.inlining[ref] {
+			return g.unrepresentable(path, fmt.Sprintf("Recursive $ref %q to a schema that is not a message", ref))
+		}
+		g.inlining[ref] = true
+		defer delete(g.inlining, ref)
+		return g.fieldType(target, name, path, nested)
+	}
+	if len(s.AllOf) > 0 {
+		return g.unrepresentable(path.Child("allOf"), "allOf has no proto3 equivalent")
+	}
+
+	if s.IsUnion() {
+		variants := nonNullVariants(s)
+		keyword := "anyOf"
+		if len(s.AnyOf) == 0 {
+			keyword = "oneOf"
+		}
+		switch {
+		case len(variants) == 1:
+			return g.optional(g.fieldType(variants[0], name, path.Child(keyword), nested))
+		case g.declarable(s):
+			*nested = append(*nested, protoDecl{protoPascal(name), s, path})
+			return protoPascal(name)
+		}
+		return g.unrepresentable(path.Child(keyword), "Union without a discriminator")
+	}
+
+	if isStringEnum(s) {
+		*nested = append(*nested, protoDecl{protoPascal(name), s, path})
+		return protoPascal(name)
+	}
+
+	var kinds []string
+	for _, t := range s.TypeList {
+		if t != "null" {
+			kinds = append(kinds, t)
+		}
+	}
+	if len(kinds) > 1 {
+		return g.unrepresentable(path.Child("type"), fmt.Sprintf("Type union of %s", strings.Join(kinds, ", ")))
+	}
+	kind := schemaKind(s)
+	if kind == "" && s.Const != nil {
+		kind = constKind(s.Const)
+	}
+	typ := ""
+	switch kind {
+	case "string":
+		typ = "string"
+	case "integer":
+		typ = "int64"
+	case "number":
+		typ = "double"
+	case "boolean":
+		typ = "bool"
+	case "array":
+		return g.arrayType(s, name, path, nested)
+	case "object":
+		switch {
+		case len(s.Properties) > 0:
+			*nested = append(*nested, protoDecl{protoPascal(name), s, path})
+			typ = protoPascal(name)
+		case s.AdditionalPropertiesSchema != nil:
+			value := g.fieldType(s.AdditionalPropertiesSchema, name+"Value", path.Child("additionalProperties"), nested)
+			if strings.HasPrefix(value, "repeated ") || strings.HasPrefix(value, "map<") || strings.HasPrefix(value, "optional ") {
+				return g.unrepresentable(path.Child("additionalProperties"), "Map values cannot be repeated, maps, or optional")
+			}
+			return fmt.Sprintf("map<string, %s>", value)
+		default:
+			g.imports["google/protobuf/struct.proto"] = true
+			typ = "google.protobuf.Struct"
+		}
+	case "null":
+		return g.unrepresentable(path, "Schema accepts only null")
+	default:
+		return g.unrepresentable(path, "Value of any type")
+	}
+	if len(s.TypeList) > 1 {
+		return g.optional(typ)
+	}
+	return typ
+}
+
+func (g *protoGen) arrayType(s *Schema, name string, path Path, nested *[]protoDecl) string {
+	if s.Items == nil {
+		return g.unrepresentable(path, "Array without items")
+	}
+	item := g.fieldType(s.Items, name+"Item", path.Child("items"), nested)
+	if strings.HasPrefix(item, "repeated ") || strings.HasPrefix(item, "map<") {
+		return g.unrepresentable(path.Child("items"), "Array items cannot be repeated or maps")
+	}
+	if strings.HasPrefix(item, "optional ") {
+		return g.unrepresentable(path.Child("items"), "Array items cannot be null")
+	}
+	return "repeated " + item
+}
+
+// optional marks a scalar or message type as optional; repeated and map
+// fields cannot be optional and are returned unchanged.
+func (g *protoGen) optional(typ string) string {
+	if strings.HasPrefix(typ, "repeated ") || strings.HasPrefix(typ, "map<") || strings.HasPrefix(typ, "optional ") || typ == protoValue {
+		return typ
+	}
+	return "optional " + typ
+}
+
+// resolve returns the schema a variant refers to, or the variant itself.
+func (g *protoGen) resolve(s *Schema) (*Schema, bool) {
+	ref := s.RefTarget()
+	if ref == "" {
+		return s, true
+	}
+	target, _, ok := resolveLocalRef(g.doc, rootPath, ref)
+	if !ok || target == nil {
+		return s, false
+	}
+	return target, true
+}
+
+// refName returns the message or enum name of a local $ref.
+func (g *protoGen) refName(ref string) string {
+	if ref == "#" {
+		return g.rootName()
+	}
+	if _, target, ok := resolveLocalRef(g.doc, NewPath(graphRootID), ref); ok {
+		ref = target.String()
+	}
+	id := definitionID(ref)
+	if id == graphRootID {
+		return g.rootName()
+	}
+	return protoPascal(id[strings.LastIndex(id, "/")+1:])
+}
+
+// discriminator returns the property with a distinct string const in every
+// variant, in sorted order, if all variants are objects.
+func (g *protoGen) discriminator(variants []*Schema) (string, bool) {
+	targets := make([]*Schema, 0, len(variants))
+	for _, v := range variants {
+		target, ok := g.resolve(v)
+		if !ok || target == nil || len(target.Properties) == 0 {
+			return "", false
+		}
+		targets = append(targets, target)
+	}
+	for _, name := range sortedKeys(targets[0].Properties) {
+		seen := make(map[string]bool)
+		for _, t := range targets {
+			prop := t.Properties[name]
+			if prop == nil {
+				break
+			}
+			if value, ok := prop.Const.(string); ok && !seen[value] {
+				seen[value] = true
+			}
+		}
+		if len(seen) == len(targets) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// constKind returns the JSON type of a const value.
+func constKind(v any) string {
+	switch v := v.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == float64(int64(v)) {
+			return "integer"
+		}
+		return "number"
+	case json.Number:
+		if !strings.ContainsAny(v.String(), ".eE") {
+			return "integer"
+		}
+		if f, err := v.Float64(); err == nil && f == math.Trunc(f) {
+			return "integer"
+		}
+		return "number"
+	}
+	return ""
+}
+
+// nonNullVariants returns the anyOf/oneOf variants other than {"type": "null"}.
+func nonNullVariants(s *Schema) []*Schema {
+	var variants []*Schema
+	for _, v := range s.GetUnionVariants() {
+		if v != nil && v.Type == "null" && len(v.TypeList) <= 1 {
+			continue
+		}
+		variants = append(variants, v)
+	}
+	return variants
+}
+
+// isStringEnum reports whether the schema is an enum of strings only.
+func isStringEnum(s *Schema) bool {
+	if len(s.Enum) == 0 {
+		return false
+	}
+	for _, v := range s.Enum {
+		if _, ok := v.(string); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// protoWords splits a name into words at non-alphanumeric characters and
+// case changes (e.g., "HTTPServer_id" is "HTTP", "Server", "id").
+func protoWords(s string) []string {
+	var words []string
+	var word []rune
+	runes := []rune(s)
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if len(word) > 0 {
+				words, word = append(words, string(word)), nil
+			}
+			continue
+		}
+		if len(word) > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				words, word = append(words, string(word)), nil
+			}
+		}
+		word = append(word, r)
+	}
+	if len(word) > 0 {
+		words = append(words, string(word))
+	}
+	return words
+}
+
+// protoPascal returns the name in PascalCase, for messages and enums.
+func protoPascal(s string) string {
+	var sb strings.Builder
+	for _, w := range protoWords(s) {
+		r := []rune(strings.ToLower(w))
+		r[0] = unicode.ToUpper(r[0])
+		sb.WriteString(string(r))
+	}
+	if sb.Len() == 0 || unicode.IsDigit(rune(sb.String()[0])) {
+		return "X" + sb.String()
+	}
+	return sb.String()
+}
+
+// protoSnake returns the name in snake_case, for fields.
+func protoSnake(s string) string {
+	name := strings.ToLower(strings.Join(protoWords(s), "_"))
+	if name == "" || unicode.IsDigit(rune(name[0])) {
+		return "x_" + name
+	}
+	return name
+}
+
+// protoUpperSnake returns the name in UPPER_SNAKE_CASE, for enum values.
+func protoUpperSnake(s string) string {
+	return strings.ToUpper(protoSnake(s))
+}
+
+// protoJSONName returns the JSON name protoc derives from a field name.
+func protoJSONName(field string) string {
+	var sb strings.Builder
+	upper := false
+	for _, r := range field {
+		if r == '_' {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		sb.WriteRune(r)
+	}
+	return sb.String()
+}
@@ -0,0 +1,128 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGenerateProto(t *testing.T) {
+	schema := `{
+		"title": "order event",
+		"type": "object",
+		"properties": {
+			"orderId": {"type": "string"},
+			"created_at": {"type": "string"},
+			"total": {"type": ["number", "null"]},
+			"status": {"type": "string", "enum": ["pending", "shipped"]},
+			"tags": {"type": "array", "items": {"type": "string"}},
+			"attrs": {"type": "object", "additionalProperties": {"type": "integer"}},
+			"payment": {"oneOf": [
+				{"$ref": "#/$defs/Card"},
+				{"type": "object", "properties": {"kind": {"const": "bank_transfer"}, "iban": {"type": "string"}}}
+			]},
+			"matrix": {"type": "array", "items": {"type": "array", "items": {"type": "number"}}},
+			"value": {"anyOf": [{"type": "string"}, {"type": "integer"}]}
+		},
+		"$defs": {
+			"Card": {"type": "object", "properties": {"kind": {"const": "card"}, "tier": {"$ref": "#/$defs/Tier"}}},
+			"Tier": {"type": "string", "enum": ["gold", "silver"]}
+		}
+	}`
+	want := `syntax = "proto3";
+
+package orders.v1;
+
+import "google/protobuf/struct.proto";
+
+message OrderEvent {
+  map<string, int64> attrs = 1;
+  string created_at = 2 [json_name = "created_at"];
+  google.protobuf.Value matrix = 3;
+  string order_id = 4;
+  Payment payment = 5;
+  Status status = 6;
+  repeated string tags = 7;
+  optional double total = 8;
+  google.protobuf.Value value = 9;
+
+  message Payment {
+    oneof payment {
+      Card card = 1;
+      BankTransfer bank_transfer = 2;
+    }
+
+    message BankTransfer {
+      string iban = 1;
+      string kind = 2;
+    }
+  }
+
+  enum Status {
+    STATUS_UNSPECIFIED = 0;
+    STATUS_PENDING = 1;
+    STATUS_SHIPPED = 2;
+  }
+}
+
+message Card {
+  string kind = 1;
+  Tier tier = 2;
+}
+
+enum Tier {
+  TIER_UNSPECIFIED = 0;
+  TIER_GOLD = 1;
+  TIER_SILVER = 2;
+}
+`
+
+	s, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	proto, issues := GenerateProto(s, "orders.v1")
+	if proto != want {
+		t.Errorf("Unexpected proto:\n%s", proto)
+	}
+
+	paths := []string{"$/properties/matrix/items", "$/properties/value/anyOf"}
+	if len(issues) != len(paths) {
+		t.Fatalf("Expected %d issues, got: %v", len(paths), issues)
+	}
+	for i, issue := range issues {
+		if issue.Code != CodeProtoUnrepresentable || issue.Path.String() != paths[i] {
+			t.Errorf("Expected proto-unrepresentable at %s, got: %s", paths[i], issue)
+		}
+	}
+}
+
+func TestGenerateProtoRoot(t *testing.T) {
+	s, err := ParseSchema([]byte(`{"type": "array", "items": {"type": "string"}}`))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+	proto, issues := GenerateProto(s, "")
+	if strings.Contains(proto, "message") || len(issues) != 1 || issues[0].Path.String() != "$" {
+		t.Errorf("Expected no messages and a root issue, got:\n%s%v", proto, issues)
+	}
+}
+
+func TestProtoNames(t *testing.T) {
+	tests := []struct {
+		in, pascal, snake string
+	}{
+		{"orderId", "OrderId", "order_id"},
+		{"HTTPServer", "HttpServer", "http_server"},
+		{"created_at", "CreatedAt", "created_at"},
+		{"content-type", "ContentType", "content_type"},
+		{"2fa", "X2fa", "x_2fa"},
+	}
+	for _, tt := range tests {
+		if got := protoPascal(tt.in); got != tt.pascal {
+			t.Errorf("protoPascal(%q) = %q, want %q", tt.in, got, tt.pascal)
+		}
+		if got := protoSnake(tt.in); got != tt.snake {
+			t.Errorf("protoSnake(%q) = %q, want %q", tt.in, got, tt.snake)
+		}
+	}
+}
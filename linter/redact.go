@@ -0,0 +1,258 @@
+package linter
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Redactor replaces the names and text of schemas with stable pseudonyms,
+// so that a schema can be shared (e.g., in a bug report) without leaking
+// proprietary field names, while keeping its structure and lint findings.
+// Pseudonyms are derived from a hash of the original, so the same name is
+// redacted the same way in every schema and result.
+type Redactor struct {
+	keep map[string]bool
+	// originals maps the redacted names and values to their pseudonyms
+	originals map[string]string
+}
+
+// NewRedactor returns a Redactor that keeps the given property names, such
+// as the discriminator fields lint rules look for.
+func NewRedactor(keep ...string) *Redactor {
+	r := &Redactor{keep: make(map[string]bool), originals: make(map[string]string)}
+	for _, name := range keep {
+		r.keep[name] = true
+	}
+	return r
+}
+
+// pseudonym returns the stable pseudonym of s: the prefix and the first
+// eight hex digits of its SHA-256 hash.
+func (r *Redactor) pseudonym(prefix, s string) string {
+	sum := sha256.Sum256([]byte(s))
+	p := prefix + hex.EncodeToString(sum[:4])
+	r.originals[s] = p
+	return p
+}
+
+// property returns the pseudonym of a property name.
+func (r *Redactor) property(name string) string {
+	if r.keep[name] {
+		return name
+	}
+	return r.pseudonym("f", name)
+}
+
+// definition returns the pseudonym of a definition name, in PascalCase.
+func (r *Redactor) definition(name string) string {
+	return r.pseudonym("T", name)
+}
+
+// Redact redacts a schema document or a JSON array of schemas:
+//
+//   - property names, in properties, required, and dependentRequired
+//   - definition names, with the local $refs to them
+//   - string values of enum, const, default, and examples, and the keys of
+//     object values
+//   - title, description, and $comment
+//
+// Keywords, types, constraints, patterns, $ids, and key order are kept.
+func (r *Redactor) Redact(data []byte) ([]byte, error) {
+	doc, err := decodeDocument(data)
+	if err != nil {
+		return nil, err
+	}
+
+	docs := []any{doc}
+	if d, ok := doc.([]any); ok {
+		docs = d
+	}
+	for _, d := range docs {
+		forEachSubschema(d, r.redactSchema)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(doc); err != nil {
+		return nil, fmt.Errorf("failed to serialize schema: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// redactSchema redacts the keywords of one schema. Subschemas are redacted
+// by the caller, after their keys are renamed here.
+func (r *Redactor) redactSchema(obj *jsonObject) {
+	for i := range obj.members {
+		member := &obj.members[i]
+		switch member.Key {
+		case "properties":
+			if props, ok := member.Value.(*jsonObject); ok {
+				for j := range props.members {
+					props.members[j].Key = r.property(props.members[j].Key)
+				}
+			}
+		case "$defs", "definitions":
+			if defs, ok := member.Value.(*jsonObject); ok {
+				for j := range defs.members {
+					defs.members[j].Key = r.definition(defs.members[j].Key)
+				}
+			}
+		case "required":
+			member.Value = r.redactNames(member.Value)
+		case "dependentRequired":
+			if deps, ok := member.Value.(*jsonObject); ok {
+				for j := range deps.members {
+					deps.members[j].Key = r.property(deps.members[j].Key)
+					deps.members[j].Value = r.redactNames(deps.members[j].Value)
+				}
+			}
+		case "dependencies":
+			// Draft-07 dependencies hold property arrays or schemas
+			if deps, ok := member.Value.(*jsonObject); ok {
+				for j := range deps.members {
+					deps.members[j].Key = r.property(deps.members[j].Key)
+					if _, ok := deps.members[j].Value.([]any); ok {
+						deps.members[j].Value = r.redactNames(deps.members[j].Value)
+					}
+				}
+			}
+		case "enum", "const", "default", "examples":
+			member.Value = r.redactValue(member.Value)
+		case "title":
+			if s, ok := member.Value.(string); ok {
+				member.Value = r.pseudonym("Title ", s)
+			}
+		case "description":
+			if s, ok := member.Value.(string); ok {
+				member.Value = r.pseudonym("Description ", s)
+			}
+		case "$comment":
+			if s, ok := member.Value.(string); ok {
+				member.Value = r.pseudonym("Comment ", s)
+			}
+		case "$ref", "$dynamicRef":
+			if s, ok := member.Value.(string); ok {
+				if doc, fragment, ok := strings.Cut(s, "#"); ok && strings.HasPrefix(fragment, "/") {
+					member.Value = doc + "#" + r.redactPointer(fragment)
+				}
+			}
+		}
+	}
+}
+
+// redactNames redacts an array of property names.
+func (r *Redactor) redactNames(v any) any {
+	names, ok := v.([]any)
+	if !ok {
+		return v
+	}
+	redacted := make([]any, len(names))
+	for i, name := range names {
+		if s, ok := name.(string); ok {
+			redacted[i] = r.property(s)
+		} else {
+			redacted[i] = name
+		}
+	}
+	return redacted
+}
+
+// redactValue redacts the strings and object keys of an instance value.
+func (r *Redactor) redactValue(v any) any {
+	switch value := v.(type) {
+	case string:
+		return r.pseudonym("v", value)
+	case []any:
+		redacted := make([]any, len(value))
+		for i, item := range value {
+			redacted[i] = r.redactValue(item)
+		}
+		return redacted
+	case *jsonObject:
+		for i := range value.members {
+			value.members[i].Key = r.property(value.members[i].Key)
+			value.members[i].Value = r.redactValue(value.members[i].Value)
+		}
+	}
+	return v
+}
+
+// redactPointer redacts the property and definition names of a JSON
+// pointer or issue path (e.g., "/$defs/User/properties/email").
+func (r *Redactor) redactPointer(pointer string) string {
+	return r.redactPath(ParsePath(pointer)).String()
+}
+
+// redactPath redacts the property and definition names of a path.
+func (r *Redactor) redactPath(path Path) Path {
+	segments := slices.Clone(path.Segments)
+	for i := 1; i < len(segments); i++ {
+		switch path.Segments[i-1] {
+		case "properties":
+			segments[i] = r.property(path.Segments[i])
+		case "$defs", "definitions":
+			segments[i] = r.definition(path.Segments[i])
+		}
+	}
+	return NewPath(path.Root, segments...)
+}
+
+// RedactResult redacts the paths, messages, and suggestions of a lint
+// result with the pseudonyms of the schema it is for, which must have been
+// redacted with r first. In messages, only quoted names (e.g., 'email')
+// and paths are replaced, so that other words are left alone.
+func (r *Redactor) RedactResult(result *Result) {
+	originals := make([]string, 0, len(r.originals))
+	for original := range r.originals {
+		originals = append(originals, original)
+	}
+	// Longer names first, so that 'userId' is not redacted as 'user'
+	slices.SortFunc(originals, func(a, b string) int { return len(b) - len(a) })
+	var pairs []string
+	for _, original := range originals {
+		for _, quote := range []string{"'", `"`, "`"} {
+			pairs = append(pairs, quote+original+quote, quote+r.originals[original]+quote)
+		}
+	}
+	quoted := strings.NewReplacer(pairs...)
+
+	text := func(s string) string {
+		words := strings.Split(quoted.Replace(s), " ")
+		for i, word := range words {
+			if strings.HasPrefix(word, "$/") || strings.HasPrefix(word, "#/") || strings.HasPrefix(word, "[") {
+				path := strings.TrimRight(word, ",.;:)")
+				words[i] = r.redactPointer(path) + word[len(path):]
+			}
+		}
+		return strings.Join(words, " ")
+	}
+	redact := func(issue *Issue) {
+		issue.Path = r.redactPath(issue.Path)
+		issue.Message = text(issue.Message)
+		issue.Suggestion = text(issue.Suggestion)
+		// TypeName is a Go type, named after the definition it matches
+		if issue.TypeName != "" {
+			issue.TypeName = r.definition(issue.TypeName)
+		}
+		refs := make([]string, len(issue.ReferencedBy))
+		for i, ref := range issue.ReferencedBy {
+			refs[i] = r.redactPointer(ref)
+		}
+		if len(refs) > 0 {
+			issue.ReferencedBy = refs
+		}
+	}
+	for i := range result.Issues {
+		redact(&result.Issues[i])
+	}
+	for i := range result.Suppressed {
+		redact(&result.Suppressed[i].Issue)
+	}
+}
@@ -0,0 +1,90 @@
+package linter
+
+import (
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestRedact(t *testing.T) {
+	schema := `{
+		"$id": "https://schemas.example.com/payroll.json",
+		"title": "Payroll",
+		"type": "object",
+		"properties": {
+			"salary": {"$ref": "#/$defs/Money", "description": "Gross salary"},
+			"type": {"enum": ["hourly", "salaried"], "default": "hourly"},
+			"bonus": {"type": "object", "examples": [{"salary": "1000"}]}
+		},
+		"required": ["salary", "type"],
+		"dependentRequired": {"bonus": ["salary"]},
+		"$defs": {"Money": {"type": "string", "pattern": "^[0-9]+$"}}
+	}`
+
+	r := NewRedactor("type")
+	out, err := r.Redact([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to redact: %v", err)
+	}
+	for _, leaked := range []string{"Payroll", "salary", "Gross", "hourly", "Money", "bonus"} {
+		if strings.Contains(string(out), leaked) {
+			t.Errorf("Redacted schema still contains %q:\n%s", leaked, out)
+		}
+	}
+	for _, kept := range []string{`"type"`, `"^[0-9]+$"`, "https://schemas.example.com/payroll.json"} {
+		if !strings.Contains(string(out), kept) {
+			t.Errorf("Redacted schema should keep %s:\n%s", kept, out)
+		}
+	}
+
+	var got struct {
+		Properties map[string]map[string]any `json:"properties"`
+		Required   []string                  `json:"required"`
+		Defs       map[string]any            `json:"$defs"`
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatalf("Failed to parse output: %v", err)
+	}
+	salary, money := r.property("salary"), r.definition("Money")
+	if got.Properties[salary]["$ref"] != "#/$defs/"+money || got.Defs[money] == nil {
+		t.Errorf("Expected the $ref to follow the renamed definition, got %s", out)
+	}
+	if !slices.Equal(got.Required, []string{salary, "type"}) {
+		t.Errorf("Expected required [%s type], got %v", salary, got.Required)
+	}
+
+	// The same name is redacted the same way by another redactor
+	if again, _ := NewRedactor("type").Redact([]byte(schema)); string(again) != string(out) {
+		t.Error("Redaction should be stable across redactors")
+	}
+}
+
+func TestRedactResult(t *testing.T) {
+	schema := `{"$defs": {"User": {"type": "object", "properties": {"userId": {"type": "string"}}}}}`
+	r := NewRedactor()
+	if _, err := r.Redact([]byte(schema)); err != nil {
+		t.Fatalf("Failed to redact: %v", err)
+	}
+
+	result := &Result{Issues: []Issue{{
+		Code:         CodeInvalidPropertyCase,
+		Path:         ParsePath("$/$defs/User/properties/userId"),
+		Message:      "Property 'userId' in $/$defs/User, should use a type",
+		ReferencedBy: []string{"$/properties/owner"},
+	}}}
+	refs := result.Issues[0].ReferencedBy
+	r.RedactResult(result)
+
+	issue := result.Issues[0]
+	user, userID := r.definition("User"), r.property("userId")
+	if want := "$/$defs/" + user + "/properties/" + userID; issue.Path.String() != want {
+		t.Errorf("Expected path %s, got %s", want, issue.Path)
+	}
+	if want := "Property '" + userID + "' in $/$defs/" + user + ", should use a type"; issue.Message != want {
+		t.Errorf("Expected message %q, got %q", want, issue.Message)
+	}
+	if refs[0] != "$/properties/owner" {
+		t.Error("RedactResult should not modify the ReferencedBy slice in place")
+	}
+}
@@ -0,0 +1,135 @@
+package linter
+
+import (
+	"strings"
+)
+
+// resolveLocalRef returns the document or definition referenced by a local
+// $ref or $dynamicRef and its path under root. It accepts "#",
+// "#/$defs/Name", "#/definitions/Name", and plain-name fragments ("#name")
+// naming a $anchor or $dynamicAnchor in the document.
+//
+// $dynamicRef is resolved statically to the anchor in the same document; the
+// dynamic scope of the evaluation is not considered.
+func resolveLocalRef(doc *Schema, root Path, ref string) (*Schema, Path, bool) {
+	if ref == "#" {
+		return doc, root, true
+	}
+	for _, defs := range []struct {
+		keyword string
+		m       map[string]*Schema
+	}{{"$defs", doc.Defs}, {"definitions", doc.Definitions}} {
+		if name, ok := strings.CutPrefix(ref, "#/"+defs.keyword+"/"); ok {
+			name = unescapePointer(name)
+			if def, ok := defs.m[name]; ok {
+				return def, root.Child(defs.keyword, name), true
+			}
+		}
+	}
+	if name, ok := strings.CutPrefix(ref, "#"); ok && name != "" && !strings.Contains(name, "/") {
+		return findAnchor(doc, root, name)
+	}
+	return nil, Path{}, false
+}
+
+// findAnchor returns the schema in the document, including its definitions,
+// that declares the $anchor or $dynamicAnchor name, and its path. The
+// shallowest declaration wins, so a $dynamicRef to an anchor declared at the
+// document root resolves to the root.
+func findAnchor(doc *Schema, root Path, name string) (*Schema, Path, bool) {
+	var found *Schema
+	var foundPath Path
+	visit := func(s *Schema, path Path, _ bool) {
+		if (s.Anchor == name || s.DynamicAnchor == name) && (found == nil || len(path.Segments) < len(foundPath.Segments)) {
+			found, foundPath = s, path
+		}
+	}
+	walkSchema(doc, root, false, visit)
+	for _, def := range sortedKeys(doc.Defs) {
+		walkSchema(doc.Defs[def], root.Child("$defs", def), false, visit)
+	}
+	for _, def := range sortedKeys(doc.Definitions) {
+		walkSchema(doc.Definitions[def], root.Child("definitions", def), false, visit)
+	}
+	return found, foundPath, found != nil
+}
+
+// RefTarget returns the reference of a schema: its $ref, or its $dynamicRef
+// if it has no $ref.
+func (s *Schema) RefTarget() string {
+	if s.Ref != "" {
+		return s.Ref
+	}
+	return s.DynamicRef
+}
+
+// attributeReferences records, on each issue located in a definition, the
+// locations of the $refs to that definition. Issues are reported once at
+// the definition rather than at every use, so the referencing paths show
+// where a fix takes effect. Issues before index start belong to other
+// documents and are left unchanged.
+func attributeReferences(doc *Schema, root Path, result *Result, start int) {
+	refs := make(map[string][]string) // definition path -> $ref locations
+	visit := func(s *Schema, path Path, _ bool) {
+		ref := s.RefTarget()
+		if ref == "" {
+			return
+		}
+		if _, target, ok := resolveLocalRef(doc, root, ref); ok && !target.Equal(root) {
+			def := definitionPath(root, target).String()
+			refs[def] = append(refs[def], path.String())
+		}
+	}
+	walkSchema(doc, root, false, visit)
+	for _, name := range sortedKeys(doc.Defs) {
+		walkSchema(doc.Defs[name], root.Child("$defs", name), false, visit)
+	}
+	for _, name := range sortedKeys(doc.Definitions) {
+		walkSchema(doc.Definitions[name], root.Child("definitions", name), false, visit)
+	}
+	if len(refs) == 0 {
+		return
+	}
+
+	for i := start; i < len(result.Issues); i++ {
+		issue := &result.Issues[i]
+		if issue.ReferencedBy == nil {
+			issue.ReferencedBy = refs[definitionPath(root, issue.Path).String()]
+		}
+	}
+}
+
+// definitionPath returns the path of the definition containing path under
+// root (e.g., "$/$defs/Pet" for "$/$defs/Pet/properties/id"), or root if
+// path is not in a definition.
+func definitionPath(root, path Path) Path {
+	if !path.HasPrefix(root) {
+		return root
+	}
+	rest := path.Segments[len(root.Segments):]
+	if len(rest) >= 2 && (rest[0] == "$defs" || rest[0] == "definitions") {
+		return root.Child(rest[:2]...)
+	}
+	return root
+}
+
+// dedupeIssues removes issues identical in code, path, and message to an
+// earlier issue of the same document, such as findings reached through
+// several $refs to one definition. Issues before index start are kept.
+func dedupeIssues(result *Result, start int) {
+	type key struct {
+		code          IssueCode
+		path, message string
+	}
+	seen := make(map[key]bool)
+	kept := result.Issues[:start]
+	for _, issue := range result.Issues[start:] {
+		k := key{issue.Code, issue.Path.String(), issue.Message}
+		if seen[k] {
+			continue
+		}
+		seen[k] = true
+		kept = append(kept, issue)
+	}
+	result.Issues = kept
+}
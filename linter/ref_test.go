@@ -0,0 +1,123 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResolveLocalRef(t *testing.T) {
+	doc, err := ParseSchema([]byte(`{
+		"$dynamicAnchor": "node",
+		"type": "object",
+		"properties": {
+			"children": {"type": "array", "items": {"$dynamicRef": "#node"}},
+			"address": {"$anchor": "addr", "type": "object"}
+		},
+		"$defs": {
+			"Tree": {"$dynamicAnchor": "node", "type": "object"},
+			"Leaf": {"$anchor": "leaf", "type": "string"}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+
+	tests := []struct {
+		ref  string
+		path string
+	}{
+		{"#", "$"},
+		{"#/$defs/Leaf", "$/$defs/Leaf"},
+		{"#leaf", "$/$defs/Leaf"},
+		{"#addr", "$/properties/address"},
+		{"#node", "$"},
+	}
+	for _, tt := range tests {
+		_, path, ok := resolveLocalRef(doc, rootPath, tt.ref)
+		if !ok || path.String() != tt.path {
+			t.Errorf("resolveLocalRef(%q) = %q, %v; want %q", tt.ref, path, ok, tt.path)
+		}
+	}
+
+	for _, ref := range []string{"#missing", "#/$defs/Missing", "other.json#leaf"} {
+		if _, _, ok := resolveLocalRef(doc, rootPath, ref); ok {
+			t.Errorf("Expected %q not to resolve", ref)
+		}
+	}
+}
+
+func TestSampleAnchors(t *testing.T) {
+	got, err := sampleJSON(t, `{
+		"$defs": {"Name": {"$anchor": "name", "type": "string", "minLength": 3}},
+		"type": "object",
+		"properties": {"name": {"$ref": "#name"}, "alias": {"$dynamicRef": "#name"}},
+		"required": ["name", "alias"]
+	}`)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if want := `{"alias":"string","name":"string"}`; got != want {
+		t.Errorf("Sample = %s, want %s", got, want)
+	}
+}
+
+func TestLintReferencedBy(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"billing": {"$ref": "#/$defs/Address"},
+			"shipping": {"$ref": "#/$defs/Address"},
+			"count": {"type": "integer", "minLength": 1}
+		},
+		"$defs": {
+			"Address": {
+				"type": "object",
+				"properties": {"zip": {"type": "integer", "pattern": "^[0-9]+$"}}
+			},
+			"Order": {
+				"type": "object",
+				"properties": {"to": {"$ref": "#/$defs/Address"}}
+			}
+		}
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	var count int
+	for _, issue := range result.Issues {
+		switch issue.Path.String() {
+		case "$/$defs/Address/properties/zip/pattern":
+			count++
+			want := []string{"$/properties/billing", "$/properties/shipping", "$/$defs/Order/properties/to"}
+			if strings.Join(issue.ReferencedBy, ",") != strings.Join(want, ",") {
+				t.Errorf("ReferencedBy = %v, want %v", issue.ReferencedBy, want)
+			}
+			if !strings.Contains(issue.String(), "referenced by: $/properties/billing") {
+				t.Errorf("Expected referencing paths in text output:\n%s", issue)
+			}
+		case "$/properties/count/minLength":
+			if issue.ReferencedBy != nil {
+				t.Errorf("Expected no references outside definitions, got %v", issue.ReferencedBy)
+			}
+		}
+	}
+	if count != 1 {
+		t.Errorf("Expected the Address issue once, got %d", count)
+	}
+}
+
+func TestDedupeIssues(t *testing.T) {
+	result := &Result{Issues: []Issue{
+		{Code: CodeDeadKeyword, Path: ParsePath("$/a"), Message: "m"},
+		{Code: CodeDeadKeyword, Path: ParsePath("$/a"), Message: "m"},
+		{Code: CodeDeadKeyword, Path: ParsePath("$/a"), Message: "other"},
+		{Code: CodeDeadKeyword, Path: ParsePath("$/a"), Message: "m"},
+	}}
+	dedupeIssues(result, 1)
+	if len(result.Issues) != 3 {
+		t.Errorf("Expected duplicates after start to be removed, got %v", result.Issues)
+	}
+}
@@ -0,0 +1,60 @@
+package linter
+
+import "strings"
+
+// A Resolver resolves $refs that point outside the linted document, such
+// as "common.json#/$defs/Money" or "https://schemas.example.com/money", so
+// that embedders can load schemas from databases, registries, or
+// in-memory stores. Refs within the document ("#/$defs/Name", "#anchor")
+// are resolved by the linter and never reach the Resolver.
+//
+// Rules that follow $refs (the discriminator checks of unions, with
+// union-no-discriminator, missing-const, and duplicate-const-value
+// reported on the variants a union references; discriminator-enum-mismatch;
+// map-of-union; inheritance-conflict; inconsistent-pagination; and
+// inconsistent-error-shape) use the Resolver; without one, or when it
+// returns an error, a $ref out of the document is left unresolved, as a
+// missing definition is, and a union with an unresolved variant is
+// reported as unresolved-union. Local $refs within a
+// resolved schema are resolved against the linted document, so resolved
+// schemas should be self-contained. A Resolver is called concurrently when
+// a Linter is, and several times for the same $ref; it should return the
+// same *Schema each time (e.g., from a cache), since cycles of $refs are
+// detected by identity.
+type Resolver interface {
+	Resolve(ref string, base RefContext) (*Schema, error)
+}
+
+// RefContext describes the document holding a $ref being resolved.
+type RefContext struct {
+	// Document is the root schema of the document.
+	Document *Schema
+	// BaseURI is the $id of the document, against which relative refs
+	// resolve, or "" if it declares none.
+	BaseURI string
+	// Root is the lint path of the document: "$", or "[i]" in a composite
+	// file.
+	Root string
+}
+
+// ResolverFunc adapts a function to a Resolver.
+type ResolverFunc func(ref string, base RefContext) (*Schema, error)
+
+// Resolve calls f.
+func (f ResolverFunc) Resolve(ref string, base RefContext) (*Schema, error) {
+	return f(ref, base)
+}
+
+// resolveRef resolves a $ref of the document: refs within it locally, and
+// others with the configured Resolver, if any. The path of a schema from
+// the Resolver is the $ref itself, as the root of its own document.
+func (l *Linter) resolveRef(doc *Schema, root Path, ref string) (*Schema, Path, bool) {
+	if strings.HasPrefix(ref, "#") || l.config.Resolver == nil {
+		return resolveLocalRef(doc, root, ref)
+	}
+	schema, err := l.config.Resolver.Resolve(ref, RefContext{Document: doc, BaseURI: doc.ID, Root: root.Root})
+	if err != nil || schema == nil {
+		return nil, Path{}, false
+	}
+	return schema, NewPath(ref), true
+}
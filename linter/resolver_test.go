@@ -0,0 +1,132 @@
+package linter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestResolver(t *testing.T) {
+	schema := []byte(`{
+		"$id": "https://schemas.example.com/pet.json",
+		"$defs": {
+			"Pet": {"allOf": [
+				{"$ref": "base.json"},
+				{"type": "object", "properties": {"id": {"type": "integer"}}}
+			]}
+		}
+	}`)
+	base := &Schema{Type: "object", Properties: map[string]*Schema{"id": {Type: "string"}}}
+
+	conflicts := func(l *Linter) int {
+		result, err := l.Lint(schema)
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		n := 0
+		for _, issue := range result.Issues {
+			if issue.Code == CodeInheritanceConflict {
+				n++
+			}
+		}
+		return n
+	}
+
+	if n := conflicts(NewWithDefaults()); n != 0 {
+		t.Errorf("Expected no conflict without a resolver, got %d", n)
+	}
+
+	var calls []RefContext
+	l, err := NewLinter(WithResolver(ResolverFunc(func(ref string, ctx RefContext) (*Schema, error) {
+		calls = append(calls, ctx)
+		if ref != "base.json" {
+			return nil, errors.New("not found")
+		}
+		return base, nil
+	})))
+	if err != nil {
+		t.Fatalf("Failed to create linter: %v", err)
+	}
+	if n := conflicts(l); n != 1 {
+		t.Errorf("Expected the conflict with the resolved base, got %d", n)
+	}
+	if len(calls) == 0 || calls[0].BaseURI != "https://schemas.example.com/pet.json" || calls[0].Root != "$" || calls[0].Document == nil {
+		t.Errorf("Unexpected resolver context: %+v", calls)
+	}
+}
+
+func TestResolverUnionVariants(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Animal": {
+				"anyOf": [
+					{"$ref": "#/$defs/Dog"},
+					{"$ref": "#/$defs/Cat"},
+					{"$ref": "#/$defs/Lion"}
+				]
+			},
+			"Dog": {"type": "object", "properties": {"type": {"const": "dog"}}},
+			"Cat": {"type": "object", "properties": {"type": {"const": "cat"}}},
+			"Lion": {"$ref": "#/$defs/BigCat"},
+			"BigCat": {"type": "object", "properties": {"type": {"const": "lion"}}}
+		}
+	}`
+	if got := codeIssues(t, DefaultConfig(), schema, CodeUnresolvedUnion); len(got) != 0 {
+		t.Errorf("Expected the local $refs to be resolved, got %v", got)
+	}
+	if got := codeIssues(t, DefaultConfig(), schema, CodeUnionNoDiscriminator); len(got) != 0 {
+		t.Errorf("Expected the discriminator of the resolved variants to be found, got %v", got)
+	}
+	duplicate := strings.Replace(schema, `"const": "lion"`, `"const": "cat"`, 1)
+	got := codeIssues(t, DefaultConfig(), duplicate, CodeUnionNoDiscriminator)
+	if len(got) != 1 || got[0].Path.String() != "$/$defs/Animal/anyOf" {
+		t.Errorf("Expected union-no-discriminator for the duplicate const, got %v", got)
+	}
+
+	external := `{"$defs": {"Animal": {"oneOf": [
+		{"$ref": "#/$defs/Dog"},
+		{"$ref": "cat.json"}
+	]}, "Dog": {"type": "object", "properties": {"type": {"const": "dog"}}}}}`
+	got = codeIssues(t, DefaultConfig(), external, CodeUnresolvedUnion)
+	if len(got) != 1 || !strings.Contains(got[0].Message, "cat.json") || strings.Contains(got[0].Message, "#/$defs/Dog") {
+		t.Errorf("Expected unresolved-union for cat.json only, got %v", got)
+	}
+
+	config := DefaultConfig()
+	config.Resolver = ResolverFunc(func(ref string, _ RefContext) (*Schema, error) {
+		return ParseSchema([]byte(`{"type": "object", "properties": {"type": {"type": "string"}}}`))
+	})
+	if got := codeIssues(t, config, external, CodeUnresolvedUnion); len(got) != 0 {
+		t.Errorf("Expected the resolver to resolve cat.json, got %v", got)
+	}
+	got = codeIssues(t, config, external, CodeUnionNoDiscriminator)
+	if len(got) != 1 || got[0].Path.String() != "$/$defs/Animal/oneOf" {
+		t.Errorf("Expected union-no-discriminator, as the resolved cat.json has no const, got %v", got)
+	}
+}
+
+func TestResolverUnionEnumMismatch(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Pet": {
+				"properties": {"type": {"enum": ["dog", "cat", "bird"]}},
+				"oneOf": [{"$ref": "#/$defs/Dog"}, {"$ref": "#/$defs/Cat"}]
+			},
+			"Dog": {"type": "object", "properties": {"type": {"const": "dog"}}},
+			"Cat": {"type": "object", "properties": {"type": {"const": "cat"}}}
+		}
+	}`
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	var codes []IssueCode
+	for _, issue := range result.Issues {
+		if issue.Path.String() == "$/$defs/Pet/oneOf" {
+			codes = append(codes, issue.Code)
+		}
+	}
+	if len(codes) != 1 || codes[0] != CodeDiscriminatorEnumMismatch {
+		t.Errorf("Expected only discriminator-enum-mismatch on the union, got %v", codes)
+	}
+}
@@ -0,0 +1,179 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Rollup summarizes the results of many schemas, such as a directory of
+// them, by directory and by rule, for projects too large to review as a
+// flat list of issues.
+type Rollup struct {
+	// Total counts the issues of all schemas.
+	Total RollupEntry `json:"total"`
+	// Directories count the issues of the schemas in each directory,
+	// sorted by directory.
+	Directories []RollupEntry `json:"directories"`
+	// Rules count the issues of each rule, most issues first.
+	Rules []RollupEntry `json:"rules"`
+}
+
+// RollupEntry counts the issues of a directory or rule.
+type RollupEntry struct {
+	// Name is the directory or the rule code.
+	Name     string `json:"name"`
+	Schemas  int    `json:"schemas,omitempty"`
+	Errors   int    `json:"errors"`
+	Warnings int    `json:"warnings"`
+	Info     int    `json:"info"`
+	// Baseline is the number of issues in the baseline results, if any.
+	Baseline *int `json:"baseline,omitempty"`
+}
+
+// Issues returns the number of issues counted.
+func (e RollupEntry) Issues() int {
+	return e.Errors + e.Warnings + e.Info
+}
+
+// Trend returns the change in the number of issues since the baseline
+// (e.g., "+3", "-2", or "0"), or "" without one.
+func (e RollupEntry) Trend() string {
+	if e.Baseline == nil {
+		return ""
+	}
+	delta := e.Issues() - *e.Baseline
+	if delta > 0 {
+		return fmt.Sprintf("+%d", delta)
+	}
+	return fmt.Sprint(delta)
+}
+
+// count adds an issue to the entry.
+func (e *RollupEntry) count(severity Severity) {
+	switch severity {
+	case SeverityError:
+		e.Errors++
+	case SeverityWarning:
+		e.Warnings++
+	default:
+		e.Info++
+	}
+}
+
+// NewRollup summarizes results. With baseline results, such as those of the
+// default branch, each entry also holds its issue count in the baseline, and
+// directories and rules with issues only in the baseline are included.
+func NewRollup(results []*Result, baseline []Result) Rollup {
+	r := Rollup{Total: RollupEntry{Name: "total", Schemas: len(results)}}
+	dirs := make(map[string]*RollupEntry)
+	rules := make(map[string]*RollupEntry)
+	entry := func(m map[string]*RollupEntry, name string) *RollupEntry {
+		if m[name] == nil {
+			m[name] = &RollupEntry{Name: name}
+		}
+		return m[name]
+	}
+
+	for _, result := range results {
+		dir := entry(dirs, filepath.Dir(result.SchemaPath))
+		dir.Schemas++
+		for _, issue := range result.Issues {
+			r.Total.count(issue.Severity)
+			dir.count(issue.Severity)
+			entry(rules, string(issue.Code)).count(issue.Severity)
+		}
+	}
+
+	if baseline != nil {
+		previous := func(e *RollupEntry, n int) {
+			if e.Baseline == nil {
+				e.Baseline = new(int)
+			}
+			*e.Baseline += n
+		}
+		previous(&r.Total, 0)
+		for _, e := range dirs {
+			previous(e, 0)
+		}
+		for _, e := range rules {
+			previous(e, 0)
+		}
+		for _, result := range baseline {
+			previous(&r.Total, len(result.Issues))
+			previous(entry(dirs, filepath.Dir(result.SchemaPath)), len(result.Issues))
+			for _, issue := range result.Issues {
+				previous(entry(rules, string(issue.Code)), 1)
+			}
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(dirs)) {
+		r.Directories = append(r.Directories, *dirs[name])
+	}
+	for _, name := range slices.Sorted(maps.Keys(rules)) {
+		r.Rules = append(r.Rules, *rules[name])
+	}
+	slices.SortStableFunc(r.Rules, func(a, b RollupEntry) int { return b.Issues() - a.Issues() })
+	return r
+}
+
+// String returns the rollup as aligned tables.
+func (r Rollup) String() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Rollup: %d schema(s), %d error(s), %d warning(s), %d info", r.Total.Schemas, r.Total.Errors, r.Total.Warnings, r.Total.Info)
+	if trend := r.Total.Trend(); trend != "" {
+		fmt.Fprintf(&sb, " (%s since baseline)", trend)
+	}
+	sb.WriteString("\n")
+
+	table := func(title string, entries []RollupEntry, schemas bool) {
+		if len(entries) == 0 {
+			return
+		}
+		width := 0
+		for _, e := range entries {
+			width = max(width, len(e.Name))
+		}
+		fmt.Fprintf(&sb, "\n%s:\n", title)
+		for _, e := range entries {
+			fmt.Fprintf(&sb, "  %-*s", width, e.Name)
+			if schemas {
+				fmt.Fprintf(&sb, "  %4d schema(s)", e.Schemas)
+			}
+			fmt.Fprintf(&sb, "  %4d error(s)  %4d warning(s)  %4d info", e.Errors, e.Warnings, e.Info)
+			if trend := e.Trend(); trend != "" {
+				fmt.Fprintf(&sb, "  %5s", trend)
+			}
+			sb.WriteString("\n")
+		}
+	}
+	table("By directory", r.Directories, true)
+	table("By rule", r.Rules, false)
+	return sb.String()
+}
+
+// LoadResults reads the JSON results of several schemas, as written by lint
+// for a directory: an array of results, or an object holding them under
+// "results".
+func LoadResults(path string) ([]Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read results: %w", err)
+	}
+	var results []Result
+	if err := json.Unmarshal(data, &results); err == nil {
+		return results, nil
+	}
+	var wrapped struct {
+		Results []Result `json:"results"`
+	}
+	if err := json.Unmarshal(data, &wrapped); err != nil || wrapped.Results == nil {
+		return nil, fmt.Errorf("failed to parse results %s: expected an array of results or an object with \"results\"", path)
+	}
+	return wrapped.Results, nil
+}
@@ -0,0 +1,91 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestNewRollup(t *testing.T) {
+	results := []*Result{
+		{SchemaPath: "schemas/orders/order.json", Issues: []Issue{
+			{Code: CodeUnionNoDiscriminator, Severity: SeverityError},
+			{Code: CodeLargeEnum, Severity: SeverityWarning},
+		}},
+		{SchemaPath: "schemas/orders/refund.json", Issues: []Issue{
+			{Code: CodeLargeEnum, Severity: SeverityWarning},
+		}},
+		{SchemaPath: "schemas/users/user.json", Issues: []Issue{}},
+	}
+
+	r := NewRollup(results, nil)
+	if r.Total.Schemas != 3 || r.Total.Errors != 1 || r.Total.Warnings != 2 || r.Total.Baseline != nil {
+		t.Errorf("Unexpected total: %+v", r.Total)
+	}
+	if len(r.Directories) != 2 || r.Directories[0].Name != "schemas/orders" || r.Directories[0].Schemas != 2 ||
+		r.Directories[0].Issues() != 3 || r.Directories[1].Issues() != 0 {
+		t.Errorf("Unexpected directories: %+v", r.Directories)
+	}
+	if len(r.Rules) != 2 || r.Rules[0].Name != string(CodeLargeEnum) || r.Rules[0].Warnings != 2 {
+		t.Errorf("Expected rules with the most issues first, got %+v", r.Rules)
+	}
+
+	baseline := []Result{
+		{SchemaPath: "schemas/orders/order.json", Issues: []Issue{
+			{Code: CodeLargeEnum, Severity: SeverityWarning},
+		}},
+		{SchemaPath: "schemas/legacy/old.json", Issues: []Issue{
+			{Code: CodeDeadKeyword, Severity: SeverityWarning},
+			{Code: CodeDeadKeyword, Severity: SeverityWarning},
+		}},
+	}
+	r = NewRollup(results, baseline)
+	if got := r.Total.Trend(); got != "0" {
+		t.Errorf("Total trend = %q, want 0", got)
+	}
+	trends := make(map[string]string)
+	for _, e := range append(r.Directories, r.Rules...) {
+		trends[e.Name] = e.Trend()
+	}
+	want := map[string]string{
+		"schemas/orders":                 "+2",
+		"schemas/users":                  "0",
+		"schemas/legacy":                 "-2",
+		string(CodeLargeEnum):            "+1",
+		string(CodeUnionNoDiscriminator): "+1",
+		string(CodeDeadKeyword):          "-2",
+	}
+	for name, trend := range want {
+		if trends[name] != trend {
+			t.Errorf("Trend of %s = %q, want %q", name, trends[name], trend)
+		}
+	}
+	if s := r.String(); !strings.Contains(s, "(0 since baseline)") || !strings.Contains(s, "By directory:") {
+		t.Errorf("Unexpected rollup text:\n%s", s)
+	}
+}
+
+func TestLoadResults(t *testing.T) {
+	dir := t.TempDir()
+	for name, data := range map[string]string{
+		"array.json":   `[{"schema_path": "a.json", "issues": []}]`,
+		"wrapped.json": `{"results": [{"schema_path": "a.json", "issues": []}], "rollup": {}}`,
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		results, err := LoadResults(path)
+		if err != nil || len(results) != 1 || results[0].SchemaPath != "a.json" {
+			t.Errorf("LoadResults(%s) = %v, %v", name, results, err)
+		}
+	}
+	path := filepath.Join(dir, "single.json")
+	if err := os.WriteFile(path, []byte(`{"schema_path": "a.json"}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadResults(path); err == nil {
+		t.Error("Expected an error for a single result")
+	}
+}
@@ -0,0 +1,101 @@
+package linter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// rootScope returns the paths of the document root and definitions that no
+// $ref chain from the configured roots reaches, and an info issue for each
+// unreachable definition. It returns nil if no roots are configured.
+func (l *Linter) rootScope(schema *Schema, root Path) (map[string]bool, []Issue, error) {
+	if len(l.config.Roots) == 0 {
+		return nil, nil, nil
+	}
+
+	entries := make([]Path, 0, len(l.config.Roots))
+	for _, ref := range l.config.Roots {
+		_, target, ok := resolveLocalRef(schema, root, ref)
+		if !ok {
+			return nil, nil, &LintError{Err: fmt.Errorf("%w: root %q does not resolve to a definition", ErrUnresolvedRef, ref)}
+		}
+		entries = append(entries, definitionPath(root, target))
+	}
+	reached := reachableDefinitions(schema, root, entries)
+
+	excluded := make(map[string]bool)
+	if !reached[root.String()] {
+		excluded[root.String()] = true
+	}
+	var issues []Issue
+	for _, path := range definitionPaths(schema, root) {
+		if reached[path.String()] {
+			continue
+		}
+		excluded[path.String()] = true
+		issues = append(issues, Issue{
+			Code:       CodeUnreachableFromRoots,
+			Severity:   SeverityInfo,
+			Path:       path,
+			Message:    fmt.Sprintf("Definition is not reachable from the roots %s and was not linted", strings.Join(l.config.Roots, ", ")),
+			Suggestion: "Add the definition to the roots if it is part of the contract",
+		})
+	}
+	return excluded, issues, nil
+}
+
+// reachableDefinitions returns the paths of the entry definitions and of the
+// definitions reached from them through local $refs. The document root is
+// reached when it is an entry or is referenced with "#".
+func reachableDefinitions(schema *Schema, root Path, entries []Path) map[string]bool {
+	defs := make(map[string]*Schema)
+	defs[root.String()] = schema
+	for name, def := range schema.Defs {
+		defs[root.Child("$defs", name).String()] = def
+	}
+	for name, def := range schema.Definitions {
+		defs[root.Child("definitions", name).String()] = def
+	}
+
+	reached := make(map[string]bool)
+	var queue []*Schema
+	for _, path := range entries {
+		if key := path.String(); !reached[key] {
+			reached[key] = true
+			queue = append(queue, defs[key])
+		}
+	}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		walkSchema(current, root, false, func(s *Schema, _ Path, _ bool) {
+			ref := s.RefTarget()
+			if ref == "" {
+				return
+			}
+			_, target, ok := resolveLocalRef(schema, root, ref)
+			if !ok {
+				return
+			}
+			def := definitionPath(root, target).String()
+			if !reached[def] {
+				reached[def] = true
+				queue = append(queue, defs[def])
+			}
+		})
+	}
+	return reached
+}
+
+// definitionPaths returns the paths of the document's $defs and
+// definitions entries, in sorted order.
+func definitionPaths(schema *Schema, root Path) []Path {
+	var paths []Path
+	for _, name := range sortedKeys(schema.Defs) {
+		paths = append(paths, root.Child("$defs", name))
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		paths = append(paths, root.Child("definitions", name))
+	}
+	return paths
+}
@@ -0,0 +1,58 @@
+package linter
+
+import "testing"
+
+func TestLintRoots(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {"bad_name": {"type": "string"}},
+		"$defs": {
+			"PublicAPI": {
+				"type": "object",
+				"properties": {"order": {"$ref": "#/$defs/Order"}}
+			},
+			"Order": {
+				"type": "object",
+				"properties": {"total_cents": {"type": "integer"}}
+			},
+			"Internal": {
+				"type": "object",
+				"properties": {"cache_key": {"type": "string"}}
+			}
+		}
+	}`
+
+	config := DefaultConfig()
+	config.Roots = []string{"#/$defs/PublicAPI"}
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	var order bool
+	unreachable := make(map[string]bool)
+	for _, issue := range result.Issues {
+		switch {
+		case issue.Code == CodeUnreachableFromRoots:
+			if issue.Severity != SeverityInfo {
+				t.Errorf("Expected info severity, got: %s", issue)
+			}
+			unreachable[issue.Path.String()] = true
+		case issue.Path.HasPrefix(ParsePath("$/$defs/Internal")) || issue.Path.String() == "$/properties/bad_name":
+			t.Errorf("Unexpected issue outside the roots: %s", issue)
+		case issue.Path.String() == "$/$defs/Order/properties/total_cents":
+			order = true
+		}
+	}
+	if !order {
+		t.Errorf("Expected issues in the reachable definition, got: %v", result.Issues)
+	}
+	if len(unreachable) != 1 || !unreachable["$/$defs/Internal"] {
+		t.Errorf("Expected Internal to be reported as unreachable, got: %v", unreachable)
+	}
+
+	config.Roots = []string{"#/$defs/Missing"}
+	if _, err := New(config).Lint([]byte(schema)); err == nil {
+		t.Error("Expected an error for a root that does not resolve")
+	}
+}
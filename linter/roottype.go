@@ -0,0 +1,58 @@
+package linter
+
+import "fmt"
+
+// ObjectRootPolicy selects the schemas that must be objects or $refs.
+type ObjectRootPolicy string
+
+const (
+	// ObjectRootsNone requires nothing.
+	ObjectRootsNone ObjectRootPolicy = "none"
+	// ObjectRootsDocument requires the root schema of each document to be
+	// an object or a $ref. A root that only holds definitions is exempt.
+	ObjectRootsDocument ObjectRootPolicy = "root"
+	// ObjectRootsDefinitions requires every $defs and definitions entry to
+	// be an object or a $ref.
+	ObjectRootsDefinitions ObjectRootPolicy = "definitions"
+	// ObjectRootsAll requires both.
+	ObjectRootsAll ObjectRootPolicy = "all"
+)
+
+// lintObjectRoots flags root schemas and, by policy, definitions that are
+// neither type: object nor a $ref, such as bare strings or untyped
+// schemas, which several code generators refuse to process as entry types.
+func (l *Linter) lintObjectRoots(schema *Schema, root Path, ignored map[string]bool, result *Result) {
+	policy := l.config.RequireObjectRoots
+	check := func(s *Schema, path Path, what string) {
+		if ignored[path.String()] || s == nil || s.IsRef() || s.HasType() && schemaKind(s) == "object" {
+			return
+		}
+		message := what + " declares no type"
+		switch {
+		case s.IsBooleanSchema:
+			message = fmt.Sprintf("%s is the boolean schema %t", what, s.BooleanValue)
+		case s.HasType():
+			message = fmt.Sprintf("%s has type %s", what, formatTypes(declaredTypes(s)))
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeNonObjectRoot,
+			Severity:   SeverityWarning,
+			Path:       path,
+			Message:    message + "; several code generators only accept an object or a $ref as an entry type",
+			Suggestion: "Declare type: object, or wrap the value in an object property",
+		})
+	}
+
+	library := !schema.IsBooleanSchema && isDefinitionBundle(schema) && len(schema.Defs)+len(schema.Definitions) > 0
+	if (policy == ObjectRootsDocument || policy == ObjectRootsAll) && !library {
+		check(schema, root, "Root schema")
+	}
+	if policy == ObjectRootsDefinitions || policy == ObjectRootsAll {
+		for _, name := range sortedKeys(schema.Defs) {
+			check(schema.Defs[name], root.Child("$defs", name), fmt.Sprintf("Definition '%s'", name))
+		}
+		for _, name := range sortedKeys(schema.Definitions) {
+			check(schema.Definitions[name], root.Child("definitions", name), fmt.Sprintf("Definition '%s'", name))
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package linter
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestLintObjectRoots(t *testing.T) {
+	schema := `{
+		"type": "string",
+		"$defs": {
+			"Email": {"type": "string", "format": "email"},
+			"User": {"type": "object", "properties": {"email": {"$ref": "#/$defs/Email"}}},
+			"Alias": {"$ref": "#/$defs/User"},
+			"Anything": {"description": "Any value"},
+			"Maybe": {"type": ["object", "null"]}
+		}
+	}`
+
+	paths := func(policy ObjectRootPolicy, data string) []string {
+		config := DefaultConfig()
+		config.RequireObjectRoots = policy
+		result, err := New(config).Lint([]byte(data))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		var found []string
+		for _, issue := range result.Issues {
+			if issue.Code == CodeNonObjectRoot {
+				found = append(found, issue.Path.String())
+			}
+		}
+		return found
+	}
+
+	tests := []struct {
+		policy ObjectRootPolicy
+		want   []string
+	}{
+		{ObjectRootsNone, nil},
+		{ObjectRootsDocument, []string{"$"}},
+		{ObjectRootsDefinitions, []string{"$/$defs/Anything", "$/$defs/Email"}},
+		{ObjectRootsAll, []string{"$", "$/$defs/Anything", "$/$defs/Email"}},
+	}
+	for _, tt := range tests {
+		if got := paths(tt.policy, schema); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.policy, got, tt.want)
+		}
+	}
+
+	library := `{"$defs": {"User": {"type": "object"}}}`
+	if got := paths(ObjectRootsDocument, library); got != nil {
+		t.Errorf("Expected a root holding only definitions to be exempt, got %v", got)
+	}
+	if got := paths(ObjectRootsDocument, `{"properties": {"id": {"type": "string"}}}`); !slices.Equal(got, []string{"$"}) {
+		t.Errorf("Expected an untyped root to be reported, got %v", got)
+	}
+
+	config := DefaultConfig()
+	config.RequireObjectRoots = "everything"
+	if err := config.Validate(); err == nil {
+		t.Error("Expected an error for an unknown object root policy")
+	}
+}
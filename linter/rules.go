@@ -0,0 +1,374 @@
+package linter
+
+import "slices"
+
+// RuleInfo describes a lint rule identified by its issue code.
+type RuleInfo struct {
+	Code IssueCode `json:"code"`
+	// Severity is the default severity of issues reported by the rule.
+	Severity Severity `json:"severity"`
+	// Profile is the profile that enables the rule; rules in the default
+	// profile run in every profile.
+	Profile Profile `json:"profile"`
+	// Category groups the rule with related rules (see Config.Categories).
+	Category Category `json:"category,omitempty"`
+	// Description explains what the rule checks and why it matters.
+	Description string `json:"description"`
+}
+
+// Category is a group of related rules that can be run on their own.
+type Category string
+
+const (
+	// CategoryUnions covers discriminators and union structure.
+	CategoryUnions Category = "unions"
+	// CategoryNaming covers property, type, and symbol names.
+	CategoryNaming Category = "naming"
+	// CategoryTyping covers types, formats, and constraints.
+	CategoryTyping Category = "typing"
+	// CategoryDocumentation covers how readable and navigable a schema is.
+	CategoryDocumentation Category = "documentation"
+	// CategoryCompatibility covers file encoding and compatibility with
+	// generators, Go types, Avro, Protobuf, CUE, and databases.
+	CategoryCompatibility Category = "compatibility"
+)
+
+// Categories returns the rule categories.
+func Categories() []Category {
+	return []Category{CategoryUnions, CategoryNaming, CategoryTyping, CategoryDocumentation, CategoryCompatibility}
+}
+
+// ruleCategory returns the category of the rule reporting code, from the
+// built-in rules or the linter's custom rules.
+func (l *Linter) ruleCategory(code IssueCode) Category {
+	if r, ok := LookupRule(code); ok {
+		return r.Category
+	}
+	for _, rule := range l.rules {
+		if info := rule.Info(); info.Code == code {
+			return info.Category
+		}
+	}
+	return ""
+}
+
+// filterRules drops the issues from result.Issues[start:] reported by
+// rules outside the configured categories, or with Config.OnlyRules, of
+// other codes. Issues of uncategorized custom rules are kept unless
+// OnlyRules leaves them out.
+func (l *Linter) filterRules(result *Result, start int) {
+	if len(l.config.Categories) == 0 && len(l.config.OnlyRules) == 0 {
+		return
+	}
+	kept := result.Issues[:start]
+	for _, issue := range result.Issues[start:] {
+		if len(l.config.OnlyRules) > 0 && !slices.Contains(l.config.OnlyRules, issue.Code) {
+			continue
+		}
+		category := l.ruleCategory(issue.Code)
+		if len(l.config.Categories) == 0 || category == "" || slices.Contains(l.config.Categories, category) {
+			kept = append(kept, issue)
+		}
+	}
+	result.Issues = kept
+}
+
+// ruleGroups are the codes reported by the rules that run under a name
+// other than their code. Rules run under the name of their code otherwise.
+var ruleGroups = map[string][]IssueCode{
+	"budgets":               {CodeTooManyDefinitions, CodeTooManyProperties, CodeDefinitionSplit},
+	"content-encoding":      {CodeMissingContentEncoding, CodeContentEncodingNotString},
+	"discriminator-closure": {CodeDiscriminatorEnumMismatch},
+	"ids":                   {CodeRelativeID, CodeDuplicateID},
+	"maps":                  {CodeUnconstrainedMapKeys, CodeMapOfUnion},
+	"navigable-profile":     {CodeDeepNesting, CodeDeepArrayNesting, CodeMissingID},
+	"scale-profile": {CodeCompositionDisallowed, CodeAdditionalPropsDisallowed, CodeDynamicRefDisallowed,
+		CodeMissingType, CodeMixedTypeDisallowed},
+	"schema-declaration": {CodeMissingSchemaDeclaration, CodeDisallowedDraft},
+	"stringly-typed":     {CodeStringlyTypedTimestamp, CodeStringlyTypedID},
+	"unanchored-pattern": {CodeUnanchoredPattern, CodeInvalidPattern},
+}
+
+// alwaysRun are the phases and rules that run whatever Config.OnlyRules
+// selects: $ref resolution, and the union and allOf rules, which also lint
+// the nested schemas of the variants and allOf members.
+var alwaysRun = []string{"resolve", "unions", "inheritance-conflict"}
+
+// runs reports whether the rule, or phase, named rule runs with the
+// configured OnlyRules.
+func (l *Linter) runs(rule string) bool {
+	if len(l.config.OnlyRules) == 0 || slices.Contains(alwaysRun, rule) {
+		return true
+	}
+	codes, ok := ruleGroups[rule]
+	if !ok {
+		codes = []IssueCode{IssueCode(rule)}
+	}
+	for _, code := range codes {
+		if slices.Contains(l.config.OnlyRules, code) {
+			return true
+		}
+	}
+	return false
+}
+
+// run runs fn, timed as rule, unless Config.OnlyRules leaves the rule out.
+func (l *Linter) run(result *Result, rule string, fn func()) {
+	if l.runs(rule) {
+		result.profiler.run(rule, fn)
+	}
+}
+
+// Rule is a custom lint rule. Check is called for every schema node during
+// traversal with the node's path and returns any issues found at that node.
+// Rules must be safe for concurrent use.
+type Rule interface {
+	Info() RuleInfo
+	Check(schema *Schema, path Path) []Issue
+}
+
+// NewRule returns a Rule from its info and a check function.
+func NewRule(info RuleInfo, check func(schema *Schema, path Path) []Issue) Rule {
+	return funcRule{info: info, check: check}
+}
+
+type funcRule struct {
+	info  RuleInfo
+	check func(schema *Schema, path Path) []Issue
+}
+
+func (r funcRule) Info() RuleInfo { return r.info }
+
+func (r funcRule) Check(schema *Schema, path Path) []Issue { return r.check(schema, path) }
+
+var rules = []RuleInfo{
+	// Default profile
+	{CodeUnionNoDiscriminator, SeverityError, ProfileDefault, CategoryUnions,
+		"anyOf/oneOf union has no discriminator field. Generated code cannot tell variants apart without trial decoding; add a property with a unique const value to each variant."},
+	{CodeInconsistentDiscriminator, SeverityError, ProfileDefault, CategoryUnions,
+		"Union variants use different discriminator field names, so no single field can select the variant."},
+	{CodeMissingConst, SeverityError, ProfileDefault, CategoryUnions,
+		"A union variant lacks the discriminator property or its const value, so it cannot be selected by the discriminator."},
+	{CodeDuplicateConstValue, SeverityError, ProfileDefault, CategoryUnions,
+		"Multiple union variants have the same discriminator const value, making the discriminator ambiguous."},
+	{CodeDiscriminatorEnumMismatch, SeverityError, ProfileDefault, CategoryUnions,
+		"The discriminator property declares an enum (on the union or a base definition the variants extend) that does not match the variants' const values exactly: an enum value has no variant, or a variant's value is not in the enum."},
+	{CodeDuplicateID, SeverityError, ProfileDefault, CategoryCompatibility,
+		"An $id is declared twice in a document, or a root $id by two schemas of a directory or manifest, so $refs to it are ambiguous."},
+	{CodeInvalidPropertyCase, SeverityError, ProfileDefault, CategoryNaming,
+		"Property name does not follow the configured case convention (--property-case)."},
+	{CodeInvalidPattern, SeverityError, ProfileDefault, CategoryTyping,
+		"A pattern is not a valid regular expression (e.g., an unclosed bracket), so validators reject the schema or fail to compile it."},
+	{CodeTooManyDefinitions, SeverityError, ProfileDefault, CategoryDocumentation,
+		"Document has more definitions than the configured budget (max_definitions); split it into smaller schemas."},
+	{CodeTooManyProperties, SeverityError, ProfileDefault, CategoryDocumentation,
+		"Object has more properties than the configured budget (max_object_properties); group related properties into nested definitions."},
+	{CodeSchemaTooLarge, SeverityError, ProfileDefault, CategoryDocumentation,
+		"Schema file, with its bundled definitions, is larger than the configured budget (max_schema_bytes)."},
+	{CodeLargeUnion, SeverityWarning, ProfileDefault, CategoryUnions,
+		"Union has more variants than the configured threshold; large unions generate unwieldy types and switch statements."},
+	{CodeNestedUnion, SeverityWarning, ProfileDefault, CategoryUnions,
+		"Union is nested inside other unions beyond the configured depth; nested unions are hard to model in static types."},
+	{CodeAdditionalProps, SeverityWarning, ProfileDefault, CategoryUnions,
+		"Union variant has additionalProperties: true, so payloads for other variants may also match it and decoding is ambiguous."},
+	{CodeAmbiguousUnion, SeverityWarning, ProfileDefault, CategoryUnions,
+		"Union variants cannot be distinguished structurally."},
+	{CodeUnionVariantOrder, SeverityWarning, ProfileDefault, CategoryUnions,
+		"An anyOf variant without a discriminator accepts every payload a later variant accepts (e.g., an open object before a closed one), so decoders that take the first match never choose the later variant."},
+	{CodeCircularReference, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"Schema contains a circular $ref, which some generators cannot handle."},
+	{CodeDeadKeyword, SeverityWarning, ProfileDefault, CategoryTyping,
+		"A type-specific keyword has no effect given the declared type (e.g., minLength on an integer), which is usually an authoring mistake."},
+	{CodeKeywordTypo, SeverityWarning, ProfileDefault, CategoryTyping,
+		"An unknown key is likely a misspelled keyword (e.g., requried or oneof); validators ignore it, so the intended constraint is not enforced."},
+	{CodeLargeEnum, SeverityWarning, ProfileDefault, CategoryTyping,
+		"Enum has more values than the configured threshold; enormous enums generate unwieldy constant blocks and are better modeled as a string with a documented registry."},
+	{CodeUnsatisfiable, SeverityWarning, ProfileDefault, CategoryTyping,
+		"No instance can satisfy the schema (e.g., a required property that recurses without end, minLength greater than maxLength, or a false schema), so no sample can be generated."},
+	{CodeGenericContainer, SeverityWarning, ProfileDefault, CategoryTyping,
+		"An object's only property is a data/payload/value envelope that accepts any value or any object, which generates map[string]interface{} in Go; type it concretely or as a discriminated union."},
+	{CodeStringlyTypedTimestamp, SeverityWarning, ProfileDefault, CategoryTyping,
+		"A property named like a timestamp (*_at, *Date, *_time) is a plain string without a date-time, date, or time format, so generators cannot map it to time.Time (patterns configurable)."},
+	{CodeStringlyTypedID, SeverityWarning, ProfileDefault, CategoryTyping,
+		"A property named like an identifier (*_id, uuid) is a plain string without a format or pattern, so generators cannot map it to a UUID type (patterns configurable)."},
+	{CodeBooleanEnum, SeverityWarning, ProfileDefault, CategoryTyping,
+		"Enum encodes a boolean as [0, 1] or as strings like \"true\"/\"false\" or \"yes\"/\"no\"; use type: boolean so generators produce a bool."},
+	{CodeNullableOptional, SeverityWarning, ProfileDefault, CategoryTyping,
+		"An optional property is also nullable, so absent and null are two ways to say \"no value\" that Go generators cannot tell apart; make it required and nullable, or optional without null (opt-in: detect_nullable_optional)."},
+	{CodeFloatMoney, SeverityWarning, ProfileDefault, CategoryTyping,
+		"A property named like a money amount (money_name_patterns, e.g., *amount, *price, *balance) is a floating-point number, which cannot represent most decimal amounts exactly, or does not follow the configured money_policy."},
+	{CodeMissingContentEncoding, SeverityWarning, ProfileDefault, CategoryTyping,
+		"A string property holds base64-encoded binary data (format: byte, or a name or description mentioning base64) but has no contentEncoding, so generators cannot map it to bytes (fixed by schemakit fix)."},
+	{CodeContentEncodingNotString, SeverityWarning, ProfileDefault, CategoryTyping,
+		"contentEncoding or contentMediaType is set on a schema whose declared type is not string, where it has no effect."},
+	{CodeInheritanceConflict, SeverityWarning, ProfileDefault, CategoryTyping,
+		"The parts of an allOf inheritance (allOf: [{$ref: Base}, {extra properties}]) conflict: a property has different types in two parts, or a part sets additionalProperties: false and so rejects the other parts' properties."},
+	{CodeMissingUnitSuffix, SeverityWarning, ProfileDefault, CategoryNaming,
+		"A numeric duration or size property (e.g., timeout, ttl, size) has no unit suffix, or one the unit_suffixes conventions do not allow (e.g., timeout_ms, size_bytes) (opt-in: detect_unit_suffixes)."},
+	{CodeInconsistentPagination, SeverityWarning, ProfileDefault, CategoryNaming,
+		"A list response (an object with one array and pagination fields such as next, cursor, or total) uses a different envelope structure or field names than most list responses in the schema set."},
+	{CodeInconsistentErrorShape, SeverityWarning, ProfileDefault, CategoryNaming,
+		"An error schema (an object with a message and a code or status) defines a different structure than the canonical error_schema definition or, without one, than most error schemas in the schema set."},
+	{CodeVersionNaming, SeverityWarning, ProfileDefault, CategoryNaming,
+		"A definition has no version marker in its name or $id (e.g., EventV2, .../event/v2), a marker in only one of them, or different versions in each (opt-in: detect_version_naming)."},
+	{CodeUnportablePattern, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"A pattern uses a construct that common target languages' regex engines do not support, such as lookbehind or backreferences (Go RE2) or possessive quantifiers (Go and JavaScript), so generated validators fail to compile it."},
+	{CodeUnanchoredPattern, SeverityWarning, ProfileDefault, CategoryTyping,
+		"A pattern is not anchored with ^...$, or has a top-level alternation the anchors do not cover, so it matches anywhere in the string; the severity is set by unanchored_pattern_severity (fixed by schemakit fix)."},
+	{CodeRelativeID, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"An $id is not an absolute URI, so it resolves differently depending on where the schema is loaded from."},
+	{CodeIDTemplateMismatch, SeverityWarning, ProfileDefault, CategoryNaming,
+		"A schema's root $id does not match the id_template convention for its file path or registry subject (e.g., https://schemas.example.com/{dir}/{name}.json), or is missing."},
+	{CodeNonObjectRoot, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"A root schema or definition is neither type: object nor a $ref (e.g., a bare string or an untyped schema), which several code generators refuse to process as an entry type (opt-in: require_object_roots)."},
+	{CodeTitleNameMismatch, SeverityWarning, ProfileDefault, CategoryNaming,
+		"A definition's title names a different type than its $defs key (e.g., key user_profile, title \"Account Profile\"), so generators that pick one or the other name the type inconsistently."},
+	{CodeMissingSchemaDeclaration, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"A root schema does not declare $schema, so tools interpret its keywords by their own default draft (opt-in: require_schema_declaration; fixed by schemakit fix, which inserts default_draft)."},
+	{CodeDisallowedDraft, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"A root schema declares a $schema draft outside allowed_drafts."},
+	{CodeNonASCIIName, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"A property or definition name has non-ASCII characters (e.g., prénom), which code generators for languages such as Protobuf, Avro, and SQL reject in identifiers (off with unicode_names)."},
+	{CodeEnumMemberCase, SeverityWarning, ProfileDefault, CategoryNaming,
+		"String enum members do not follow the enum_case convention (e.g., SCREAMING_SNAKE_CASE), or, with consistent_enum_case, the convention of most members of their enum (opt-in; fixed by schemakit fix --rule enum-member-case)."},
+	{CodeDefinitionNameCase, SeverityWarning, ProfileDefault, CategoryNaming,
+		"A $defs or definitions key does not follow the definition_case convention (typically PascalCase), so generated type names do not either (opt-in; fixed by schemakit fix --rule definition-name-case, which updates the $refs)."},
+	{CodeImplicitAdditionalProps, SeverityWarning, ProfileDefault, CategoryTyping,
+		"An object schema omits additionalProperties, so whether it accepts unknown properties is left implicit and generators differ in the types they emit (opt-in: require_additional_properties; fixed by inserting default_additional_properties)."},
+	{CodeUnconstrainedMapKeys, SeverityWarning, ProfileDefault, CategoryTyping,
+		"A map (an object with a schema for additionalProperties and no properties) has no propertyNames pattern, format, or enum, so any string is a valid key and the key type of generated maps is undocumented."},
+	{CodeMapOfUnion, SeverityWarning, ProfileDefault, CategoryUnions,
+		"The values of a map are an anyOf/oneOf union without a discriminator, so every map value needs trial decoding, which Go's encoding/json cannot do without a custom unmarshaller."},
+	{CodeConstUnion, SeverityWarning, ProfileDefault, CategoryTyping,
+		"An anyOf/oneOf union whose variants are all scalar consts is an enum; generators emit a wrapper type or interface for the union but a plain enum type for enum (fixed by replacing the union with an enum)."},
+	{CodeMixedEnum, SeverityWarning, ProfileDefault, CategoryTyping,
+		"An enum mixes scalar values with objects or arrays, which no generated enum type can hold, or has an object with a $ref as a value, which is a literal value rather than a reference."},
+	{CodeOpenTuple, SeverityWarning, ProfileDefault, CategoryTyping,
+		"A tuple (prefixItems, or a draft-07 items array) does not close with items: false (additionalItems: false before draft 2020-12) or maxItems, so it accepts extra positional elements that fixed-arity generated types cannot hold."},
+	{CodeInvalidAnnotation, SeverityWarning, ProfileDefault, CategoryDocumentation,
+		"An x-stability or x-owner annotation is not a string, or an x-schemalint annotation is not an object with an ignore array and a reason string, so the annotation is ignored."},
+	{CodeUnresolvedUnion, SeverityInfo, ProfileDefault, CategoryUnions,
+		"A union variant is a $ref that does not resolve, so discriminator verification was skipped; reported as an error with strict unresolved checking."},
+	{CodeProseEnum, SeverityInfo, ProfileDefault, CategoryDocumentation,
+		"Description lists a fixed set of values (\"one of:\", \"allowed values\") but the schema declares no enum or const (opt-in)."},
+	{CodeContains, SeverityInfo, ProfileDefault, CategoryTyping,
+		"Array uses contains/minContains/maxContains, validation-only constraints that vanish from generated types; model a structurally important element as a dedicated typed property."},
+	{CodeUnknownKeyword, SeverityInfo, ProfileDefault, CategoryCompatibility,
+		"A key is not a JSON Schema or OpenAPI keyword nor allowed by allowed_keywords (default: x-*), so validators and generators ignore it."},
+	{CodeRepeatedProperties, SeverityInfo, ProfileDefault, CategoryTyping,
+		"A cluster of properties (min_shared_properties, e.g., id, createdAt, updatedAt) is repeated verbatim in several definitions (min_sharing_definitions); extracting a shared base definition keeps them in sync."},
+	{CodeUnreachableFromRoots, SeverityInfo, ProfileDefault, CategoryDocumentation,
+		"A definition is not reachable through $refs from the configured roots (--root), so it was not linted."},
+	{CodeDefinitionSplit, SeverityInfo, ProfileDefault, CategoryDocumentation,
+		"A document over the max_definitions budget has a group of definitions that reference each other more than the rest of the document, proposed as a separate file of at most max_definitions definitions."},
+	{CodeInvalidInstance, SeverityError, ProfileDefault, CategoryCompatibility,
+		"An instance document does not validate against the schema (reported by the validate command, not by lint)."},
+	{CodeGoMissingField, SeverityError, ProfileDefault, CategoryCompatibility,
+		"A schema property has no corresponding field in the Go type (reported by check-go)."},
+	{CodeGoExtraField, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"A Go struct field is not a schema property; an error if the schema sets additionalProperties: false (reported by check-go)."},
+	{CodeGoTypeMismatch, SeverityError, ProfileDefault, CategoryCompatibility,
+		"A Go field's JSON encoding does not match the schema type, e.g., a float64 for an integer property (reported by check-go)."},
+	{CodeGoOptionality, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"A required property's Go field has omitempty, or an optional property's field is always encoded (reported by check-go)."},
+	{CodeByteOrderMark, SeverityError, ProfileDefault, CategoryCompatibility,
+		"The file starts with a UTF-8 byte order mark, which schemakit skips but encoding/json rejects (reported by doctor)."},
+	{CodeInvalidUTF8, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"The file contains invalid UTF-8, which encoding/json silently replaces with U+FFFD (reported by doctor)."},
+	{CodeDuplicateKey, SeverityError, ProfileDefault, CategoryCompatibility,
+		"An object has a duplicate key; encoding/json keeps only the last value, silently dropping the others. The message gives the line and column."},
+	{CodeDeepJSONNesting, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"The JSON is nested more than 64 levels deep, which is hard to review and may exceed tool limits (reported by doctor)."},
+	{CodeUnreachableDefinition, SeverityWarning, ProfileDefault, CategoryDocumentation,
+		"A definition is not reachable through $refs from the root schema; documents that only bundle definitions are not checked (reported by doctor)."},
+	{CodeSourceUnreadable, SeverityError, ProfileDefault, CategoryCompatibility,
+		"A manifest source could not be fetched or parsed, so it was not linted (reported by crawl)."},
+	{CodeParseError, SeverityError, ProfileDefault, CategoryCompatibility,
+		"A schema file in a linted directory is not valid JSON or not a JSON Schema, so it was not linted; the other files are."},
+	{CodeInvalidAssertion, SeverityError, ProfileDefault, CategoryCompatibility,
+		"A config assertion has no code or its CEL expression does not compile, so it was not evaluated (reported by linters created with New; Config.Validate rejects it)."},
+	{CodeAvroOpenMap, SeverityError, ProfileDefault, CategoryCompatibility,
+		"An object has both properties and additionalProperties; an Avro record has a fixed set of fields and a map has none (reported by avro-compat)."},
+	{CodeAvroUnion, SeverityError, ProfileDefault, CategoryCompatibility,
+		"A union has more than one non-null variant that is not a record; Avro unions must be a single type with null, or named records (reported by avro-compat)."},
+	{CodeAvroUntyped, SeverityError, ProfileDefault, CategoryCompatibility,
+		"A schema accepts any value, or an object accepts any properties; Avro has no any type, and maps need a value type (reported by avro-compat)."},
+	{CodeAvroAllOf, SeverityError, ProfileDefault, CategoryCompatibility,
+		"allOf has no Avro equivalent; flatten the parts into a single record (reported by avro-compat)."},
+	{CodeAvroInvalidName, SeverityError, ProfileDefault, CategoryNaming,
+		"A property name is not a valid Avro name ([A-Za-z_][A-Za-z0-9_]*), so it cannot be a record field (reported by avro-compat)."},
+	{CodeAvroEnumSymbol, SeverityWarning, ProfileDefault, CategoryNaming,
+		"A string enum value is not a valid Avro enum symbol, so the enum can only be represented as a plain string (reported by avro-compat)."},
+	{CodeProtoUnrepresentable, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"A construct has no proto3 equivalent, such as an undiscriminated union, allOf, or nested arrays, and is previewed as google.protobuf.Value (reported by generate proto)."},
+	{CodeCUEUnsupported, SeverityInfo, ProfileDefault, CategoryCompatibility,
+		"A keyword has no CUE equivalent, such as most formats, multipleOf, contains, or oneOf exclusivity, and is left out of the CUE definition (reported by generate cue)."},
+	{CodeDDLUnmappable, SeverityWarning, ProfileDefault, CategoryCompatibility,
+		"A property has no clean Postgres column type and is stored as jsonb, or a definition has additional properties or union variants that do not fit a table (reported by ddl)."},
+
+	// Scale profile
+	{CodeCompositionDisallowed, SeverityError, ProfileScale, CategoryUnions,
+		"anyOf, oneOf, and allOf map poorly to static types and are disallowed in the scale profile."},
+	{CodeAdditionalPropsDisallowed, SeverityError, ProfileScale, CategoryTyping,
+		"additionalProperties: true creates map[string]any style types and is disallowed in the scale profile."},
+	{CodeMissingType, SeverityError, ProfileScale, CategoryTyping,
+		"Schema lacks an explicit type field; the scale profile requires explicit types to avoid ambiguous inference."},
+	{CodeMixedTypeDisallowed, SeverityError, ProfileScale, CategoryTyping,
+		"Type arrays like [\"string\", \"number\"] create union types and are disallowed in the scale profile."},
+	{CodeDynamicRefDisallowed, SeverityError, ProfileScale, CategoryCompatibility,
+		"$dynamicRef resolves by the dynamic scope of evaluation, which no static type generator handles; it is disallowed in the scale profile."},
+
+	// Navigable profile
+	{CodeDeepNesting, SeverityError, ProfileNavigable, CategoryDocumentation,
+		"Object nesting exceeds the configured depth; move nested objects to top-level definitions with $ref."},
+	{CodeDeepArrayNesting, SeverityWarning, ProfileNavigable, CategoryDocumentation,
+		"Arrays of arrays of objects reduce navigability; use flat arrays with cross-references."},
+	{CodeMissingID, SeverityWarning, ProfileNavigable, CategoryDocumentation,
+		"Array items lack an ID field, which prevents cross-referencing items."},
+	{CodeComplexRef, SeverityWarning, ProfileNavigable, CategoryDocumentation,
+		"Cross-reference is not a single hop to a top-level definition."},
+	{CodeImplicitDependency, SeverityWarning, ProfileNavigable, CategoryDocumentation,
+		"Object depends on context defined elsewhere and is not locally comprehensible."},
+}
+
+// Rules returns information about all rules the linter can report.
+func Rules() []RuleInfo {
+	out := make([]RuleInfo, len(rules))
+	copy(out, rules)
+	return out
+}
+
+// otherCommandRules are the rules reported by commands other than lint,
+// such as validate, check-go, doctor, crawl, avro-compat, generate, and ddl.
+var otherCommandRules = []IssueCode{
+	CodeInvalidInstance,
+	CodeGoMissingField, CodeGoExtraField, CodeGoTypeMismatch, CodeGoOptionality,
+	CodeByteOrderMark, CodeInvalidUTF8, CodeDeepJSONNesting, CodeUnreachableDefinition,
+	CodeSourceUnreadable,
+	CodeAvroOpenMap, CodeAvroUnion, CodeAvroUntyped, CodeAvroAllOf, CodeAvroInvalidName, CodeAvroEnumSymbol,
+	CodeProtoUnrepresentable, CodeCUEUnsupported, CodeDDLUnmappable,
+}
+
+// LintRules returns information about the rules lint reports in a
+// profile, in the order of Rules: those of the default profile, and of
+// profile itself. Rules reported only by other commands are left out;
+// some rules are opt-in (see Config).
+func LintRules(profile Profile) []RuleInfo {
+	var out []RuleInfo
+	for _, r := range rules {
+		if (r.Profile == ProfileDefault || r.Profile == profile) && !slices.Contains(otherCommandRules, r.Code) {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// LookupRule returns information about the rule with the given code.
+func LookupRule(code IssueCode) (RuleInfo, bool) {
+	for _, r := range rules {
+		if r.Code == code {
+			return r, true
+		}
+	}
+	return RuleInfo{}, false
+}
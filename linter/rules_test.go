@@ -0,0 +1,226 @@
+package linter
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestRulesUnique(t *testing.T) {
+	seen := make(map[IssueCode]bool)
+	for _, r := range Rules() {
+		if seen[r.Code] {
+			t.Errorf("Duplicate rule code %s", r.Code)
+		}
+		seen[r.Code] = true
+		if r.Description == "" {
+			t.Errorf("Rule %s has no description", r.Code)
+		}
+	}
+}
+
+func TestLookupRule(t *testing.T) {
+	r, ok := LookupRule(CodeUnionNoDiscriminator)
+	if !ok {
+		t.Fatal("Expected union-no-discriminator rule")
+	}
+	if r.Severity != SeverityError || r.Profile != ProfileDefault {
+		t.Errorf("Unexpected rule info: %+v", r)
+	}
+
+	if _, ok := LookupRule("no-such-rule"); ok {
+		t.Error("Did not expect unknown rule to be found")
+	}
+}
+
+func TestLintRules(t *testing.T) {
+	defaults := LintRules(ProfileDefault)
+	codes := make([]IssueCode, len(defaults))
+	for i, r := range defaults {
+		codes[i] = r.Code
+	}
+	if !slices.Contains(codes, CodeUnionNoDiscriminator) || !slices.Contains(codes, CodeParseError) {
+		t.Errorf("Expected the rules lint reports, got %v", codes)
+	}
+	if slices.Contains(codes, CodeAvroUnion) || slices.Contains(codes, CodeGoMissingField) || slices.Contains(codes, CodeCompositionDisallowed) {
+		t.Errorf("Expected no rules of other commands or profiles, got %v", codes)
+	}
+	if scale := LintRules(ProfileScale); len(scale) != len(defaults)+5 {
+		t.Errorf("Expected the default rules and 5 scale rules, got %d and %d", len(defaults), len(scale))
+	}
+	for _, code := range otherCommandRules {
+		if _, ok := LookupRule(code); !ok {
+			t.Errorf("Unknown rule %s in otherCommandRules", code)
+		}
+	}
+}
+
+func TestCustomRule(t *testing.T) {
+	rule := NewRule(RuleInfo{Code: "require-description", Severity: SeverityWarning},
+		func(schema *Schema, path Path) []Issue {
+			if schema.IsObject() && schema.Description == "" {
+				return []Issue{{Code: "require-description", Severity: SeverityWarning, Path: path, Message: "Object has no description"}}
+			}
+			return nil
+		})
+
+	config := DefaultConfig()
+	config.Rules = []Rule{rule}
+
+	schema := `{
+		"$defs": {
+			"Documented": {"type": "object", "description": "Has docs"},
+			"Undocumented": {"type": "object"}
+		}
+	}`
+
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	if len(result.Issues) != 1 || result.Issues[0].Path.String() != "$/$defs/Undocumented" {
+		t.Errorf("Expected one issue for Undocumented, got: %v", result.Issues)
+	}
+}
+
+func TestRulesCategorized(t *testing.T) {
+	for _, r := range Rules() {
+		if !slices.Contains(Categories(), r.Category) {
+			t.Errorf("Rule %s has unknown category %q", r.Code, r.Category)
+		}
+	}
+}
+
+func TestLintCategories(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Shape": {"oneOf": [
+				{"type": "object", "properties": {"radius": {"type": "number"}}},
+				{"type": "object", "properties": {"side": {"type": "number"}}}
+			]},
+			"Event": {"type": "object", "properties": {"created_at": {"type": "string"}}}
+		}
+	}`
+
+	config := DefaultConfig()
+	config.PropertyCase = CaseNone
+	config.Categories = []Category{CategoryTyping}
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if len(result.Issues) == 0 {
+		t.Fatal("Expected typing issues")
+	}
+	for _, issue := range result.Issues {
+		if r, _ := LookupRule(issue.Code); r.Category != CategoryTyping {
+			t.Errorf("Unexpected %s issue outside the typing category", issue.Code)
+		}
+	}
+
+	config.Categories = []Category{"style"}
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for unknown category")
+	}
+}
+
+func TestOnlyRules(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Shape": {"oneOf": [
+				{"type": "object", "properties": {"radius": {"type": "number"}}},
+				{"type": "object", "properties": {"side": {"type": "number"}}}
+			]},
+			"Event": {
+				"type": "object",
+				"properties": {
+					"created_at": {"type": "string"},
+					"user_id": {"type": "string", "pattern": "[a-z]+"},
+					"total_amount": {"type": "number"},
+					"payload": {"type": "object"},
+					"status": {"enum": [0, 1]},
+					"tags": {"type": "object", "additionalProperties": {"anyOf": [{"type": "string"}, {"type": "integer"}]}}
+				}
+			},
+			"Child": {"allOf": [{"$ref": "#/$defs/Event"}, {"type": "object", "properties": {"user_id": {"type": "integer"}}}]},
+			"bad name": {"type": "string", "minimum": 1}
+		}
+	}`
+
+	config := DefaultConfig()
+	if err := config.SetStrictness(StrictnessPedantic); err != nil {
+		t.Fatal(err)
+	}
+	full, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if len(full.Issues) < 10 {
+		t.Fatalf("Expected issues of many rules, got %v", full.Issues)
+	}
+
+	// Running one rule reports what it reports in a full run
+	for _, r := range Rules() {
+		var want []string
+		for _, issue := range full.Issues {
+			if issue.Code == r.Code {
+				want = append(want, issue.Path.String()+" "+string(issue.Severity))
+			}
+		}
+		only := config
+		only.OnlyRules = []IssueCode{r.Code}
+		result, err := New(only).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("%s: failed to lint: %v", r.Code, err)
+		}
+		var got []string
+		for _, issue := range result.Issues {
+			if issue.Code != r.Code {
+				t.Errorf("%s: unexpected %s issue", r.Code, issue.Code)
+			}
+			got = append(got, issue.Path.String()+" "+string(issue.Severity))
+		}
+		slices.Sort(want)
+		slices.Sort(got)
+		if !slices.Equal(got, want) {
+			t.Errorf("%s: expected %v, got %v", r.Code, want, got)
+		}
+	}
+}
+
+func TestOnlyRulesSkipsOtherRules(t *testing.T) {
+	calls := 0
+	counter := NewRule(RuleInfo{Code: "counter", Severity: SeverityInfo}, func(*Schema, Path) []Issue {
+		calls++
+		return nil
+	})
+	config := DefaultConfig()
+	config.Rules = []Rule{counter}
+	config.OnlyRules = []IssueCode{CodeInvalidPropertyCase}
+	if err := config.Validate(); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	result, err := New(config).Lint([]byte(`{"type": "object", "properties": {"first_name": {"type": "string"}}}`))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if calls != 0 {
+		t.Errorf("Expected the custom rule not to run, ran %d times", calls)
+	}
+	if len(result.Issues) != 1 || result.Issues[0].Code != CodeInvalidPropertyCase {
+		t.Errorf("Expected one invalid-property-case issue, got %v", result.Issues)
+	}
+
+	config.OnlyRules = []IssueCode{"counter"}
+	if _, err := New(config).Lint([]byte(`{"type": "string"}`)); err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if calls != 1 {
+		t.Errorf("Expected the custom rule to run once, ran %d times", calls)
+	}
+
+	config.OnlyRules = []IssueCode{"no-such-rule"}
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for unknown rule")
+	}
+}
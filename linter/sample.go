@@ -0,0 +1,494 @@
+package linter
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// SampleError reports why no sample instance could be generated for a schema.
+type SampleError struct {
+	// Path is the location of the schema that could not be sampled.
+	Path    Path
+	Message string
+	// Unsatisfiable is true if no instance can satisfy the schema, as opposed
+	// to the generator being unable to construct one (e.g., for a pattern).
+	Unsatisfiable bool
+}
+
+func (e *SampleError) Error() string {
+	return fmt.Sprintf("%s: %s", e.Path, e.Message)
+}
+
+// formatSamples are example values for common string formats.
+var formatSamples = map[string]string{
+	"date-time":     "2024-01-01T00:00:00Z",
+	"date":          "2024-01-01",
+	"time":          "00:00:00Z",
+	"duration":      "P1D",
+	"email":         "user@example.com",
+	"idn-email":     "user@example.com",
+	"hostname":      "example.com",
+	"idn-hostname":  "example.com",
+	"ipv4":          "192.0.2.1",
+	"ipv6":          "2001:db8::1",
+	"uri":           "https://example.com",
+	"iri":           "https://example.com",
+	"url":           "https://example.com",
+	"uri-reference": "/example",
+	"iri-reference": "/example",
+	"uri-template":  "https://example.com/{id}",
+	"uuid":          "00000000-0000-0000-0000-000000000000",
+	"json-pointer":  "/example",
+	"regex":         ".*",
+}
+
+// Sample generates an example instance of the schema, honoring const
+// discriminators, enums, required properties, formats, and basic numeric,
+// length, and size constraints. Local $refs are resolved against the schema.
+// It returns a *SampleError if no instance could be generated.
+func Sample(schema *Schema) (any, error) {
+	return newSampler(schema, rootPath).sample(schema, rootPath)
+}
+
+// sampler generates sample instances for schemas within a document.
+type sampler struct {
+	doc  *Schema
+	root Path
+	// active holds the $refs being expanded, by their depth of expansion,
+	// to detect required recursion.
+	active map[string]int
+	// cutoff is the smallest depth of an active $ref that recursion was cut
+	// at while sampling the current $ref target.
+	cutoff int
+	// samples holds the sample of each $ref target by path, so that each
+	// definition is sampled once however often it is referenced.
+	samples map[string]sampleResult
+}
+
+// sampleResult is the sample of a $ref target, or why there is none.
+type sampleResult struct {
+	value any
+	err   error
+}
+
+// newSampler returns a sampler for the document at root.
+func newSampler(doc *Schema, root Path) *sampler {
+	return &sampler{doc: doc, root: root, active: make(map[string]int), cutoff: math.MaxInt, samples: make(map[string]sampleResult)}
+}
+
+func (s *sampler) fail(path Path, unsatisfiable bool, format string, args ...any) *SampleError {
+	return &SampleError{Path: path, Message: fmt.Sprintf(format, args...), Unsatisfiable: unsatisfiable}
+}
+
+func (s *sampler) sample(schema *Schema, path Path) (any, error) {
+	if schema == nil {
+		return nil, nil
+	}
+	if schema.IsBooleanSchema {
+		if !schema.BooleanValue {
+			return nil, s.fail(path, true, "false schema accepts no values")
+		}
+		return nil, nil
+	}
+
+	if ref := schema.RefTarget(); ref != "" {
+		return s.sampleRef(ref, path)
+	}
+	if schema.Const != nil {
+		return schema.Const, nil
+	}
+	if schema.Enum != nil {
+		if len(schema.Enum) == 0 {
+			return nil, s.fail(path, true, "enum has no values")
+		}
+		return schema.Enum[0], nil
+	}
+	if schema.Default != nil {
+		return schema.Default, nil
+	}
+
+	if len(schema.AllOf) > 0 {
+		return s.sampleAllOf(schema, path)
+	}
+	if len(schema.OneOf) > 0 {
+		return s.sampleVariants(schema.OneOf, path.Child("oneOf"))
+	}
+	if len(schema.AnyOf) > 0 {
+		return s.sampleVariants(schema.AnyOf, path.Child("anyOf"))
+	}
+
+	return s.sampleType(schema, path)
+}
+
+// sampleRef samples the target of a local $ref. Samples are cached by
+// target, except failures caused by cutting recursion into a $ref expanded
+// further out, which may succeed when the target is reached another way.
+func (s *sampler) sampleRef(ref string, path Path) (any, error) {
+	target, targetPath, ok := s.resolve(ref)
+	if !ok {
+		return nil, s.fail(path, false, "cannot resolve $ref %s", ref)
+	}
+	if depth, ok := s.active[ref]; ok {
+		s.cutoff = min(s.cutoff, depth)
+		return nil, s.fail(path, true, "$ref %s recurses without a terminating alternative", ref)
+	}
+	if cached, ok := s.samples[targetPath.String()]; ok {
+		return cached.value, cached.err
+	}
+
+	depth := len(s.active)
+	outer := s.cutoff
+	s.cutoff = math.MaxInt
+	s.active[ref] = depth
+	value, err := s.sample(target, targetPath)
+	delete(s.active, ref)
+	if err == nil || s.cutoff >= depth {
+		s.samples[targetPath.String()] = sampleResult{value, err}
+	}
+	s.cutoff = min(outer, s.cutoff)
+	return value, err
+}
+
+// resolve returns the local definition referenced by ref and its path.
+func (s *sampler) resolve(ref string) (*Schema, Path, bool) {
+	return resolveLocalRef(s.doc, s.root, ref)
+}
+
+// sampleVariants returns a sample of the first variant that can be sampled.
+func (s *sampler) sampleVariants(variants []*Schema, path Path) (any, error) {
+	var firstErr *SampleError
+	unsatisfiable := true
+	for i, v := range variants {
+		value, err := s.sample(v, path.Index(i))
+		if err == nil {
+			return value, nil
+		}
+		se := asSampleError(err)
+		if firstErr == nil {
+			firstErr = se
+		}
+		unsatisfiable = unsatisfiable && se.Unsatisfiable
+	}
+	return nil, s.fail(path, unsatisfiable, "no variant can be sampled (%s)", firstErr.Message)
+}
+
+// sampleAllOf merges samples of the schema's own keywords and each allOf
+// subschema; object samples are merged and other values are taken from the
+// last subschema that produced one.
+func (s *sampler) sampleAllOf(schema *Schema, path Path) (any, error) {
+	base := *schema
+	base.AllOf = nil
+
+	var value any
+	parts := []*Schema{&base}
+	parts = append(parts, schema.AllOf...)
+	for i, part := range parts {
+		partPath := path
+		if i > 0 {
+			partPath = path.Child("allOf").Index(i - 1)
+		}
+		v, err := s.sample(part, partPath)
+		if err != nil {
+			return nil, err
+		}
+		obj, isObj := v.(map[string]any)
+		merged, mergedIsObj := value.(map[string]any)
+		switch {
+		case v == nil:
+		case isObj && mergedIsObj:
+			for k, pv := range obj {
+				merged[k] = pv
+			}
+		case isObj:
+			// Samples can be the default or const of a schema, or shared
+			// by the $refs to a definition, so they are merged into a copy
+			value = maps.Clone(obj)
+		default:
+			value = v
+		}
+	}
+	return value, nil
+}
+
+func (s *sampler) sampleType(schema *Schema, path Path) (any, error) {
+	typ := schema.Type
+	if typ == "" {
+		for _, t := range schema.TypeList {
+			if t != "null" {
+				typ = t
+				break
+			}
+		}
+		if typ == "" && len(schema.TypeList) > 0 {
+			typ = "null"
+		}
+	}
+	if typ == "" {
+		switch {
+		case schema.IsObject():
+			typ = "object"
+		case schema.IsArray():
+			typ = "array"
+		}
+	}
+
+	switch typ {
+	case "object":
+		return s.sampleObject(schema, path)
+	case "array":
+		return s.sampleArray(schema, path)
+	case "string":
+		return s.sampleString(schema, path)
+	case "integer":
+		return s.sampleNumber(schema, path, true)
+	case "number":
+		return s.sampleNumber(schema, path, false)
+	case "boolean":
+		return true, nil
+	default:
+		return nil, nil
+	}
+}
+
+func (s *sampler) sampleObject(schema *Schema, path Path) (any, error) {
+	if schema.MinProperties != nil && schema.MaxProperties != nil && *schema.MinProperties > *schema.MaxProperties {
+		return nil, s.fail(path, true, "minProperties %d exceeds maxProperties %d", *schema.MinProperties, *schema.MaxProperties)
+	}
+
+	required := make(map[string]bool, len(schema.Required))
+	for _, name := range schema.Required {
+		required[name] = true
+	}
+	closed := schema.AdditionalProperties != nil && !*schema.AdditionalProperties
+
+	obj := make(map[string]any)
+	for _, name := range sortedKeys(schema.Properties) {
+		value, err := s.sample(schema.Properties[name], path.Child("properties", name))
+		if err != nil {
+			if required[name] {
+				return nil, err
+			}
+			continue
+		}
+		obj[name] = value
+	}
+
+	for _, name := range schema.Required {
+		if _, ok := obj[name]; ok {
+			continue
+		}
+		if closed {
+			return nil, s.fail(path, true, "required property %q is not allowed by additionalProperties: false", name)
+		}
+		value, err := s.sample(schema.AdditionalPropertiesSchema, path.Child("additionalProperties"))
+		if err != nil {
+			return nil, err
+		}
+		obj[name] = value
+	}
+
+	if schema.MinProperties != nil {
+		for i := 1; len(obj) < *schema.MinProperties; i++ {
+			if closed {
+				return nil, s.fail(path, false, "cannot add properties to reach minProperties %d", *schema.MinProperties)
+			}
+			value, err := s.sample(schema.AdditionalPropertiesSchema, path.Child("additionalProperties"))
+			if err != nil {
+				return nil, err
+			}
+			obj[fmt.Sprintf("property%d", i)] = value
+		}
+	}
+	return obj, nil
+}
+
+func (s *sampler) sampleArray(schema *Schema, path Path) (any, error) {
+	minItems, maxItems := 0, -1
+	if schema.MinItems != nil {
+		minItems = *schema.MinItems
+	}
+	if schema.MaxItems != nil {
+		maxItems = *schema.MaxItems
+	}
+	if maxItems >= 0 && minItems > maxItems {
+		return nil, s.fail(path, true, "minItems %d exceeds maxItems %d", minItems, maxItems)
+	}
+
+	// Elements matching contains come first
+	minContains := 0
+	if schema.Contains != nil {
+		minContains = 1
+		if schema.MinContains != nil {
+			minContains = *schema.MinContains
+		}
+		if schema.MaxContains != nil && minContains > *schema.MaxContains {
+			return nil, s.fail(path, true, "minContains %d exceeds maxContains %d", minContains, *schema.MaxContains)
+		}
+		if maxItems >= 0 && minContains > maxItems {
+			return nil, s.fail(path, true, "minContains %d exceeds maxItems %d", minContains, maxItems)
+		}
+	}
+
+	count := max(minItems, minContains)
+	if count == 0 && maxItems != 0 {
+		count = 1
+	}
+	if count > 1 && schema.UniqueItems != nil && *schema.UniqueItems {
+		return nil, s.fail(path, false, "cannot generate %d unique items", count)
+	}
+
+	arr := make([]any, 0, count)
+	if count == 0 {
+		return arr, nil
+	}
+	if minContains > 0 {
+		contained, err := s.sample(schema.Contains, path.Child("contains"))
+		if err != nil {
+			return nil, err
+		}
+		for i := 0; i < minContains; i++ {
+			arr = append(arr, contained)
+		}
+		if len(arr) == count {
+			return arr, nil
+		}
+	}
+	item, err := s.sample(schema.Items, path.Child("items"))
+	if err != nil {
+		if minItems <= len(arr) {
+			return arr, nil
+		}
+		return nil, err
+	}
+	for len(arr) < count {
+		arr = append(arr, item)
+	}
+	return arr, nil
+}
+
+func (s *sampler) sampleString(schema *Schema, path Path) (any, error) {
+	minLen, maxLen := 0, -1
+	if schema.MinLength != nil {
+		minLen = *schema.MinLength
+	}
+	if schema.MaxLength != nil {
+		maxLen = *schema.MaxLength
+	}
+	if maxLen >= 0 && minLen > maxLen {
+		return nil, s.fail(path, true, "minLength %d exceeds maxLength %d", minLen, maxLen)
+	}
+
+	str, ok := formatSamples[schema.Format]
+	if !ok {
+		str = "string"
+	}
+	if n := len([]rune(str)); n < minLen {
+		str += strings.Repeat("x", minLen-n)
+	}
+	if maxLen >= 0 && len([]rune(str)) > maxLen {
+		str = string([]rune(str)[:maxLen])
+	}
+
+	if schema.Pattern != "" {
+		re, err := regexp.Compile(schema.Pattern)
+		if err == nil && !re.MatchString(str) {
+			return nil, s.fail(path, false, "cannot generate a string matching pattern %q", schema.Pattern)
+		}
+	}
+	return str, nil
+}
+
+func (s *sampler) sampleNumber(schema *Schema, path Path, integer bool) (any, error) {
+	lower, upper := math.Inf(-1), math.Inf(1)
+	lowerExclusive, upperExclusive := false, false
+	if schema.Minimum != nil {
+		lower = *schema.Minimum
+	}
+	if schema.ExclusiveMinimum != nil && *schema.ExclusiveMinimum >= lower {
+		lower, lowerExclusive = *schema.ExclusiveMinimum, true
+	}
+	if schema.Maximum != nil {
+		upper = *schema.Maximum
+	}
+	if schema.ExclusiveMaximum != nil && *schema.ExclusiveMaximum <= upper {
+		upper, upperExclusive = *schema.ExclusiveMaximum, true
+	}
+
+	step := 1.0
+	if !integer {
+		step = 0
+	}
+	if schema.MultipleOf != nil && *schema.MultipleOf > 0 {
+		step = *schema.MultipleOf
+	}
+
+	// Start from zero or the lower bound, rounded up to the step.
+	value := 0.0
+	if value < lower || (lowerExclusive && value == lower) {
+		value = lower
+		if step > 0 {
+			value = math.Ceil(lower/step) * step
+			if lowerExclusive && value == lower {
+				value += step
+			}
+		} else if lowerExclusive {
+			value = lower + 1
+			if value >= upper {
+				value = lower + (upper-lower)/2
+			}
+		}
+	}
+	if value > upper || (upperExclusive && value == upper) {
+		return nil, s.fail(path, true, "no value satisfies the numeric bounds")
+	}
+
+	if integer || value == math.Trunc(value) {
+		return int64(value), nil
+	}
+	return value, nil
+}
+
+// asSampleError converts an error from the sampler to a *SampleError.
+func asSampleError(err error) *SampleError {
+	if se, ok := err.(*SampleError); ok {
+		return se
+	}
+	return &SampleError{Message: err.Error()}
+}
+
+// lintUnsatisfiable reports schemas in the document for which no instance
+// can exist, such as required recursion or contradictory bounds. Failures
+// of the generator itself (e.g., for patterns) are not reported.
+func (l *Linter) lintUnsatisfiable(schema *Schema, root Path, result *Result) {
+	s := newSampler(schema, root)
+	reported := make(map[string]bool)
+	check := func(def *Schema, path Path) {
+		_, err := s.sample(def, path)
+		if err == nil {
+			return
+		}
+		se := asSampleError(err)
+		if !se.Unsatisfiable || reported[se.Path.String()] {
+			return
+		}
+		reported[se.Path.String()] = true
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeUnsatisfiable,
+			Severity:   SeverityWarning,
+			Path:       se.Path,
+			Message:    "No valid instance exists: " + se.Message,
+			Suggestion: "Relax the conflicting constraints or make recursive properties optional",
+		})
+	}
+
+	check(schema, root)
+	for _, name := range sortedKeys(schema.Defs) {
+		check(schema.Defs[name], root.Child("$defs", name))
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		check(schema.Definitions[name], root.Child("definitions", name))
+	}
+}
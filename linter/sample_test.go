@@ -0,0 +1,215 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func sampleJSON(t *testing.T, schemaJSON string) (string, error) {
+	t.Helper()
+	schema, err := ParseSchema([]byte(schemaJSON))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	value, err := Sample(schema)
+	if err != nil {
+		return "", err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("Failed to marshal sample: %v", err)
+	}
+	return string(data), nil
+}
+
+func TestSample(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   string
+	}{
+		{"string format", `{"type": "string", "format": "email"}`, `"user@example.com"`},
+		{"min length", `{"type": "string", "minLength": 8}`, `"stringxx"`},
+		{"integer bounds", `{"type": "integer", "exclusiveMinimum": 3, "multipleOf": 2}`, `4`},
+		{"enum", `{"enum": ["b", "a"]}`, `"b"`},
+		{"nullable type", `{"type": ["null", "boolean"]}`, `true`},
+		{"array min items", `{"type": "array", "items": {"type": "integer"}, "minItems": 2}`, `[0,0]`},
+		{"array contains", `{"type": "array", "items": {"type": "integer"}, "contains": {"const": 7}, "minContains": 2, "minItems": 3}`, `[7,7,0]`},
+		{
+			"discriminated union",
+			`{
+				"$defs": {
+					"Dog": {"type": "object", "properties": {"kind": {"const": "dog"}, "bark": {"type": "boolean"}}, "required": ["kind"]},
+					"Cat": {"type": "object", "properties": {"kind": {"const": "cat"}}, "required": ["kind"]}
+				},
+				"oneOf": [{"$ref": "#/$defs/Dog"}, {"$ref": "#/$defs/Cat"}]
+			}`,
+			`{"bark":true,"kind":"dog"}`,
+		},
+		{
+			"allOf merge",
+			`{"allOf": [
+				{"type": "object", "properties": {"id": {"type": "string", "format": "uuid"}}},
+				{"type": "object", "properties": {"count": {"type": "integer", "minimum": 1}}}
+			]}`,
+			`{"count":1,"id":"00000000-0000-0000-0000-000000000000"}`,
+		},
+		{
+			"optional recursion",
+			`{"$defs": {"Node": {"type": "object", "properties": {"next": {"$ref": "#/$defs/Node"}, "children": {"type": "array", "items": {"$ref": "#/$defs/Node"}}}}}, "$ref": "#/$defs/Node"}`,
+			`{"children":[]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := sampleJSON(t, tt.schema)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Sample = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSampleUnsatisfiable(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		path   string
+	}{
+		{"length bounds", `{"type": "string", "minLength": 5, "maxLength": 2}`, "$"},
+		{"numeric bounds", `{"type": "integer", "minimum": 1, "maximum": 3, "multipleOf": 5}`, "$"},
+		{"contains bounds", `{"type": "array", "contains": {"type": "string"}, "minContains": 3, "maxContains": 1}`, "$"},
+		{"false property", `{"type": "object", "properties": {"x": false}, "required": ["x"]}`, "$/properties/x"},
+		{
+			"required recursion",
+			`{"$defs": {"Node": {"type": "object", "properties": {"next": {"$ref": "#/$defs/Node"}}, "required": ["next"]}}, "$ref": "#/$defs/Node"}`,
+			"$/$defs/Node/properties/next",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := sampleJSON(t, tt.schema)
+			se, ok := err.(*SampleError)
+			if !ok {
+				t.Fatalf("Expected *SampleError, got %v", err)
+			}
+			if !se.Unsatisfiable || se.Path.String() != tt.path {
+				t.Errorf("Expected unsatisfiable at %s, got %+v", tt.path, se)
+			}
+		})
+	}
+}
+
+func TestLintUnsatisfiable(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Node": {"type": "object", "properties": {"next": {"$ref": "#/$defs/Node"}}, "required": ["next"]},
+			"Code": {"type": "string", "pattern": "^[A-Z]{3}$"}
+		},
+		"type": "object",
+		"properties": {"head": {"$ref": "#/$defs/Node"}, "code": {"$ref": "#/$defs/Code"}},
+		"required": ["head"]
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	var paths []string
+	for _, issue := range result.Issues {
+		if issue.Code == CodeUnsatisfiable {
+			paths = append(paths, issue.Path.String())
+		}
+	}
+	// Reported once for Node; the pattern the generator cannot satisfy is not reported.
+	if len(paths) != 1 || paths[0] != "$/$defs/Node/properties/next" {
+		t.Errorf("Expected one unsatisfiable-schema issue for Node, got: %v", paths)
+	}
+}
+
+func TestSampleRefFanOut(t *testing.T) {
+	// Each definition references the next twice; sampling every reference
+	// again would take 2^40 steps
+	const n = 40
+	defs := make([]string, n)
+	for i := range n - 1 {
+		defs[i] = fmt.Sprintf(`"D%d": {"type": "object", "properties": {"a": {"$ref": "#/$defs/D%d"}, "b": {"$ref": "#/$defs/D%d"}}, "required": ["a", "b"]}`, i, i+1, i+1)
+	}
+	defs[n-1] = fmt.Sprintf(`"D%d": {"type": "string"}`, n-1)
+	schema := `{"$ref": "#/$defs/D0", "$defs": {` + strings.Join(defs, ", ") + `}}`
+
+	doc, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	value, err := Sample(doc)
+	if err != nil {
+		t.Fatalf("Failed to sample: %v", err)
+	}
+	for i := range n - 1 {
+		obj, ok := value.(map[string]any)
+		if !ok || obj["b"] == nil {
+			t.Fatalf("Expected an object at depth %d, got %v", i, value)
+		}
+		value = obj["a"]
+	}
+	if _, ok := value.(string); !ok {
+		t.Errorf("Expected a string at depth %d, got %v", n-1, value)
+	}
+	if issues := codeIssues(t, DefaultConfig(), schema, CodeUnsatisfiable); len(issues) != 0 {
+		t.Errorf("Expected no unsatisfiable-schema issues, got %v", issues)
+	}
+
+	// A failure from cutting recursion into an outer $ref is not cached:
+	// Node is reached first within Leaf, where its required leaf recurses,
+	// but can be sampled on its own
+	schema = `{
+		"$defs": {
+			"Node": {"type": "object", "properties": {"leaf": {"$ref": "#/$defs/Leaf"}}, "required": ["leaf"]},
+			"Leaf": {"type": "object", "properties": {"node": {"$ref": "#/$defs/Node"}}}
+		},
+		"type": "object",
+		"properties": {"node": {"$ref": "#/$defs/Node"}, "leaf": {"$ref": "#/$defs/Leaf"}},
+		"required": ["node", "leaf"]
+	}`
+	if _, err := sampleJSON(t, schema); err != nil {
+		t.Errorf("Failed to sample: %v", err)
+	}
+}
+
+func TestSampleAllOfKeepsDefaults(t *testing.T) {
+	schema, err := ParseSchema([]byte(`{
+		"$defs": {"Base": {"type": "object", "default": {"id": 1}}},
+		"type": "object",
+		"properties": {
+			"a": {"allOf": [{"$ref": "#/$defs/Base"}, {"type": "object", "properties": {"name": {"type": "string"}}, "required": ["name"]}]},
+			"b": {"$ref": "#/$defs/Base"}
+		},
+		"required": ["a", "b"]
+	}`))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	value, err := Sample(schema)
+	if err != nil {
+		t.Fatalf("Failed to sample: %v", err)
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		t.Fatalf("Failed to marshal sample: %v", err)
+	}
+	if want := `{"a":{"id":1,"name":"string"},"b":{"id":1}}`; string(data) != want {
+		t.Errorf("Expected %s, got %s", want, data)
+	}
+	if def, _ := json.Marshal(schema.Defs["Base"].Default); string(def) != `{"id":1}` {
+		t.Errorf("Expected the default to be unchanged, got %s", def)
+	}
+}
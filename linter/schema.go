@@ -1,18 +1,27 @@
 package linter
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
 )
 
 // Schema represents a JSON Schema document or subschema.
 // This is a simplified representation focused on the fields needed for linting.
 type Schema struct {
 	// Core
-	Schema      string             `json:"$schema,omitempty"`
-	ID          string             `json:"$id,omitempty"`
-	Ref         string             `json:"$ref,omitempty"`
-	Defs        map[string]*Schema `json:"$defs,omitempty"`
-	Definitions map[string]*Schema `json:"definitions,omitempty"`
+	Schema        string             `json:"$schema,omitempty"`
+	ID            string             `json:"$id,omitempty"`
+	Ref           string             `json:"$ref,omitempty"`
+	Anchor        string             `json:"$anchor,omitempty"`
+	DynamicAnchor string             `json:"$dynamicAnchor,omitempty"`
+	DynamicRef    string             `json:"$dynamicRef,omitempty"`
+	Defs          map[string]*Schema `json:"$defs,omitempty"`
+	Definitions   map[string]*Schema `json:"definitions,omitempty"`
 
 	// Type
 	Type     string   `json:"-"` // Handled specially for type arrays
@@ -28,30 +37,133 @@ type Schema struct {
 	Required                   []string           `json:"required,omitempty"`
 	AdditionalProperties       *bool              `json:"-"` // Handled specially
 	AdditionalPropertiesSchema *Schema            `json:"-"` // Handled specially
+	PropertyNames              *Schema            `json:"propertyNames,omitempty"`
+	MinProperties              *int               `json:"minProperties,omitempty"`
+	MaxProperties              *int               `json:"maxProperties,omitempty"`
 
 	// Array
-	Items *Schema `json:"items,omitempty"`
+	Items           *Schema   `json:"-"` // Handled specially for draft-07 tuples
+	PrefixItems     []*Schema `json:"prefixItems,omitempty"`
+	AdditionalItems *Schema   `json:"additionalItems,omitempty"`
+	MinItems        *int      `json:"minItems,omitempty"`
+	MaxItems        *int      `json:"maxItems,omitempty"`
+	UniqueItems     *bool     `json:"uniqueItems,omitempty"`
+	Contains        *Schema   `json:"contains,omitempty"`
+	MinContains     *int      `json:"minContains,omitempty"`
+	MaxContains     *int      `json:"maxContains,omitempty"`
+
+	// String
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+	Pattern   string `json:"pattern,omitempty"`
+
+	// Content
+	ContentEncoding  string `json:"contentEncoding,omitempty"`
+	ContentMediaType string `json:"contentMediaType,omitempty"`
+
+	// Numeric
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64 `json:"-"` // Handled specially for draft-04 booleans
+	ExclusiveMaximum *float64 `json:"-"` // Handled specially for draft-04 booleans
+	MultipleOf       *float64 `json:"multipleOf,omitempty"`
 
 	// Validation
-	Const any   `json:"const,omitempty"`
-	Enum  []any `json:"enum,omitempty"`
+	Const  any    `json:"const,omitempty"`
+	Enum   []any  `json:"enum,omitempty"`
+	Format string `json:"format,omitempty"`
 
 	// Metadata
 	Title       string `json:"title,omitempty"`
 	Description string `json:"description,omitempty"`
 	Default     any    `json:"default,omitempty"`
+	Examples    []any  `json:"examples,omitempty"`
 
 	// Extension
 	XAbstractComponent *bool `json:"x-abstract-component,omitempty"`
+	// XStability, XOwner, and XSchemalint are kept as written and read with
+	// Stability, Owner, and Annotation, so that a malformed value is
+	// reported as invalid-annotation rather than failing the parse.
+	XStability  json.RawMessage `json:"x-stability,omitempty"`
+	XOwner      json.RawMessage `json:"x-owner,omitempty"`
+	XSchemalint json.RawMessage `json:"x-schemalint,omitempty"`
 
 	// BooleanSchema is true if this schema is a boolean schema (true = accept all, false = reject all).
 	// When IsBooleanSchema is true, BooleanValue holds the value.
 	IsBooleanSchema bool `json:"-"`
 	BooleanValue    bool `json:"-"`
+
+	// UnknownKeywords are the keys of the schema object that are neither
+	// keywords nor extensions the linter reads, sorted, including other x-
+	// extensions. Validators ignore them.
+	UnknownKeywords []string `json:"-"`
+
+	// legacyTuple is true if PrefixItems came from a draft-07 items array,
+	// whose rest is additionalItems rather than items.
+	legacyTuple bool
+}
+
+// ParseSchema parses JSON Schema data, which may start with a byte order
+// mark and contain comments and trailing commas (see NormalizeJSON), into
+// a Schema. Errors are *LintError values wrapping ErrParse.
+func ParseSchema(data []byte) (*Schema, error) {
+	var schema Schema
+	data, err := NormalizeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, parseError(data, 0, err)
+	}
+	return &schema, nil
+}
+
+// ParseDocuments parses data containing one or more schema documents. A JSON
+// array of schemas or newline-delimited JSON schemas (as exported by some
+// registries) is returned as a composite of independent documents. Errors
+// are *LintError values wrapping ErrParse. As with ParseSchema, the data
+// is normalized with NormalizeJSON.
+func ParseDocuments(data []byte) (schemas []*Schema, composite bool, err error) {
+	if data, err = NormalizeJSON(data); err != nil {
+		return nil, false, err
+	}
+	trimmed := bytes.TrimSpace(data)
+	base := len(data) - len(bytes.TrimLeft(data, " \t\r\n"))
+	if len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &schemas); err != nil {
+			return nil, false, parseError(data, base, fmt.Errorf("array: %w", err))
+		}
+		return schemas, true, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(trimmed))
+	for {
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, false, parseError(data, base, err)
+		}
+		schema, err := ParseSchema(raw)
+		if err != nil {
+			return nil, false, fmt.Errorf("document %d: %w", len(schemas), err)
+		}
+		schemas = append(schemas, schema)
+	}
+	if len(schemas) == 0 {
+		return nil, false, &LintError{Err: fmt.Errorf("%w: empty document", ErrParse)}
+	}
+
+	return schemas, len(schemas) > 1, nil
 }
 
 // UnmarshalJSON implements custom unmarshalling to handle boolean schemas and additionalProperties.
 func (s *Schema) UnmarshalJSON(data []byte) error {
+	if isJSONNull(data) {
+		return errNullSchema
+	}
+
 	// First, check if the entire schema is a boolean (true or false)
 	var boolSchema bool
 	if err := json.Unmarshal(data, &boolSchema); err == nil {
@@ -60,11 +172,15 @@ func (s *Schema) UnmarshalJSON(data []byte) error {
 		return nil
 	}
 
-	// Use an alias to avoid infinite recursion
+	// Use an alias to avoid infinite recursion. Numbers in const, enum,
+	// default, and examples are kept as json.Number, since float64 would
+	// round integers beyond 2^53 (e.g., int64 IDs).
 	type schemaAlias Schema
 	var alias schemaAlias
 
-	if err := json.Unmarshal(data, &alias); err != nil {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&alias); err != nil {
 		return err
 	}
 
@@ -75,6 +191,15 @@ func (s *Schema) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &raw); err != nil {
 		return err
 	}
+	for key := range raw {
+		if !slices.Contains(schemaKeywords, key) && !slices.Contains(linterExtensions, key) {
+			s.UnknownKeywords = append(s.UnknownKeywords, key)
+		}
+	}
+	slices.Sort(s.UnknownKeywords)
+	if err := checkNullSubschemas(raw); err != nil {
+		return err
+	}
 
 	// Handle type which can be a string or an array of strings
 	if typeRaw, ok := raw["type"]; ok {
@@ -94,6 +219,27 @@ func (s *Schema) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	// Handle exclusiveMinimum/exclusiveMaximum which are numbers since draft-06
+	// but booleans modifying minimum/maximum in draft-04
+	s.ExclusiveMinimum = parseExclusiveBound(raw["exclusiveMinimum"], s.Minimum)
+	s.ExclusiveMaximum = parseExclusiveBound(raw["exclusiveMaximum"], s.Maximum)
+
+	// Handle items, which before draft 2020-12 can be an array of positional
+	// schemas: a tuple, written with prefixItems since draft 2020-12
+	if itemsRaw, ok := raw["items"]; ok {
+		if trimmed := bytes.TrimSpace(itemsRaw); len(trimmed) > 0 && trimmed[0] == '[' {
+			if err := json.Unmarshal(itemsRaw, &s.PrefixItems); err != nil {
+				return err
+			}
+			s.legacyTuple = true
+		} else {
+			s.Items = &Schema{}
+			if err := json.Unmarshal(itemsRaw, s.Items); err != nil {
+				return err
+			}
+		}
+	}
+
 	// Handle properties - each property can be a bool or schema
 	if propsRaw, ok := raw["properties"]; ok {
 		var propsMap map[string]json.RawMessage
@@ -132,6 +278,69 @@ func (s *Schema) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// errNullSchema is the error for a JSON null where a schema is expected,
+// which is neither an object nor a boolean schema.
+var errNullSchema = errors.New("null is not a valid schema (use true or false)")
+
+// isJSONNull returns true if data is the JSON literal null.
+func isJSONNull(data []byte) bool {
+	return string(bytes.TrimSpace(data)) == "null"
+}
+
+// checkNullSubschemas returns an error naming the first subschema keyword
+// of a schema object whose value, or one of whose values, is null. The
+// decoder would otherwise leave a nil subschema, read as absent or false.
+func checkNullSubschemas(raw map[string]json.RawMessage) error {
+	nullErr := func(location string) error {
+		return fmt.Errorf("%s: %w", location, errNullSchema)
+	}
+	for _, kw := range append([]string{"additionalItems"}, subschemaKeywords...) {
+		if value, ok := raw[kw]; ok && isJSONNull(value) {
+			return nullErr(kw)
+		}
+	}
+	for _, kw := range append([]string{"items"}, subschemaArrayKeywords...) {
+		var values []json.RawMessage
+		if json.Unmarshal(raw[kw], &values) != nil {
+			continue
+		}
+		for i, value := range values {
+			if isJSONNull(value) {
+				return nullErr(fmt.Sprintf("%s/%d", kw, i))
+			}
+		}
+	}
+	for _, kw := range append([]string{"definitions"}, subschemaMapKeywords...) {
+		var values map[string]json.RawMessage
+		if json.Unmarshal(raw[kw], &values) != nil {
+			continue
+		}
+		for _, name := range slices.Sorted(maps.Keys(values)) {
+			if isJSONNull(values[name]) {
+				return nullErr(kw + "/" + escapePointer(name))
+			}
+		}
+	}
+	return nil
+}
+
+// parseExclusiveBound parses an exclusiveMinimum/exclusiveMaximum value. A
+// draft-04 boolean true makes the corresponding inclusive bound exclusive.
+func parseExclusiveBound(raw json.RawMessage, inclusive *float64) *float64 {
+	if raw == nil {
+		return nil
+	}
+	var num float64
+	if err := json.Unmarshal(raw, &num); err == nil {
+		return &num
+	}
+	var flag bool
+	if err := json.Unmarshal(raw, &flag); err == nil && flag {
+		return inclusive
+	}
+	return nil
+}
+
 // IsObject returns true if this schema describes an object type.
 func (s *Schema) IsObject() bool {
 	return s.Type == "object" || len(s.Properties) > 0
@@ -139,7 +348,7 @@ func (s *Schema) IsObject() bool {
 
 // IsArray returns true if this schema describes an array type.
 func (s *Schema) IsArray() bool {
-	return s.Type == "array" || s.Items != nil
+	return s.Type == "array" || s.Items != nil || len(s.PrefixItems) > 0
 }
 
 // IsUnion returns true if this schema is a union type (anyOf or oneOf).
@@ -147,9 +356,9 @@ func (s *Schema) IsUnion() bool {
 	return len(s.AnyOf) > 0 || len(s.OneOf) > 0
 }
 
-// IsRef returns true if this schema is a reference.
+// IsRef returns true if this schema is a reference ($ref or $dynamicRef).
 func (s *Schema) IsRef() bool {
-	return s.Ref != ""
+	return s.Ref != "" || s.DynamicRef != ""
 }
 
 // GetUnionVariants returns the union variants (anyOf takes precedence over oneOf).
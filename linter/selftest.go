@@ -0,0 +1,165 @@
+package linter
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// SuiteReport is the outcome of running the schemas of the JSON Schema Test
+// Suite (https://github.com/json-schema-org/JSON-Schema-Test-Suite) for one
+// draft through the parser, resolver, and linter.
+type SuiteReport struct {
+	Draft string `json:"draft"`
+	// Keywords are the results of each test file, named after the keyword
+	// it covers, sorted by name.
+	Keywords []SuiteKeyword `json:"keywords"`
+}
+
+// SuiteKeyword is the result of the test cases of one keyword.
+type SuiteKeyword struct {
+	Keyword string `json:"keyword"`
+	Cases   int    `json:"cases"`
+	Passed  int    `json:"passed"`
+	// Unmodeled are the keywords used by the cases that the schema model
+	// does not represent, which rules cannot see.
+	Unmodeled []string       `json:"unmodeled,omitempty"`
+	Failures  []SuiteFailure `json:"failures,omitempty"`
+}
+
+// SuiteFailure is a test case whose schema failed to parse, lint, or
+// resolve its local $refs.
+type SuiteFailure struct {
+	Case    string `json:"case"`
+	Problem string `json:"problem"`
+}
+
+// Cases returns the number of test cases run.
+func (r *SuiteReport) Cases() int {
+	n := 0
+	for _, k := range r.Keywords {
+		n += k.Cases
+	}
+	return n
+}
+
+// Failed returns the number of test cases that failed.
+func (r *SuiteReport) Failed() int {
+	n := 0
+	for _, k := range r.Keywords {
+		n += k.Cases - k.Passed
+	}
+	return n
+}
+
+// suiteCase is a test case group of a test suite file; the instances it
+// validates are not needed.
+type suiteCase struct {
+	Description string          `json:"description"`
+	Schema      json.RawMessage `json:"schema"`
+}
+
+// RunTestSuite runs the schemas of the test suite checked out in fsys for a
+// draft directory (e.g., "draft2020-12"), read from tests/<draft>/*.json.
+// The optional tests are not run. Each case fails if its schema cannot be
+// parsed or linted with the default configuration, or if a local $ref in it
+// does not resolve; the keywords it uses that the schema model ignores are
+// reported as unmodeled. A missing draft directory is an error.
+func RunTestSuite(fsys fs.FS, draft string) (*SuiteReport, error) {
+	files, err := fs.Glob(fsys, path.Join("tests", draft, "*.json"))
+	if err != nil {
+		return nil, err
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("no test files in tests/%s", draft)
+	}
+
+	report := &SuiteReport{Draft: draft, Keywords: []SuiteKeyword{}}
+	l := New(DefaultConfig())
+	for _, file := range files {
+		data, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", file, err)
+		}
+		var cases []suiteCase
+		if err := json.Unmarshal(data, &cases); err != nil {
+			return nil, fmt.Errorf("failed to parse %s: %w", file, err)
+		}
+
+		result := SuiteKeyword{Keyword: strings.TrimSuffix(path.Base(file), ".json"), Cases: len(cases)}
+		unmodeled := make(map[string]bool)
+		for _, c := range cases {
+			problems := l.runSuiteCase(c.Schema, unmodeled)
+			for _, problem := range problems {
+				result.Failures = append(result.Failures, SuiteFailure{Case: c.Description, Problem: problem})
+			}
+			if len(problems) == 0 {
+				result.Passed++
+			}
+		}
+		for kw := range unmodeled {
+			result.Unmodeled = append(result.Unmodeled, kw)
+		}
+		slices.Sort(result.Unmodeled)
+		report.Keywords = append(report.Keywords, result)
+	}
+	return report, nil
+}
+
+// runSuiteCase returns the problems with a test case schema, and adds the
+// unmodeled keywords it uses to unmodeled.
+func (l *Linter) runSuiteCase(data []byte, unmodeled map[string]bool) []string {
+	var problems []string
+	doc, err := ParseSchema(data)
+	if err != nil {
+		return []string{"parse: " + err.Error()}
+	}
+	if _, err := l.Lint(data); err != nil {
+		problems = append(problems, "lint: "+err.Error())
+	}
+
+	tree, err := decodeDocument(data)
+	if err != nil {
+		return append(problems, "parse: "+err.Error())
+	}
+	forEachSubschema(tree, func(obj *jsonObject) {
+		for _, member := range obj.members {
+			if slices.Contains(schemaKeywords, member.Key) && !modeledKeywords[member.Key] {
+				unmodeled[member.Key] = true
+			}
+		}
+	})
+
+	seen := make(map[string]bool)
+	for _, ref := range collectRefs(tree) {
+		if !strings.HasPrefix(ref, "#") || seen[ref] {
+			continue
+		}
+		seen[ref] = true
+		if _, ok := resolvePointer(doc, rootPath, ref); !ok {
+			problems = append(problems, fmt.Sprintf("unresolved $ref %q", ref))
+		}
+	}
+	return problems
+}
+
+// modeledKeywords are the keywords represented by Schema fields: those with
+// a JSON tag and those parsed specially.
+var modeledKeywords = func() map[string]bool {
+	modeled := map[string]bool{
+		"type": true, "properties": true, "additionalProperties": true, "items": true,
+		"exclusiveMinimum": true, "exclusiveMaximum": true,
+	}
+	t := reflect.TypeFor[Schema]()
+	for i := range t.NumField() {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			modeled[name] = true
+		}
+	}
+	return modeled
+}()
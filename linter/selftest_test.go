@@ -0,0 +1,54 @@
+package linter
+
+import (
+	"slices"
+	"testing"
+	"testing/fstest"
+)
+
+func TestRunTestSuite(t *testing.T) {
+	fsys := fstest.MapFS{
+		"tests/draft2020-12/ref.json": {Data: []byte(`[
+			{"description": "definition ref", "schema": {"$defs": {"a": {"type": "string"}}, "$ref": "#/$defs/a"}, "tests": []},
+			{"description": "missing definition", "schema": {"$ref": "#/$defs/missing"}, "tests": []},
+			{"description": "remote ref", "schema": {"$ref": "http://example.com/schema"}, "tests": []}
+		]`)},
+		"tests/draft2020-12/prefixItems.json": {Data: []byte(`[
+			{"description": "tuple", "schema": {"prefixItems": [{"type": "integer"}], "items": false, "unevaluatedItems": false}, "tests": []}
+		]`)},
+		"tests/draft2020-12/boolean_schema.json": {Data: []byte(`[
+			{"description": "true", "schema": true, "tests": []}
+		]`)},
+		"tests/draft2020-12/optional/format.json": {Data: []byte(`[]`)},
+	}
+
+	report, err := RunTestSuite(fsys, "draft2020-12")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Keywords) != 3 {
+		t.Fatalf("got %d keywords, want 3: %+v", len(report.Keywords), report.Keywords)
+	}
+	byName := make(map[string]SuiteKeyword)
+	for _, k := range report.Keywords {
+		byName[k.Keyword] = k
+	}
+
+	ref := byName["ref"]
+	if ref.Cases != 3 || ref.Passed != 2 || len(ref.Failures) != 1 || ref.Failures[0].Case != "missing definition" {
+		t.Errorf("ref = %+v, want one failure for the missing definition", ref)
+	}
+	if prefix := byName["prefixItems"]; prefix.Passed != 1 || !slices.Equal(prefix.Unmodeled, []string{"unevaluatedItems"}) {
+		t.Errorf("prefixItems = %+v, want unevaluatedItems unmodeled", prefix)
+	}
+	if b := byName["boolean_schema"]; b.Passed != 1 {
+		t.Errorf("boolean_schema = %+v, want passed", b)
+	}
+	if report.Cases() != 5 || report.Failed() != 1 {
+		t.Errorf("cases = %d, failed = %d, want 5 and 1", report.Cases(), report.Failed())
+	}
+
+	if _, err := RunTestSuite(fsys, "draft7"); err == nil {
+		t.Error("expected an error for a missing draft")
+	}
+}
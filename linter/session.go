@@ -0,0 +1,262 @@
+package linter
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// Session lints the schema files of a project incrementally, for editors
+// and watch modes. It keeps each document's content, parsed schemas, and
+// last result in memory, so that Relint only lints documents changed since
+// their last lint: the updated document, the documents that $ref it
+// directly or through other documents, and those declaring the same root
+// $id. Root $ids declared by two documents of the session are reported as
+// duplicate-id in the one whose path sorts last. A Session is safe for
+// concurrent use.
+type Session struct {
+	linter *Linter
+
+	mu   sync.Mutex
+	docs map[string]*sessionDoc
+}
+
+// sessionDoc is a document of a session.
+type sessionDoc struct {
+	data []byte
+	// ids are the root $ids of the parsed documents
+	ids []declaredID
+	// refs are the documents referenced by $refs, as written without the
+	// fragment (e.g., "common.json" or "https://schemas.example.com/a.json")
+	refs []string
+	// base is the $id the refs are resolved against, if absolute
+	base *url.URL
+
+	result *Result
+	err    error
+	stale  bool
+}
+
+// NewSession returns an empty session linting with l.
+func (l *Linter) NewSession() *Session {
+	return &Session{linter: l, docs: make(map[string]*sessionDoc)}
+}
+
+// Update sets the content of a document, adding it to the session if
+// needed, and returns the paths of the documents whose results may have
+// changed, sorted, including path. Content that does not parse is kept,
+// and Relint returns its parse error.
+func (s *Session) Update(path string, data []byte) []string {
+	key := filepath.Clean(path)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	affected := s.affected(key)
+	doc := &sessionDoc{data: data, stale: true}
+	if schemas, composite, err := ParseDocuments(data); err == nil {
+		for i, schema := range schemas {
+			root := documentPath(i, composite)
+			if schema.ID != "" {
+				doc.ids = append(doc.ids, declaredID{id: normalizeID(schema.ID), path: root})
+			}
+			doc.refs = append(doc.refs, externalRefs(schema)...)
+		}
+		if len(schemas) == 1 {
+			if base, err := url.Parse(schemas[0].ID); err == nil && base.IsAbs() {
+				doc.base = base
+			}
+		}
+	}
+	s.docs[key] = doc
+	return s.invalidate(append(affected, s.affected(key)...))
+}
+
+// Remove removes a document from the session (e.g., when its file is
+// deleted) and returns the paths of the remaining documents whose results
+// may have changed, sorted.
+func (s *Session) Remove(path string) []string {
+	key := filepath.Clean(path)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.docs[key] == nil {
+		return []string{}
+	}
+	affected := s.affected(key)
+	delete(s.docs, key)
+	return s.invalidate(affected)
+}
+
+// Relint returns the result of a document of the session, linting it
+// first if it changed since its last lint. As with LintFile, SchemaPath is
+// set to path, and the root $id is checked against IDTemplate for path.
+func (s *Session) Relint(path string) (*Result, error) {
+	key := filepath.Clean(path)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	doc := s.docs[key]
+	if doc == nil {
+		return nil, fmt.Errorf("%s is not in the session", path)
+	}
+	if doc.stale {
+		doc.result, doc.err = s.linter.Lint(doc.data)
+		if doc.err != nil {
+			var le *LintError
+			if errors.As(doc.err, &le) {
+				le.File = key
+			}
+		} else {
+			doc.result.SchemaPath = key
+			s.linter.CheckIDLocation(doc.result, IDLocation{Path: key})
+			s.linter.ApplyPathClass(doc.result, key)
+		}
+		doc.stale = false
+	}
+	if doc.err != nil {
+		return nil, doc.err
+	}
+
+	// Root $ids of other documents may have changed since the lint, so
+	// duplicates are checked on a copy of the cached result.
+	result := *doc.result
+	result.Issues = slices.Clone(doc.result.Issues)
+	result.Suppressed = slices.Clone(doc.result.Suppressed)
+	var results []*Result
+	for _, other := range sortedDocKeys(s.docs) {
+		if other < key && s.sharesID(s.docs[other], doc) {
+			results = append(results, &Result{SchemaPath: other, ids: s.docs[other].ids})
+		}
+	}
+	s.linter.CheckDuplicateIDs(append(results, &result))
+	return &result, nil
+}
+
+// Dependents returns the paths of the documents that $ref a document,
+// directly or through other documents, sorted.
+func (s *Session) Dependents(path string) []string {
+	key := filepath.Clean(path)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	deps := append([]string{}, s.referrers(key)...)
+	slices.Sort(deps)
+	return deps
+}
+
+// affected returns a document, the documents that $ref it directly or
+// transitively, and the documents declaring one of its root $ids.
+func (s *Session) affected(key string) []string {
+	affected := append([]string{key}, s.referrers(key)...)
+	if doc := s.docs[key]; doc != nil {
+		for other, d := range s.docs {
+			if other != key && s.sharesID(d, doc) {
+				affected = append(affected, other)
+			}
+		}
+	}
+	return affected
+}
+
+// referrers returns the documents that $ref a document, directly or
+// transitively, in no particular order.
+func (s *Session) referrers(key string) []string {
+	var found []string
+	seen := map[string]bool{key: true}
+	queue := []string{key}
+	for len(queue) > 0 {
+		target := queue[0]
+		queue = queue[1:]
+		for other, doc := range s.docs {
+			if !seen[other] && s.references(other, doc, target) {
+				seen[other] = true
+				found = append(found, other)
+				queue = append(queue, other)
+			}
+		}
+	}
+	return found
+}
+
+// references reports whether the document at key has a $ref to the
+// document at target: a relative reference to its file, or a reference
+// to one of its root $ids, absolute or resolved against the referencing
+// document's $id.
+func (s *Session) references(key string, doc *sessionDoc, target string) bool {
+	targetDoc := s.docs[target]
+	for _, ref := range doc.refs {
+		u, err := url.Parse(ref)
+		if err != nil {
+			continue
+		}
+		if !u.IsAbs() && filepath.Join(filepath.Dir(key), filepath.FromSlash(u.Path)) == target {
+			return true
+		}
+		if doc.base != nil {
+			u = doc.base.ResolveReference(u)
+		}
+		if targetDoc != nil && u.IsAbs() && slices.ContainsFunc(targetDoc.ids, func(d declaredID) bool {
+			return d.id == u.String()
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+// sharesID reports whether two documents declare a common root $id.
+func (s *Session) sharesID(a, b *sessionDoc) bool {
+	for _, d := range a.ids {
+		if slices.ContainsFunc(b.ids, func(e declaredID) bool { return e.id == d.id }) {
+			return true
+		}
+	}
+	return false
+}
+
+// invalidate marks the documents of the session among keys as changed,
+// and returns them sorted, once each.
+func (s *Session) invalidate(keys []string) []string {
+	invalidated := []string{}
+	for _, key := range keys {
+		if doc := s.docs[key]; doc != nil && !slices.Contains(invalidated, key) {
+			doc.stale = true
+			invalidated = append(invalidated, key)
+		}
+	}
+	slices.Sort(invalidated)
+	return invalidated
+}
+
+// externalRefs returns the documents referenced by the $refs of a schema
+// and its definitions, without the fragment, once each.
+func externalRefs(schema *Schema) []string {
+	var refs []string
+	visit := func(s *Schema, _ Path, _ bool) {
+		if doc, _, _ := strings.Cut(s.RefTarget(), "#"); doc != "" && !slices.Contains(refs, doc) {
+			refs = append(refs, doc)
+		}
+	}
+	walkSchema(schema, rootPath, false, visit)
+	for _, name := range sortedKeys(schema.Defs) {
+		walkSchema(schema.Defs[name], rootPath, false, visit)
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		walkSchema(schema.Definitions[name], rootPath, false, visit)
+	}
+	return refs
+}
+
+// sortedDocKeys returns the paths of the documents of a session, sorted.
+func sortedDocKeys(docs map[string]*sessionDoc) []string {
+	keys := make([]string, 0, len(docs))
+	for k := range docs {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
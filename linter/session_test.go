@@ -0,0 +1,84 @@
+package linter
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSessionInvalidation(t *testing.T) {
+	s := NewWithDefaults().NewSession()
+
+	common := `{"$id": "https://schemas.example.com/common.json", "$defs": {"Money": {"type": "object", "properties": {"amount": {"type": "string"}}}}}`
+	order := `{"$id": "https://schemas.example.com/orders/order.json", "type": "object", "properties": {"total": {"$ref": "../common.json#/$defs/Money"}}}`
+	invoice := `{"type": "object", "properties": {"order": {"$ref": "orders/order.json"}}}`
+	other := `{"type": "object", "properties": {"name": {"type": "string"}}}`
+
+	for path, data := range map[string]string{
+		"common.json": common, "orders/order.json": order, "invoice.json": invoice, "other.json": other,
+	} {
+		s.Update(path, []byte(data))
+	}
+
+	if got, want := s.Dependents("common.json"), []string{"invoice.json", "orders/order.json"}; !slices.Equal(got, want) {
+		t.Errorf("Dependents(common.json) = %v, want %v", got, want)
+	}
+	for _, path := range []string{"common.json", "orders/order.json", "invoice.json", "other.json"} {
+		if _, err := s.Relint(path); err != nil {
+			t.Fatalf("Relint(%s): %v", path, err)
+		}
+	}
+
+	changed := s.Update("common.json", []byte(common))
+	if want := []string{"common.json", "invoice.json", "orders/order.json"}; !slices.Equal(changed, want) {
+		t.Errorf("Update(common.json) = %v, want %v", changed, want)
+	}
+	if s.docs["other.json"].stale {
+		t.Error("other.json should not be invalidated by an unrelated change")
+	}
+
+	if changed := s.Remove("orders/order.json"); !slices.Equal(changed, []string{"invoice.json"}) {
+		t.Errorf("Remove(orders/order.json) = %v, want [invoice.json]", changed)
+	}
+	if _, err := s.Relint("orders/order.json"); err == nil {
+		t.Error("Relint of a removed document should fail")
+	}
+}
+
+func TestSessionRelint(t *testing.T) {
+	s := NewWithDefaults().NewSession()
+	hasDuplicate := func(result *Result) bool {
+		return slices.ContainsFunc(result.Issues, func(i Issue) bool { return i.Code == CodeDuplicateID })
+	}
+
+	s.Update("a.json", []byte(`{"$id": "https://schemas.example.com/a.json", "type": "object"}`))
+	s.Update("b.json", []byte(`{"$id": "https://schemas.example.com/a.json#", "type": "object"}`))
+
+	a, err := s.Relint("a.json")
+	if err != nil {
+		t.Fatalf("Relint(a.json): %v", err)
+	}
+	if hasDuplicate(a) {
+		t.Error("duplicate-id should be reported in the later document only")
+	}
+	b, err := s.Relint("b.json")
+	if err != nil {
+		t.Fatalf("Relint(b.json): %v", err)
+	}
+	if !hasDuplicate(b) || b.SchemaPath != "b.json" {
+		t.Errorf("Relint(b.json) = %+v, want duplicate-id", b)
+	}
+
+	// Fixing the duplicate in a.json clears the finding in b.json
+	changed := s.Update("a.json", []byte(`{"$id": "https://schemas.example.com/c.json", "type": "object"}`))
+	if !slices.Equal(changed, []string{"a.json", "b.json"}) {
+		t.Errorf("Update(a.json) = %v, want [a.json b.json]", changed)
+	}
+	if b, _ = s.Relint("b.json"); hasDuplicate(b) {
+		t.Error("duplicate-id should be cleared once a.json changes its $id")
+	}
+
+	s.Update("broken.json", []byte(`{"type": `))
+	if _, err := s.Relint("broken.json"); err == nil {
+		t.Error("Relint of unparseable content should return the parse error")
+	}
+}
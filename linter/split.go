@@ -0,0 +1,169 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// definitionCluster is a group of definitions proposed to move into a
+// separate file.
+type definitionCluster struct {
+	names []string
+	// internal counts the $refs between definitions of the cluster, and
+	// external those between the cluster and the rest of the document
+	internal, external int
+}
+
+// lintDefinitionSplits suggests groups of definitions to split out of a
+// document with more definitions than MaxDefinitions: clusters that
+// reference each other more than the rest of the document, each within
+// the budget.
+func (l *Linter) lintDefinitionSplits(schema *Schema, root Path, result *Result) {
+	for _, c := range definitionClusters(schema, root, l.config.MaxDefinitions) {
+		result.Issues = append(result.Issues, Issue{
+			Code:     CodeDefinitionSplit,
+			Severity: SeverityInfo,
+			Path:     root,
+			Message: fmt.Sprintf("Definitions %s could form a separate file: they have %d $ref(s) among themselves and %d to or from the rest of the document",
+				strings.Join(c.names, ", "), c.internal, c.external),
+			Suggestion: "Move the definitions into their own schema file, and point the $refs to them at that file",
+		})
+	}
+}
+
+// definitionClusters groups the definitions of a document by their $refs,
+// merging the pair of groups that most increases the modularity of the
+// reference graph until no merge does or would exceed limit definitions.
+// Groups of two or more definitions with more $refs among themselves than
+// to or from the rest of the document are returned, largest first.
+func definitionClusters(schema *Schema, root Path, limit int) []definitionCluster {
+	paths := definitionPaths(schema, root)
+	index := make(map[string]int, len(paths))
+	for i, path := range paths {
+		index[path.String()] = i
+	}
+
+	// weights[i][j] counts the $refs between definitions i and j, in
+	// either direction; rootRefs those between a definition and the root
+	weights := make([]map[int]int, len(paths))
+	for i := range weights {
+		weights[i] = make(map[int]int)
+	}
+	rootRefs := make([]int, len(paths))
+	refs := func(from int) func(s *Schema, _ Path, _ bool) {
+		return func(s *Schema, _ Path, _ bool) {
+			ref := s.RefTarget()
+			if ref == "" {
+				return
+			}
+			_, target, ok := resolveLocalRef(schema, root, ref)
+			if !ok {
+				return
+			}
+			to, ok := index[definitionPath(root, target).String()]
+			switch {
+			case !ok && from >= 0:
+				rootRefs[from]++
+			case ok && from < 0:
+				rootRefs[to]++
+			case ok && from != to:
+				weights[from][to]++
+				weights[to][from]++
+			}
+		}
+	}
+	walkSchema(schema, root, false, refs(-1))
+	for i, path := range paths {
+		def := schema.Defs[path.Last()]
+		if path.Parent().Last() == "definitions" {
+			def = schema.Definitions[path.Last()]
+		}
+		walkSchema(def, path, false, refs(i))
+	}
+
+	degree := make([]int, len(paths))
+	total := 0
+	for i := range weights {
+		for _, w := range weights[i] {
+			degree[i] += w
+		}
+		total += degree[i]
+	}
+	if total == 0 {
+		return nil
+	}
+	members := make([][]int, len(paths))
+	internal := make([]int, len(paths))
+	for i := range members {
+		members[i] = []int{i}
+	}
+
+	m2 := float64(total)
+	for {
+		best, from, into := 0.0, -1, -1
+		for i := range weights {
+			if members[i] == nil {
+				continue
+			}
+			for _, j := range sortedIndexes(weights[i]) {
+				if j <= i || len(members[i])+len(members[j]) > limit {
+					continue
+				}
+				gain := float64(weights[i][j])/m2 - float64(degree[i])*float64(degree[j])/(m2*m2)
+				if gain > best {
+					best, from, into = gain, j, i
+				}
+			}
+		}
+		if from < 0 {
+			break
+		}
+		internal[into] += internal[from] + weights[into][from]
+		delete(weights[into], from)
+		delete(weights[from], into)
+		for k, w := range weights[from] {
+			weights[into][k] += w
+			weights[k][into] += w
+			delete(weights[k], from)
+		}
+		weights[from] = nil
+		degree[into] += degree[from]
+		members[into] = append(members[into], members[from]...)
+		members[from] = nil
+	}
+
+	var clusters []definitionCluster
+	for i, group := range members {
+		if len(group) < 2 {
+			continue
+		}
+		c := definitionCluster{internal: internal[i], external: degree[i] - 2*internal[i]}
+		for _, def := range group {
+			c.external += rootRefs[def]
+			c.names = append(c.names, paths[def].Last())
+		}
+		if c.internal <= c.external {
+			continue
+		}
+		slices.Sort(c.names)
+		clusters = append(clusters, c)
+	}
+	slices.SortStableFunc(clusters, func(a, b definitionCluster) int {
+		if len(a.names) != len(b.names) {
+			return len(b.names) - len(a.names)
+		}
+		return strings.Compare(a.names[0], b.names[0])
+	})
+	return clusters
+}
+
+// sortedIndexes returns the keys of a weight map in ascending order.
+func sortedIndexes(m map[int]int) []int {
+	keys := make([]int, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
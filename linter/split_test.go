@@ -0,0 +1,59 @@
+package linter
+
+import (
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestDefinitionSplits(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {"order": {"$ref": "#/$defs/Order"}, "user": {"$ref": "#/$defs/User"}},
+		"$defs": {
+			"Order": {"type": "object", "properties": {"lines": {"type": "array", "items": {"$ref": "#/$defs/OrderLine"}}, "status": {"$ref": "#/$defs/OrderStatus"}, "buyer": {"$ref": "#/$defs/User"}}},
+			"OrderLine": {"type": "object", "properties": {"status": {"$ref": "#/$defs/OrderStatus"}, "sku": {"type": "string"}}},
+			"OrderStatus": {"type": "string", "enum": ["OPEN", "CLOSED"]},
+			"User": {"type": "object", "properties": {"address": {"$ref": "#/$defs/Address"}, "billing": {"$ref": "#/$defs/Address"}, "role": {"$ref": "#/$defs/UserRole"}}},
+			"Address": {"type": "object", "properties": {"city": {"type": "string"}}},
+			"UserRole": {"type": "string", "enum": ["ADMIN", "MEMBER"]},
+			"Unused": {"type": "string"}
+		}
+	}`
+
+	if got := codeIssues(t, DefaultConfig(), schema, CodeDefinitionSplit); len(got) != 0 {
+		t.Errorf("Expected no suggestions without a budget, got %v", got)
+	}
+
+	config := DefaultConfig()
+	config.MaxDefinitions = 4
+	issues := codeIssues(t, config, schema, CodeDefinitionSplit)
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 suggestions, got %v", issues)
+	}
+	for i, want := range []string{"Address, User, UserRole", "Order, OrderLine, OrderStatus"} {
+		if issues[i].Severity != SeverityInfo || issues[i].Path.String() != "$" || !strings.Contains(issues[i].Message, "Definitions "+want+" ") {
+			t.Errorf("Expected a suggestion for %s, got %v", want, issues[i])
+		}
+	}
+	if !strings.Contains(issues[1].Message, "3 $ref(s) among themselves and 2 to or from") {
+		t.Errorf("Expected the $ref counts of the order cluster, got %q", issues[1].Message)
+	}
+
+	// Clusters stay within the budget
+	config.MaxDefinitions = 2
+	for _, c := range definitionClusters(mustParse(t, schema), rootPath, config.MaxDefinitions) {
+		if len(c.names) > 2 || slices.Contains(c.names, "Unused") {
+			t.Errorf("Unexpected cluster %+v", c)
+		}
+	}
+}
+
+func mustParse(t *testing.T, schema string) *Schema {
+	t.Helper()
+	doc, err := ParseSchema([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to parse: %v", err)
+	}
+	return doc
+}
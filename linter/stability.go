@@ -0,0 +1,39 @@
+package linter
+
+// Stability levels for the x-stability annotation.
+const (
+	StabilityStable       = "stable"
+	StabilityBeta         = "beta"
+	StabilityExperimental = "experimental"
+)
+
+// DefaultStabilityPolicy returns the default severity overrides by
+// x-stability level: findings on stable schemas are errors and findings on
+// experimental schemas are info. Beta schemas keep rule defaults.
+func DefaultStabilityPolicy() map[string]Severity {
+	return map[string]Severity{
+		StabilityStable:       SeverityError,
+		StabilityExperimental: SeverityInfo,
+	}
+}
+
+// applyStabilityPolicy overrides the severity of issues located under
+// schemas annotated with x-stability, using the nearest annotation. Issues
+// before index start belong to other documents and are left unchanged.
+func (l *Linter) applyStabilityPolicy(schema *Schema, root Path, result *Result, start int) {
+	if len(l.config.StabilityPolicy) == 0 {
+		return
+	}
+
+	scopes := collectScopes(schema, root, (*Schema).Stability)
+	if len(scopes) == 0 {
+		return
+	}
+
+	for i := start; i < len(result.Issues); i++ {
+		issue := &result.Issues[i]
+		if severity := l.config.StabilityPolicy[nearestScope(scopes, issue.Path)]; severity != "" {
+			issue.Severity = severity
+		}
+	}
+}
@@ -0,0 +1,71 @@
+package linter
+
+import "testing"
+
+func TestStabilityPolicy(t *testing.T) {
+	schema := `{
+		"x-stability": "beta",
+		"$defs": {
+			"Stable": {
+				"x-stability": "stable",
+				"type": "integer",
+				"minLength": 1
+			},
+			"Experimental": {
+				"x-stability": "experimental",
+				"anyOf": [
+					{"type": "object", "properties": {"bark": {"type": "boolean"}}},
+					{"type": "object", "properties": {"meow": {"type": "boolean"}}}
+				]
+			},
+			"Beta": {
+				"type": "integer",
+				"maxLength": 1
+			}
+		}
+	}`
+
+	result, err := NewWithDefaults().Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	want := map[string]Severity{
+		"$/$defs/Stable/minLength":   SeverityError,   // dead-keyword escalated from warning
+		"$/$defs/Experimental/anyOf": SeverityInfo,    // union-no-discriminator demoted from error
+		"$/$defs/Beta/maxLength":     SeverityWarning, // dead-keyword keeps its default
+	}
+	for _, issue := range result.Issues {
+		if severity, ok := want[issue.Path.String()]; ok {
+			if issue.Severity != severity {
+				t.Errorf("Expected %s at %s, got %s", severity, issue.Path, issue.Severity)
+			}
+			delete(want, issue.Path.String())
+		}
+	}
+	for path := range want {
+		t.Errorf("Expected an issue at %s, got: %v", path, result.Issues)
+	}
+}
+
+func TestStabilityPolicyDisabled(t *testing.T) {
+	schema := `{"x-stability": "stable", "type": "integer", "minLength": 1}`
+
+	config := DefaultConfig()
+	config.StabilityPolicy = nil
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if len(result.Issues) != 1 || result.Issues[0].Severity != SeverityWarning {
+		t.Errorf("Expected one warning without a stability policy, got: %v", result.Issues)
+	}
+}
+
+func TestStabilityPolicyValidate(t *testing.T) {
+	config := DefaultConfig()
+	config.StabilityPolicy = map[string]Severity{StabilityBeta: "fatal"}
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for unknown severity")
+	}
+}
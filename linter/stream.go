@@ -0,0 +1,351 @@
+package linter
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+	"time"
+)
+
+// LintFileLowMemory lints a JSON Schema file like LintFile, using far less
+// memory for large bundles. The file is memory-mapped rather than read, and
+// its top-level definitions are parsed, linted, and released one at a time:
+// each definition is linted in a document holding only the root schema and
+// the definitions it references, directly or indirectly, and only its own
+// findings are kept. The root schema is linted once, with the definitions
+// it references.
+//
+// Rules that compare definitions with each other only see a definition and
+// those it references: repeated-properties and duplicate $ids across
+// unrelated definitions are not reported, and the pagination and error-shape
+// consistency checks and definition-split suggestions are skipped. Issues do not list the $refs using a
+// definition (ReferencedBy), and Config.Roots is not supported. Files that
+// are not a single JSON object, such as composite files, are linted with
+// Lint. Files read from a file system set by WithFS are not mapped.
+func (l *Linter) LintFileLowMemory(path string) (*Result, error) {
+	if len(l.config.Roots) > 0 {
+		return nil, errors.New("low-memory linting does not support roots")
+	}
+	var data []byte
+	if l.fsys != nil {
+		// Files of an fs.FS cannot be mapped
+		var err error
+		if data, err = fs.ReadFile(l.fsys, path); err != nil {
+			return nil, fmt.Errorf("failed to read file: %w", err)
+		}
+	} else {
+		mapped, unmap, err := mapFile(path)
+		if err != nil {
+			return nil, err
+		}
+		defer unmap()
+		data = mapped
+	}
+
+	result, err := l.lintLowMemory(data)
+	if err != nil {
+		var le *LintError
+		if errors.As(err, &le) {
+			le.File = path
+		}
+		return nil, err
+	}
+	result.SchemaPath = path
+	l.CheckIDLocation(result, IDLocation{Path: path})
+	l.ApplyPathClass(result, path)
+	return result, nil
+}
+
+// lintLowMemory lints data one top-level definition at a time; see
+// LintFileLowMemory.
+func (l *Linter) lintLowMemory(data []byte) (*Result, error) {
+	p := l.newProfiler()
+	start := time.Now()
+	metadata := l.metadata(start)
+	data, err := NormalizeJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	index, ok, err := indexDocument(data)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return l.Lint(data)
+	}
+	root, err := index.parseRoot()
+	if err != nil {
+		return nil, err
+	}
+	if err := checkDraft(root); err != nil {
+		return nil, err
+	}
+	p.add("parse", time.Since(start))
+
+	// Infer the property case from all the definitions, not each part
+	if l.config.PropertyCase == CaseAuto {
+		sample := sampleCase(root)
+		for _, key := range index.keys {
+			def, err := index.parseDefinition(key)
+			if err != nil {
+				return nil, err
+			}
+			sample.add(def)
+		}
+		l = l.withInferredCase(sample)
+	}
+
+	start = time.Now()
+	duplicates, err := duplicateKeys(data, false)
+	if err != nil {
+		return nil, err
+	}
+	p.add("duplicate-key", time.Since(start))
+
+	result := &Result{Issues: []Issue{}, profiler: p}
+	l.lintSize(len(data), result)
+
+	// Group the duplicate keys by the definition they occur in
+	byDefinition := make(map[string][]Issue)
+	for _, issue := range duplicates[rootPath.Root] {
+		path := definitionPath(rootPath, issue.Path).String()
+		byDefinition[path] = append(byDefinition[path], issue)
+	}
+
+	// Lint the root schema, with empty stand-ins for the definitions it does
+	// not reference so that rules counting the definitions see them all
+	doc, err := index.document(root, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	if err := l.lintPart(doc, rootPath, result, byDefinition[rootPath.String()], true); err != nil {
+		return nil, err
+	}
+
+	// Lint each definition with the definitions it references
+	for _, key := range index.keys {
+		keyword, name, _ := strings.Cut(key, "/")
+		path := rootPath.Child(keyword, name)
+		doc, err := index.document(root, []string{key}, false)
+		if err != nil {
+			return nil, err
+		}
+		if err := l.lintPart(doc, path, result, byDefinition[path.String()], false); err != nil {
+			return nil, err
+		}
+	}
+
+	result.Timing, result.profiler = p.timing(), nil
+	result.Metadata = metadata
+	return result, nil
+}
+
+// lintPart lints a partial document and adds to result the findings located
+// at path: the findings of a definition, or, for "$", those outside the
+// definitions. With keepIDs, the root $ids of the document are recorded.
+func (l *Linter) lintPart(doc *Schema, path Path, result *Result, parsed []Issue, keepIDs bool) error {
+	part := &Result{Issues: []Issue{}, profiler: result.profiler}
+	if err := l.lintDocument(doc, rootPath, part, parsed); err != nil {
+		return err
+	}
+	within := func(issue Issue) bool { return definitionPath(rootPath, issue.Path).Equal(path) }
+	for _, issue := range part.Issues {
+		if within(issue) {
+			issue.ReferencedBy = nil
+			result.Issues = append(result.Issues, issue)
+		}
+	}
+	for _, s := range part.Suppressed {
+		if within(s.Issue) {
+			s.Issue.ReferencedBy = nil
+			result.Suppressed = append(result.Suppressed, s)
+		}
+	}
+	if keepIDs {
+		result.ids = part.ids
+	}
+	return nil
+}
+
+// documentIndex locates the top-level definitions of a schema document in
+// its source text, so that they can be parsed one at a time.
+type documentIndex struct {
+	data []byte
+	// root is the source of the root schema without its definitions
+	root []byte
+	// keys are the definitions, as "$defs/name" or "definitions/name", in
+	// sorted order
+	keys []string
+	// spans are the source ranges of the definitions by key
+	spans map[string][2]int
+}
+
+// indexDocument indexes data, reporting false if it is not a single JSON
+// object.
+func indexDocument(data []byte) (*documentIndex, bool, error) {
+	index := &documentIndex{data: data, spans: make(map[string][2]int)}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		return nil, false, nil
+	}
+
+	var root bytes.Buffer
+	root.WriteByte('{')
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, false, parseError(data, 0, err)
+		}
+		key, _ := tok.(string)
+		if (key == "$defs" || key == "definitions") && nextByte(data[dec.InputOffset():]) == '{' {
+			if _, err := dec.Token(); err != nil {
+				return nil, false, parseError(data, 0, err)
+			}
+			for dec.More() {
+				tok, err := dec.Token()
+				if err != nil {
+					return nil, false, parseError(data, 0, err)
+				}
+				name, _ := tok.(string)
+				var raw json.RawMessage
+				if err := dec.Decode(&raw); err != nil {
+					return nil, false, parseError(data, 0, err)
+				}
+				end := int(dec.InputOffset())
+				index.spans[key+"/"+name] = [2]int{end - len(raw), end}
+			}
+			if _, err := dec.Token(); err != nil {
+				return nil, false, parseError(data, 0, err)
+			}
+			continue
+		}
+
+		var raw json.RawMessage
+		if err := dec.Decode(&raw); err != nil {
+			return nil, false, parseError(data, 0, err)
+		}
+		if root.Len() > 1 {
+			root.WriteByte(',')
+		}
+		name, _ := json.Marshal(key)
+		root.Write(name)
+		root.WriteByte(':')
+		root.Write(raw)
+	}
+	if _, err := dec.Token(); err != nil {
+		return nil, false, parseError(data, 0, err)
+	}
+	if _, err := dec.Token(); !errors.Is(err, io.EOF) {
+		// Several documents, or trailing data that Lint reports
+		return nil, false, nil
+	}
+	root.WriteByte('}')
+
+	index.root = root.Bytes()
+	for key := range index.spans {
+		index.keys = append(index.keys, key)
+	}
+	slices.Sort(index.keys)
+	return index, true, nil
+}
+
+// nextByte returns the first byte of data that is not whitespace or the
+// colon after an object key.
+func nextByte(data []byte) byte {
+	rest := bytes.TrimLeft(data, " \t\r\n:")
+	if len(rest) == 0 {
+		return 0
+	}
+	return rest[0]
+}
+
+// parseRoot parses the root schema without its definitions.
+func (idx *documentIndex) parseRoot() (*Schema, error) {
+	var schema Schema
+	if err := json.Unmarshal(idx.root, &schema); err != nil {
+		return nil, parseError(idx.root, 0, err)
+	}
+	return &schema, nil
+}
+
+// parseDefinition parses the definition with the given key.
+func (idx *documentIndex) parseDefinition(key string) (*Schema, error) {
+	span := idx.spans[key]
+	var schema Schema
+	if err := json.Unmarshal(idx.data[span[0]:span[1]], &schema); err != nil {
+		return nil, parseError(idx.data, span[0], err)
+	}
+	return &schema, nil
+}
+
+// refKey returns the key of the definition a local $ref points into.
+func (idx *documentIndex) refKey(ref string) (string, bool) {
+	for _, keyword := range []string{"$defs", "definitions"} {
+		name, ok := strings.CutPrefix(ref, "#/"+keyword+"/")
+		if !ok {
+			continue
+		}
+		name, _, _ = strings.Cut(name, "/")
+		name = unescapePointer(name)
+		if _, ok := idx.spans[keyword+"/"+name]; ok {
+			return keyword + "/" + name, true
+		}
+	}
+	return "", false
+}
+
+// document returns a document holding the root schema, the definitions
+// with the given keys, and the definitions these and the root reference,
+// directly or indirectly. With stubs, the other definitions are added as
+// empty schemas.
+func (idx *documentIndex) document(root *Schema, keys []string, stubs bool) (*Schema, error) {
+	doc := *root
+	doc.Defs, doc.Definitions = nil, nil
+	seen := make(map[string]bool)
+	queue := slices.Clone(keys)
+	for _, key := range keys {
+		seen[key] = true
+	}
+	visit := func(s *Schema, _ Path, _ bool) {
+		if key, ok := idx.refKey(s.RefTarget()); ok && !seen[key] {
+			seen[key] = true
+			queue = append(queue, key)
+		}
+	}
+	walkSchema(root, Path{}, false, visit)
+
+	add := func(key string, def *Schema) {
+		keyword, name, _ := strings.Cut(key, "/")
+		defs := &doc.Defs
+		if keyword == "definitions" {
+			defs = &doc.Definitions
+		}
+		if *defs == nil {
+			*defs = make(map[string]*Schema)
+		}
+		(*defs)[name] = def
+	}
+	for len(queue) > 0 {
+		key := queue[0]
+		queue = queue[1:]
+		def, err := idx.parseDefinition(key)
+		if err != nil {
+			return nil, err
+		}
+		walkSchema(def, Path{}, false, visit)
+		add(key, def)
+	}
+	if stubs {
+		for _, key := range idx.keys {
+			if !seen[key] {
+				add(key, &Schema{})
+			}
+		}
+	}
+	return &doc, nil
+}
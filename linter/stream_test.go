@@ -0,0 +1,101 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestLintFileLowMemory(t *testing.T) {
+	schema := `{
+		"$schema": "https://json-schema.org/draft/2020-12/schema",
+		"type": "object",
+		"properties": {"pet": {"$ref": "#/$defs/Pet"}, "user_name": {"type": "string"}},
+		"$defs": {
+			"Pet": {"anyOf": [{"$ref": "#/$defs/Cat"}, {"$ref": "#/$defs/Dog"}]},
+			"Cat": {"type": "object", "properties": {"kind": {"const": "cat"}, "lives": {"type": "integer"}}},
+			"Dog": {"type": "object", "properties": {"kind": {"const": "dog"}, "goodBoy": {"type": "boolean"}}},
+			"Empty": {"type": "object", "properties": {"a": {"type": "string"}, "a": {"type": "integer"}}}
+		},
+		"definitions": {"Legacy": {"title": "Other", "type": "object"}}
+	}`
+	path := filepath.Join(t.TempDir(), "bundle.json")
+	if err := os.WriteFile(path, []byte(schema), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	l := NewWithDefaults()
+	want, err := l.LintFile(path)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	got, err := l.LintFileLowMemory(path)
+	if err != nil {
+		t.Fatalf("Failed to lint with low memory: %v", err)
+	}
+	if got.SchemaPath != path {
+		t.Errorf("Expected schema path %s, got %s", path, got.SchemaPath)
+	}
+
+	issues := func(r *Result) []string {
+		var s []string
+		for _, issue := range r.Issues {
+			s = append(s, string(issue.Code)+" "+issue.Path.String()+" "+issue.Message)
+		}
+		slices.Sort(s)
+		return s
+	}
+	if len(want.Issues) == 0 {
+		t.Fatal("Expected issues in the test schema")
+	}
+	if !slices.Equal(issues(got), issues(want)) {
+		t.Errorf("Expected the issues of LintFile:\n%s\ngot:\n%s", strings.Join(issues(want), "\n"), strings.Join(issues(got), "\n"))
+	}
+}
+
+func TestLintFileLowMemoryComposite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "schemas.ndjson")
+	if err := os.WriteFile(path, []byte("{\"type\": \"object\"}\n{\"type\": \"string\"}\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	result, err := NewWithDefaults().LintFileLowMemory(path)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	for _, issue := range result.Issues {
+		if !strings.HasPrefix(issue.Path.String(), "[") {
+			t.Errorf("Expected composite paths, got %s", issue.Path)
+		}
+	}
+}
+
+func TestLintFileLowMemoryRoots(t *testing.T) {
+	config := DefaultConfig()
+	config.Roots = []string{"#/$defs/A"}
+	if _, err := New(config).LintFileLowMemory("unused.json"); err == nil {
+		t.Error("Expected an error for roots")
+	}
+}
+
+func TestIndexDocument(t *testing.T) {
+	data := []byte(`{"type": "object", "$defs": {"A": {"type": "string"}, "B": true}, "definitions": "invalid"}`)
+	index, ok, err := indexDocument(data)
+	if err != nil || !ok {
+		t.Fatalf("Failed to index: ok=%t, %v", ok, err)
+	}
+	if !slices.Equal(index.keys, []string{"$defs/A", "$defs/B"}) {
+		t.Errorf("Unexpected keys: %v", index.keys)
+	}
+	span := index.spans["$defs/A"]
+	if got := string(data[span[0]:span[1]]); got != `{"type": "string"}` {
+		t.Errorf("Unexpected span of A: %s", got)
+	}
+	if got := string(index.root); got != `{"type":"object","definitions":"invalid"}` {
+		t.Errorf("Unexpected root: %s", got)
+	}
+	if _, ok, _ := indexDocument([]byte(`[{"type": "object"}]`)); ok {
+		t.Error("Expected an array not to be indexed")
+	}
+}
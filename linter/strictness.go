@@ -0,0 +1,58 @@
+package linter
+
+import "fmt"
+
+// Strictness is a preset for the rule thresholds and opt-in rules of a
+// profile, so one setting can tighten or loosen the linter.
+type Strictness string
+
+const (
+	// StrictnessRelaxed raises the thresholds, for adopting the linter on
+	// existing schemas.
+	StrictnessRelaxed Strictness = "relaxed"
+	// StrictnessStandard uses the default thresholds.
+	StrictnessStandard Strictness = "standard"
+	// StrictnessPedantic lowers the thresholds and enables the opt-in rules
+	// (prose-enum, nullable-optional, missing-unit-suffix, and
+	// unresolved-union as an error).
+	StrictnessPedantic Strictness = "pedantic"
+)
+
+// strictnessPreset holds the settings a strictness level controls.
+type strictnessPreset struct {
+	maxUnionVariants      int
+	maxUnionNestingDepth  int
+	maxEnumValues         int
+	maxObjectNestingDepth int
+	maxArrayNestingDepth  int
+	optIn                 bool
+}
+
+var strictnessPresets = map[Strictness]strictnessPreset{
+	StrictnessRelaxed:  {maxUnionVariants: 20, maxUnionNestingDepth: 4, maxEnumValues: 250, maxObjectNestingDepth: 4, maxArrayNestingDepth: 2},
+	StrictnessStandard: {maxUnionVariants: 10, maxUnionNestingDepth: 2, maxEnumValues: 100, maxObjectNestingDepth: 2, maxArrayNestingDepth: 1},
+	StrictnessPedantic: {maxUnionVariants: 5, maxUnionNestingDepth: 1, maxEnumValues: 50, maxObjectNestingDepth: 1, maxArrayNestingDepth: 1, optIn: true},
+}
+
+// SetStrictness sets the strictness level and the settings it controls:
+// the union size, union nesting, enum size, and object and array nesting
+// thresholds, and the opt-in rules DetectProseEnums,
+// DetectNullableOptional, DetectUnitSuffixes, and StrictUnresolved.
+// Other settings are unchanged.
+func (c *Config) SetStrictness(s Strictness) error {
+	p, ok := strictnessPresets[s]
+	if !ok {
+		return fmt.Errorf("unknown strictness: %s (use 'relaxed', 'standard', or 'pedantic')", s)
+	}
+	c.Strictness = s
+	c.MaxUnionVariants = p.maxUnionVariants
+	c.MaxUnionNestingDepth = p.maxUnionNestingDepth
+	c.MaxEnumValues = p.maxEnumValues
+	c.MaxObjectNestingDepth = p.maxObjectNestingDepth
+	c.MaxArrayNestingDepth = p.maxArrayNestingDepth
+	c.DetectProseEnums = p.optIn
+	c.DetectNullableOptional = p.optIn
+	c.DetectUnitSuffixes = p.optIn
+	c.StrictUnresolved = p.optIn
+	return nil
+}
@@ -0,0 +1,87 @@
+package linter
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestSetStrictness(t *testing.T) {
+	config := DefaultConfig()
+	if err := config.SetStrictness(StrictnessStandard); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	defaults := DefaultConfig()
+	if config.MaxUnionVariants != defaults.MaxUnionVariants || config.MaxEnumValues != defaults.MaxEnumValues ||
+		config.MaxUnionNestingDepth != defaults.MaxUnionNestingDepth || config.DetectProseEnums {
+		t.Errorf("Standard strictness should match the defaults: %+v", config)
+	}
+
+	if err := config.SetStrictness(StrictnessPedantic); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.MaxUnionVariants >= defaults.MaxUnionVariants || !config.DetectProseEnums || !config.DetectUnitSuffixes || !config.StrictUnresolved {
+		t.Errorf("Pedantic strictness should tighten thresholds and enable opt-in rules: %+v", config)
+	}
+
+	if err := config.SetStrictness(StrictnessRelaxed); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if config.MaxUnionVariants <= defaults.MaxUnionVariants || config.DetectProseEnums {
+		t.Errorf("Relaxed strictness should loosen thresholds: %+v", config)
+	}
+
+	if err := config.SetStrictness("lenient"); err == nil {
+		t.Error("Expected error for unknown strictness")
+	}
+}
+
+func TestStrictnessLargeUnion(t *testing.T) {
+	schema := `{"oneOf": [
+		{"type": "object", "properties": {"kind": {"const": "a"}}, "required": ["kind"]},
+		{"type": "object", "properties": {"kind": {"const": "b"}}, "required": ["kind"]},
+		{"type": "object", "properties": {"kind": {"const": "c"}}, "required": ["kind"]},
+		{"type": "object", "properties": {"kind": {"const": "d"}}, "required": ["kind"]},
+		{"type": "object", "properties": {"kind": {"const": "e"}}, "required": ["kind"]},
+		{"type": "object", "properties": {"kind": {"const": "f"}}, "required": ["kind"]}
+	]}`
+
+	for _, tt := range []struct {
+		strictness Strictness
+		want       bool
+	}{
+		{StrictnessStandard, false},
+		{StrictnessPedantic, true},
+	} {
+		config := DefaultConfig()
+		if err := config.SetStrictness(tt.strictness); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		result, err := New(config).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		got := slices.ContainsFunc(result.Issues, func(issue Issue) bool { return issue.Code == CodeLargeUnion })
+		if got != tt.want {
+			t.Errorf("%s: large-union reported = %v, want %v", tt.strictness, got, tt.want)
+		}
+	}
+}
+
+func TestLoadConfigStrictness(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"strictness": "pedantic", "max_union_variants": 8}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	config, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("Failed to load config: %v", err)
+	}
+	if config.MaxUnionVariants != 8 {
+		t.Errorf("Expected explicit max_union_variants to take precedence, got %d", config.MaxUnionVariants)
+	}
+	if config.MaxEnumValues != 50 || !config.DetectProseEnums {
+		t.Errorf("Expected pedantic preset for other settings: %+v", config)
+	}
+}
@@ -0,0 +1,127 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// LintAnnotation is the x-schemalint extension of a schema, which
+// suppresses findings at the schema and everything beneath it:
+//
+//	"x-schemalint": {"ignore": ["large-enum"], "reason": "ISO 3166 country list"}
+type LintAnnotation struct {
+	// Ignore lists the issue codes to suppress.
+	Ignore []IssueCode `json:"ignore,omitempty"`
+	// Reason explains the suppression in suppression reports.
+	Reason string `json:"reason,omitempty"`
+}
+
+// SuppressionSource is the mechanism that suppressed an issue.
+type SuppressionSource string
+
+const (
+	// SuppressedInline is an x-schemalint annotation in the schema.
+	SuppressedInline SuppressionSource = "inline"
+	// SuppressedIgnoredID is a definition matched by IgnoreIDPrefixes.
+	SuppressedIgnoredID SuppressionSource = "ignore_id_prefix"
+	// SuppressedBaseline is a baseline result the issue was already in.
+	SuppressedBaseline SuppressionSource = "baseline"
+)
+
+// Suppression is an issue that was found but not reported.
+type Suppression struct {
+	Issue
+	Source SuppressionSource `json:"source"`
+	Reason string            `json:"reason,omitempty"`
+}
+
+// suppressInline moves the issues from result.Issues[start:] that an
+// enclosing x-schemalint annotation ignores to result.Suppressed.
+func suppressInline(schema *Schema, root Path, result *Result, start int) {
+	var annotations []ignoreScope
+	walkDocument(schema, root, func(s *Schema, path Path) {
+		if a, err := s.Annotation(); err == nil && a != nil && len(a.Ignore) > 0 {
+			annotations = append(annotations, ignoreScope{path, a})
+		}
+	})
+	if len(annotations) == 0 {
+		return
+	}
+
+	kept := result.Issues[:start]
+	for _, issue := range result.Issues[start:] {
+		if a := ignoringAnnotation(annotations, issue); a != nil {
+			result.Suppressed = append(result.Suppressed, Suppression{Issue: issue, Source: SuppressedInline, Reason: a.Reason})
+			continue
+		}
+		kept = append(kept, issue)
+	}
+	result.Issues = kept
+}
+
+// ignoreScope is an x-schemalint annotation that ignores codes and the
+// location of the schema it is on.
+type ignoreScope struct {
+	path       Path
+	annotation *LintAnnotation
+}
+
+// ignoringAnnotation returns the innermost annotation enclosing the issue
+// that ignores its code, or nil.
+func ignoringAnnotation(annotations []ignoreScope, issue Issue) *LintAnnotation {
+	var best *LintAnnotation
+	bestLen := -1
+	for _, scope := range annotations {
+		a := scope.annotation
+		if issue.Path.HasPrefix(scope.path) && len(scope.path.Segments) > bestLen && slices.Contains(a.Ignore, issue.Code) {
+			best, bestLen = a, len(scope.path.Segments)
+		}
+	}
+	return best
+}
+
+// SuppressionCount returns the number of suppressed issues in the results.
+func SuppressionCount(results ...Result) int {
+	n := 0
+	for _, r := range results {
+		n += len(r.Suppressed)
+	}
+	return n
+}
+
+// SuppressionReport returns a human-readable list of the suppressed issues
+// in the results, with totals by source.
+func SuppressionReport(results ...Result) string {
+	total := SuppressionCount(results...)
+	if total == 0 {
+		return "Suppressions: 0\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString("Suppressed issues:\n")
+	bySource := make(map[SuppressionSource]int)
+	for _, r := range results {
+		for _, s := range r.Suppressed {
+			bySource[s.Source]++
+			fmt.Fprintf(&sb, "  [%s] ", s.Source)
+			if len(results) > 1 && r.SchemaPath != "" {
+				fmt.Fprintf(&sb, "%s ", r.SchemaPath)
+			}
+			fmt.Fprintf(&sb, "%s: %s", s.Path, s.Code)
+			if s.Reason != "" {
+				fmt.Fprintf(&sb, " (%s)", s.Reason)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	sources := make([]string, 0, len(bySource))
+	for source, n := range bySource {
+		sources = append(sources, fmt.Sprintf("%s: %d", source, n))
+	}
+	sort.Strings(sources)
+	fmt.Fprintf(&sb, "Suppressions: %d (%s)\n", total, strings.Join(sources, ", "))
+	return sb.String()
+}
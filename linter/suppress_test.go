@@ -0,0 +1,107 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSuppressInline(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Country": {
+				"x-schemalint": {"ignore": ["large-enum"], "reason": "ISO 3166 list"},
+				"enum": ["ad", "ae", "af"]
+			},
+			"Currency": {"enum": ["eur", "usd", "yen"]},
+			"Event": {
+				"x-schemalint": {"ignore": ["stringly-typed-timestamp"]},
+				"type": "object",
+				"properties": {"createdAt": {"type": "string"}, "updated_at": {"type": "string"}}
+			}
+		}
+	}`
+
+	config := DefaultConfig()
+	config.MaxEnumValues = 2
+	config.PropertyCase = CaseNone
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	if len(result.Issues) != 1 || result.Issues[0].Code != CodeLargeEnum || !strings.HasPrefix(result.Issues[0].Path.String(), "$/$defs/Currency") {
+		t.Errorf("Expected only the Currency large-enum issue, got: %v", result.Issues)
+	}
+	if len(result.Suppressed) != 2 {
+		t.Fatalf("Expected 2 suppressed issues, got: %v", result.Suppressed)
+	}
+	for _, s := range result.Suppressed {
+		if s.Source != SuppressedInline {
+			t.Errorf("Unexpected source: %+v", s)
+		}
+		if s.Code == CodeLargeEnum && s.Reason != "ISO 3166 list" {
+			t.Errorf("Expected the annotation reason, got: %+v", s)
+		}
+	}
+}
+
+func TestSuppressIgnoredID(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {"geo": {"$ref": "#/$defs/Point"}},
+		"required": ["geo"],
+		"$defs": {
+			"Point": {
+				"$id": "https://geojson.org/schema/Point.json",
+				"type": "object",
+				"properties": {"type": {"type": "string", "minLength": 5, "maxLength": 1}},
+				"required": ["type"]
+			}
+		}
+	}`
+
+	config := DefaultConfig()
+	config.IgnoreIDPrefixes = []string{"https://geojson.org/"}
+	result, err := New(config).Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if len(result.Suppressed) == 0 {
+		t.Fatal("Expected the findings reached in the vendored definition to be suppressed")
+	}
+	for _, s := range result.Suppressed {
+		if s.Source != SuppressedIgnoredID {
+			t.Errorf("Unexpected source: %+v", s)
+		}
+	}
+	for _, issue := range result.Issues {
+		if strings.HasPrefix(issue.Path.String(), "$/$defs/Point") {
+			t.Errorf("Unexpected issue in vendored definition: %+v", issue)
+		}
+	}
+}
+
+func TestSuppressionReport(t *testing.T) {
+	previous := Result{SchemaPath: "a.json", Issues: []Issue{{Code: CodeLargeUnion, Path: ParsePath("$/oneOf")}}}
+	current := Result{SchemaPath: "a.json", Issues: []Issue{
+		{Code: CodeLargeUnion, Path: ParsePath("$/oneOf")},
+		{Code: CodeLargeEnum, Path: ParsePath("$/enum")},
+	}}
+	added := Compare(previous, current).NewResult()
+	if len(added.Issues) != 1 || len(added.Suppressed) != 1 || added.Suppressed[0].Source != SuppressedBaseline {
+		t.Fatalf("Expected the persisting issue to be suppressed by the baseline: %+v", added)
+	}
+
+	added.Suppressed = append(added.Suppressed, Suppression{Issue: Issue{Code: CodeLargeEnum, Path: ParsePath("$/$defs/Country")}, Source: SuppressedInline, Reason: "ISO list"})
+	want := `Suppressed issues:
+  [baseline] $/oneOf: large-union
+  [inline] $/$defs/Country: large-enum (ISO list)
+Suppressions: 2 (baseline: 1, inline: 1)
+`
+	if got := SuppressionReport(added); got != want {
+		t.Errorf("Unexpected report:\n%s\nwant:\n%s", got, want)
+	}
+	if got := SuppressionReport(Result{}); got != "Suppressions: 0\n" {
+		t.Errorf("Unexpected empty report: %q", got)
+	}
+}
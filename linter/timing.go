@@ -0,0 +1,119 @@
+package linter
+
+import (
+	"cmp"
+	"slices"
+	"time"
+)
+
+// Timing is the execution time of a lint run, recorded when
+// Config.ProfileRules is set, to find slow rules and schemas.
+type Timing struct {
+	// Total is the time Lint took, including parsing.
+	Total time.Duration `json:"total_ns"`
+	// Rules are the time spent in each rule, slowest first. The time of a
+	// rule excludes the rules it runs on nested schemas (e.g., the union
+	// rules on variants). Definitions linted concurrently add up their
+	// times, so the durations may add up to more than Total.
+	Rules []RuleTiming `json:"rules"`
+}
+
+// RuleTiming is the time spent in a rule, or in a phase such as "parse".
+type RuleTiming struct {
+	Rule     string        `json:"rule"`
+	Duration time.Duration `json:"duration_ns"`
+	// Calls is the number of times the rule ran, e.g., once per schema node.
+	Calls int `json:"calls"`
+}
+
+// profiler accumulates the time of the rules of a lint run. A nil profiler
+// runs rules without timing them.
+type profiler struct {
+	start time.Time
+	rules map[string]*RuleTiming
+	// nested holds, for each rule running, the time of the rules run
+	// inside it so far
+	nested []time.Duration
+}
+
+// newProfiler returns a profiler if rules are profiled or traced, or nil.
+func (l *Linter) newProfiler() *profiler {
+	if !l.config.ProfileRules && !l.tracing() {
+		return nil
+	}
+	return &profiler{start: time.Now(), rules: make(map[string]*RuleTiming)}
+}
+
+// run runs fn and adds its time, less that of the rules run inside it, to
+// rule.
+func (p *profiler) run(rule string, fn func()) {
+	if p == nil {
+		fn()
+		return
+	}
+	p.nested = append(p.nested, 0)
+	start := time.Now()
+	fn()
+	elapsed := time.Since(start)
+	level := len(p.nested) - 1
+	self := elapsed - p.nested[level]
+	p.nested = p.nested[:level]
+	if level > 0 {
+		p.nested[level-1] += elapsed
+	}
+	p.add(rule, self)
+}
+
+// add adds the time of a call to rule.
+func (p *profiler) add(rule string, d time.Duration) {
+	if p == nil {
+		return
+	}
+	t := p.rules[rule]
+	if t == nil {
+		t = &RuleTiming{Rule: rule}
+		p.rules[rule] = t
+	}
+	t.Duration += d
+	t.Calls++
+}
+
+// fork returns a profiler for rules run in another goroutine, whose times
+// are added back with merge, or nil if p is nil.
+func (p *profiler) fork() *profiler {
+	if p == nil {
+		return nil
+	}
+	return &profiler{start: p.start, rules: make(map[string]*RuleTiming)}
+}
+
+// merge adds the times recorded by a forked profiler.
+func (p *profiler) merge(q *profiler) {
+	if p == nil || q == nil {
+		return
+	}
+	for name, rule := range q.rules {
+		t := p.rules[name]
+		if t == nil {
+			t = &RuleTiming{Rule: name}
+			p.rules[name] = t
+		}
+		t.Duration += rule.Duration
+		t.Calls += rule.Calls
+	}
+}
+
+// timing returns the recorded times, slowest rule first.
+func (p *profiler) timing() *Timing {
+	if p == nil {
+		return nil
+	}
+	t := &Timing{Total: time.Since(p.start), Rules: make([]RuleTiming, 0, len(p.rules))}
+	for _, rule := range p.rules {
+		t.Rules = append(t.Rules, *rule)
+	}
+	slices.SortFunc(t.Rules, func(a, b RuleTiming) int {
+		return cmp.Or(cmp.Compare(b.Duration, a.Duration), cmp.Compare(a.Rule, b.Rule))
+	})
+	return t
+}
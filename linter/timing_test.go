@@ -0,0 +1,71 @@
+package linter
+
+import (
+	"testing"
+	"time"
+)
+
+func TestProfileRules(t *testing.T) {
+	schema := []byte(`{
+		"type": "object",
+		"properties": {
+			"pet": {"anyOf": [
+				{"type": "object", "properties": {"kind": {"const": "cat"}}},
+				{"type": "object", "properties": {"kind": {"const": "dog"}}}
+			]}
+		}
+	}`)
+
+	result, err := NewWithDefaults().Lint(schema)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if result.Timing != nil {
+		t.Error("Expected no timing without ProfileRules")
+	}
+
+	config := DefaultConfig()
+	config.ProfileRules = true
+	result, err = New(config).Lint(schema)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if result.Timing == nil || result.Timing.Total <= 0 {
+		t.Fatalf("Expected a total time, got %+v", result.Timing)
+	}
+	calls := make(map[string]int)
+	var sum time.Duration
+	for i, rule := range result.Timing.Rules {
+		calls[rule.Rule] = rule.Calls
+		sum += rule.Duration
+		if i > 0 && rule.Duration > result.Timing.Rules[i-1].Duration {
+			t.Errorf("Expected the slowest rules first, got %v", result.Timing.Rules)
+		}
+	}
+	// The root, the pet property, its two variants, and their kind properties
+	if calls["dead-keyword"] != 6 || calls["unions"] != 1 || calls["parse"] != 1 {
+		t.Errorf("Unexpected call counts: %v", calls)
+	}
+	if sum > result.Timing.Total {
+		t.Errorf("Rule times %s exceed the total %s; nested rules are counted twice", sum, result.Timing.Total)
+	}
+}
+
+func TestProfilerNested(t *testing.T) {
+	p := &profiler{start: time.Now(), rules: make(map[string]*RuleTiming)}
+	p.run("outer", func() {
+		time.Sleep(2 * time.Millisecond)
+		p.run("inner", func() { time.Sleep(5 * time.Millisecond) })
+	})
+	timing := p.timing()
+	if timing.Rules[0].Rule != "inner" {
+		t.Errorf("Expected the inner rule's time to be excluded from the outer rule, got %v", timing.Rules)
+	}
+
+	var none *profiler
+	ran := false
+	none.run("rule", func() { ran = true })
+	if !ran || none.timing() != nil {
+		t.Error("Expected a nil profiler to run rules untimed")
+	}
+}
@@ -0,0 +1,52 @@
+package linter
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// lintDefinitionTitles flags definitions whose title names a different type
+// than their $defs or definitions key, once both are reduced to lowercase
+// words (so "user_profile", "UserProfile", and "User Profile" agree).
+// Generators variously name the type after the key or the title, so a
+// mismatch names the same type differently in each language.
+func (l *Linter) lintDefinitionTitles(schema *Schema, root Path, ignored map[string]bool, result *Result) {
+	check := func(defs map[string]*Schema, keyword string) {
+		for _, name := range sortedKeys(defs) {
+			def := defs[name]
+			path := root.Child(keyword, name)
+			if def == nil || def.Title == "" || ignored[path.String()] || sameName(name, def.Title) {
+				continue
+			}
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeTitleNameMismatch,
+				Severity:   SeverityWarning,
+				Path:       path,
+				Message:    fmt.Sprintf("Definition '%s' has title '%s'; generators name the type after one or the other", name, def.Title),
+				Suggestion: fmt.Sprintf("Rename the definition after its title, or set the title to '%s'", definitionTitle(name)),
+			})
+		}
+	}
+	check(schema.Defs, "$defs")
+	check(schema.Definitions, "definitions")
+}
+
+// sameName reports whether two names have the same words, ignoring case
+// and separators.
+func sameName(a, b string) bool {
+	return strings.Join(lowerWords(a), "") == strings.Join(lowerWords(b), "")
+}
+
+// definitionTitle returns a title for a definition name, its words
+// capitalized and separated by spaces (e.g., "User Profile" for
+// "user_profile").
+func definitionTitle(name string) string {
+	words := protoWords(name)
+	for i, word := range words {
+		r := []rune(word)
+		r[0] = unicode.ToUpper(r[0])
+		words[i] = string(r)
+	}
+	return strings.Join(words, " ")
+}
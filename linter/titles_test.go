@@ -0,0 +1,56 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintDefinitionTitles(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"user_profile": {"title": "Account Profile", "type": "object"},
+			"UserAccount": {"title": "User Account", "type": "object"},
+			"HTTPServer": {"title": "http-server", "type": "object"},
+			"Untitled": {"type": "object"}
+		},
+		"definitions": {
+			"Order": {"title": "Purchase", "type": "object"}
+		}
+	}`
+
+	l := NewWithDefaults()
+	result, err := l.Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	expected := map[string]string{
+		"$/$defs/user_profile": "'User Profile'",
+		"$/definitions/Order":  "'Order'",
+	}
+	for _, issue := range result.Issues {
+		if issue.Code != CodeTitleNameMismatch {
+			continue
+		}
+		want, ok := expected[issue.Path.String()]
+		if !ok {
+			t.Errorf("Unexpected title-name-mismatch issue at %s", issue.Path)
+			continue
+		}
+		if !strings.HasSuffix(issue.Suggestion, want) {
+			t.Errorf("Expected the suggestion at %s to end with %s, got %q", issue.Path, want, issue.Suggestion)
+		}
+		delete(expected, issue.Path.String())
+	}
+	for path := range expected {
+		t.Errorf("Expected title-name-mismatch warning at %s", path)
+	}
+
+	fixed, issues, err := l.Fix([]byte(schema), CodeTitleNameMismatch)
+	if err != nil || len(issues) != 2 {
+		t.Fatalf("Expected 2 fixes, got %v, %v", issues, err)
+	}
+	if !strings.Contains(string(fixed), `"title": "User Profile"`) || !strings.Contains(string(fixed), `"title": "Order"`) {
+		t.Errorf("Expected titles synced to definition names, got:\n%s", fixed)
+	}
+}
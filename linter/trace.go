@@ -0,0 +1,46 @@
+package linter
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+// tracerName is the instrumentation scope of the linter's spans.
+const tracerName = "github.com/grokify/schemakit/linter"
+
+// newTracer returns the tracer of the configured TracerProvider, or a no-op
+// tracer without one.
+func newTracer(provider trace.TracerProvider) trace.Tracer {
+	if provider == nil {
+		provider = noop.NewTracerProvider()
+	}
+	return provider.Tracer(tracerName)
+}
+
+// tracing reports whether spans are recorded.
+func (l *Linter) tracing() bool {
+	return l.config.TracerProvider != nil
+}
+
+// traceRules records a span for each rule and phase timed by a lint run,
+// as children of the span in ctx. A span starts with the lint run and lasts
+// the total time of its rule across calls, with the number of calls as an
+// attribute, since a span per call would be one per rule and schema node.
+func (l *Linter) traceRules(ctx context.Context, start time.Time, timing *Timing) {
+	if timing == nil || !l.tracing() {
+		return
+	}
+	for _, rule := range timing.Rules {
+		_, span := l.tracer.Start(ctx, "schemakit.rule "+rule.Rule,
+			trace.WithTimestamp(start),
+			trace.WithAttributes(
+				attribute.String("schemakit.rule", rule.Rule),
+				attribute.Int("schemakit.rule.calls", rule.Calls),
+			))
+		span.End(trace.WithTimestamp(start.Add(rule.Duration)))
+	}
+}
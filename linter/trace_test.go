@@ -0,0 +1,50 @@
+package linter
+
+import (
+	"context"
+	"testing"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestLintContextTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	config := DefaultConfig()
+	config.TracerProvider = sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	result, err := New(config).LintContext(context.Background(), []byte(`{"type": "object", "$defs": {"Pet": {"anyOf": [{"type": "object"}, {"type": "string"}]}}}`))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if result.Timing != nil {
+		t.Error("Expected no timing in the result without ProfileRules")
+	}
+
+	spans := make(map[string]sdktrace.ReadOnlySpan)
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+	lint := spans["schemakit.lint"]
+	if lint == nil {
+		t.Fatalf("Expected a lint span, got %v", spans)
+	}
+	for _, name := range []string{"schemakit.rule parse", "schemakit.rule resolve", "schemakit.rule unions"} {
+		span := spans[name]
+		if span == nil {
+			t.Errorf("Expected a %s span", name)
+			continue
+		}
+		if span.Parent().SpanID() != lint.SpanContext().SpanID() {
+			t.Errorf("Expected %s to be a child of the lint span", name)
+		}
+	}
+
+	if _, err := New(config).Lint([]byte(`{`)); err == nil {
+		t.Fatal("Expected a parse error")
+	}
+	last := recorder.Ended()[len(recorder.Ended())-1]
+	if last.Name() != "schemakit.lint" || len(last.Events()) == 0 {
+		t.Errorf("Expected the error recorded on the lint span, got %s with %d event(s)", last.Name(), len(last.Events()))
+	}
+}
@@ -0,0 +1,302 @@
+package linter
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// annotationKeywords are the keywords that only document a schema and have
+// no effect on validation.
+var annotationKeywords = []string{"title", "description", "examples", "$comment"}
+
+// TrimOptions configure Trim.
+type TrimOptions struct {
+	// KeepTitles keeps title keywords, e.g. for generators that name types
+	// after them.
+	KeepTitles bool
+	// Roots are JSON pointers to entry schemas (e.g., "#/$defs/PublicAPI")
+	// whose definitions are kept, in addition to those the document root
+	// references.
+	Roots []string
+	// Minify writes the schema without whitespace.
+	Minify bool
+}
+
+// TrimStats count what Trim removed.
+type TrimStats struct {
+	Annotations int `json:"annotations"`
+	Definitions int `json:"definitions"`
+}
+
+// Trim removes annotation-only keywords (title, description, examples, and
+// $comment) and the definitions no $ref reaches from the entry schemas,
+// producing a minimal schema for runtime validation. Definitions that
+// declare their own $id are kept, since they may be referenced by URI. A
+// document root that only holds definitions (a schema library) keeps all
+// of them unless Roots are given. Key order and all other keywords are
+// preserved. As with Convert, data is a single schema document or a JSON
+// array of schemas.
+func Trim(data []byte, opts TrimOptions) ([]byte, TrimStats, error) {
+	var stats TrimStats
+	doc, err := decodeDocument(data)
+	if err != nil {
+		return nil, stats, err
+	}
+
+	docs := []any{doc}
+	if d, ok := doc.([]any); ok {
+		docs = d
+	}
+	for _, d := range docs {
+		obj, ok := d.(*jsonObject)
+		if !ok {
+			continue
+		}
+		removed, err := trimDefinitions(obj, opts.Roots)
+		if err != nil {
+			return nil, stats, err
+		}
+		stats.Definitions += removed
+		stats.Annotations += trimAnnotations(obj, opts.KeepTitles)
+	}
+
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if !opts.Minify {
+		enc.SetIndent("", "  ")
+	}
+	if err := enc.Encode(doc); err != nil {
+		return nil, stats, fmt.Errorf("failed to serialize schema: %w", err)
+	}
+	return buf.Bytes(), stats, nil
+}
+
+// trimAnnotations removes the annotation keywords of a schema and its
+// subschemas, and returns how many it removed. Values of other keywords,
+// such as a property named "title" or an enum value, are left alone.
+func trimAnnotations(v any, keepTitles bool) int {
+	removed := 0
+	forEachSubschema(v, func(obj *jsonObject) {
+		for _, kw := range annotationKeywords {
+			if kw == "title" && keepTitles {
+				continue
+			}
+			if obj.index(kw) >= 0 {
+				obj.replace(kw)
+				removed++
+			}
+		}
+	})
+	return removed
+}
+
+// forEachSubschema calls fn for a schema and each of its subschemas,
+// including definitions and the draft-07 forms of items and dependencies.
+func forEachSubschema(v any, fn func(obj *jsonObject)) {
+	obj, ok := v.(*jsonObject)
+	if !ok {
+		return
+	}
+	fn(obj)
+	for _, kw := range append([]string{"additionalItems"}, subschemaKeywords...) {
+		if sub, ok := obj.get(kw); ok {
+			if items, ok := sub.([]any); ok && kw == "items" {
+				for _, item := range items {
+					forEachSubschema(item, fn)
+				}
+				continue
+			}
+			forEachSubschema(sub, fn)
+		}
+	}
+	for _, kw := range append([]string{"definitions", "dependencies"}, subschemaMapKeywords...) {
+		if m, ok := obj.get(kw); ok {
+			if m, ok := m.(*jsonObject); ok {
+				for _, member := range m.members {
+					forEachSubschema(member.Value, fn)
+				}
+			}
+		}
+	}
+	for _, kw := range subschemaArrayKeywords {
+		if a, ok := obj.get(kw); ok {
+			if a, ok := a.([]any); ok {
+				for _, sub := range a {
+					forEachSubschema(sub, fn)
+				}
+			}
+		}
+	}
+}
+
+// trimDefinitions removes the $defs and definitions entries of a document
+// that no $ref reaches from the entry schemas, and returns how many it
+// removed.
+func trimDefinitions(doc *jsonObject, roots []string) (int, error) {
+	defs := make(map[string]any)
+	var order []string
+	for _, kw := range []string{"$defs", "definitions"} {
+		if m, ok := doc.get(kw); ok {
+			if m, ok := m.(*jsonObject); ok {
+				for _, member := range m.members {
+					key := "#/" + kw + "/" + escapePointer(member.Key)
+					defs[key] = member.Value
+					order = append(order, key)
+				}
+			}
+		}
+	}
+	if len(defs) == 0 {
+		return 0, nil
+	}
+
+	reached := make(map[string]bool)
+	var queue []any
+	reach := func(key string) {
+		if !reached[key] {
+			reached[key] = true
+			queue = append(queue, defs[key])
+		}
+	}
+	for _, key := range order {
+		if def, ok := defs[key].(*jsonObject); ok && def.index("$id") >= 0 {
+			reach(key)
+		}
+	}
+	for _, root := range roots {
+		key := definitionKey(root, order)
+		if key == "" {
+			return 0, fmt.Errorf("root %q does not resolve to a definition", root)
+		}
+		reach(key)
+	}
+	library := true
+	for _, member := range doc.members {
+		if !slices.Contains([]string{"$defs", "definitions", "$schema", "$id"}, member.Key) &&
+			!slices.Contains(annotationKeywords, member.Key) {
+			library = false
+			break
+		}
+	}
+	if library && len(roots) == 0 {
+		return 0, nil
+	}
+	if !library {
+		// The root without its definitions
+		queue = append(queue, &jsonObject{members: slices.DeleteFunc(slices.Clone(doc.members), func(m jsonMember) bool {
+			return m.Key == "$defs" || m.Key == "definitions"
+		})})
+	}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, ref := range collectRefs(current) {
+			if name, ok := strings.CutPrefix(ref, "#"); ok && name != "" && !strings.HasPrefix(name, "/") {
+				// A plain-name fragment reaches the definitions declaring
+				// the anchor
+				for _, key := range order {
+					if declaresAnchor(defs[key], name) {
+						reach(key)
+					}
+				}
+				continue
+			}
+			if key := definitionKey(ref, order); key != "" {
+				reach(key)
+			}
+		}
+	}
+
+	removed := 0
+	for _, kw := range []string{"$defs", "definitions"} {
+		v, _ := doc.get(kw)
+		obj, ok := v.(*jsonObject)
+		if !ok {
+			continue
+		}
+		for _, member := range slices.Clone(obj.members) {
+			if !reached["#/"+kw+"/"+escapePointer(member.Key)] {
+				obj.replace(member.Key)
+				removed++
+			}
+		}
+		if len(obj.members) == 0 {
+			doc.replace(kw)
+		}
+	}
+	return removed, nil
+}
+
+// definitionKey returns the definition among keys that a local $ref
+// points into (e.g., "#/$defs/Money" for "#/$defs/Money/properties/amount"),
+// or "". Percent-encoded characters in the reference are decoded.
+func definitionKey(ref string, keys []string) string {
+	if unescaped, err := url.PathUnescape(ref); err == nil {
+		ref = unescaped
+	}
+	for _, key := range keys {
+		if ref == key || strings.HasPrefix(ref, key+"/") {
+			return key
+		}
+	}
+	return ""
+}
+
+// collectRefs returns the $ref and $dynamicRef values anywhere in v. Values
+// under other keywords that happen to be named $ref are included, which
+// can only keep a definition that is not needed.
+func collectRefs(v any) []string {
+	var refs []string
+	switch node := v.(type) {
+	case *jsonObject:
+		for _, member := range node.members {
+			if s, ok := member.Value.(string); ok && (member.Key == "$ref" || member.Key == "$dynamicRef") {
+				refs = append(refs, s)
+				continue
+			}
+			refs = append(refs, collectRefs(member.Value)...)
+		}
+	case []any:
+		for _, item := range node {
+			refs = append(refs, collectRefs(item)...)
+		}
+	}
+	return refs
+}
+
+// declaresAnchor reports whether v declares the $anchor or $dynamicAnchor
+// name anywhere.
+func declaresAnchor(v any, name string) bool {
+	switch node := v.(type) {
+	case *jsonObject:
+		for _, member := range node.members {
+			if (member.Key == "$anchor" || member.Key == "$dynamicAnchor") && member.Value == name {
+				return true
+			}
+			if declaresAnchor(member.Value, name) {
+				return true
+			}
+		}
+	case []any:
+		for _, item := range node {
+			if declaresAnchor(item, name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// pointerEscaper escapes the names in JSON pointers.
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// escapePointer escapes a name for use in a JSON pointer.
+func escapePointer(name string) string {
+	return pointerEscaper.Replace(name)
+}
@@ -0,0 +1,128 @@
+package linter
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestTrim(t *testing.T) {
+	schema := `{
+		"title": "Order",
+		"$comment": "internal",
+		"type": "object",
+		"properties": {
+			"title": {"type": "string", "description": "Order title", "enum": ["description"]},
+			"total": {"$ref": "#/$defs/Money", "examples": ["12.50"]},
+			"ship": {"$ref": "#address"}
+		},
+		"$defs": {
+			"Money": {"title": "Money", "allOf": [{"$ref": "#/$defs/Amount"}]},
+			"Amount": {"type": "string", "pattern": "^[0-9.]+$"},
+			"Address": {"$anchor": "address", "type": "object"},
+			"Legacy": {"type": "object"},
+			"Shared": {"$id": "https://schemas.example.com/shared.json", "type": "object"},
+			"Public API": {"$ref": "#/$defs/Legacy"}
+		}
+	}`
+
+	tests := []struct {
+		name      string
+		opts      TrimOptions
+		want      string
+		wantStats TrimStats
+	}{
+		{
+			name: "default",
+			want: `{
+				"type": "object",
+				"properties": {
+					"title": {"type": "string", "enum": ["description"]},
+					"total": {"$ref": "#/$defs/Money"},
+					"ship": {"$ref": "#address"}
+				},
+				"$defs": {
+					"Money": {"allOf": [{"$ref": "#/$defs/Amount"}]},
+					"Amount": {"type": "string", "pattern": "^[0-9.]+$"},
+					"Address": {"$anchor": "address", "type": "object"},
+					"Shared": {"$id": "https://schemas.example.com/shared.json", "type": "object"}
+				}
+			}`,
+			wantStats: TrimStats{Annotations: 5, Definitions: 2},
+		},
+		{
+			name: "keep titles and roots",
+			opts: TrimOptions{KeepTitles: true, Roots: []string{"#/$defs/Public%20API"}},
+			want: `{
+				"title": "Order",
+				"type": "object",
+				"properties": {
+					"title": {"type": "string", "enum": ["description"]},
+					"total": {"$ref": "#/$defs/Money"},
+					"ship": {"$ref": "#address"}
+				},
+				"$defs": {
+					"Money": {"title": "Money", "allOf": [{"$ref": "#/$defs/Amount"}]},
+					"Amount": {"type": "string", "pattern": "^[0-9.]+$"},
+					"Address": {"$anchor": "address", "type": "object"},
+					"Legacy": {"type": "object"},
+					"Shared": {"$id": "https://schemas.example.com/shared.json", "type": "object"},
+					"Public API": {"$ref": "#/$defs/Legacy"}
+				}
+			}`,
+			wantStats: TrimStats{Annotations: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			out, stats, err := Trim([]byte(schema), tt.opts)
+			if err != nil {
+				t.Fatalf("Failed to trim: %v", err)
+			}
+			var got, want any
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatalf("Failed to parse output: %v", err)
+			}
+			if err := json.Unmarshal([]byte(tt.want), &want); err != nil {
+				t.Fatalf("Failed to parse expected output: %v", err)
+			}
+			if !reflect.DeepEqual(got, want) {
+				t.Errorf("Expected %s, got %s", tt.want, out)
+			}
+			if stats != tt.wantStats {
+				t.Errorf("Expected stats %+v, got %+v", tt.wantStats, stats)
+			}
+		})
+	}
+}
+
+func TestTrimLibrary(t *testing.T) {
+	schema := `{"$schema": "https://json-schema.org/draft/2020-12/schema", "$defs": {"A": {"type": "string"}, "B": {"type": "integer"}}}`
+
+	out, stats, err := Trim([]byte(schema), TrimOptions{Minify: true})
+	if err != nil {
+		t.Fatalf("Failed to trim: %v", err)
+	}
+	if stats.Definitions != 0 {
+		t.Errorf("A schema library should keep its definitions, removed %d", stats.Definitions)
+	}
+	if want := `{"$schema":"https://json-schema.org/draft/2020-12/schema","$defs":{"A":{"type":"string"},"B":{"type":"integer"}}}` + "\n"; string(out) != want {
+		t.Errorf("Expected minified %s, got %s", want, out)
+	}
+
+	out, _, err = Trim([]byte(schema), TrimOptions{Roots: []string{"#/$defs/B"}})
+	if err != nil {
+		t.Fatalf("Failed to trim: %v", err)
+	}
+	var got struct {
+		Defs map[string]any `json:"$defs"`
+	}
+	if err := json.Unmarshal(out, &got); err != nil || len(got.Defs) != 1 || got.Defs["B"] == nil {
+		t.Errorf("Expected only B with --root, got %s", out)
+	}
+
+	if _, _, err := Trim([]byte(schema), TrimOptions{Roots: []string{"#/$defs/Missing"}}); err == nil {
+		t.Error("Expected an error for a root that does not resolve")
+	}
+}
@@ -0,0 +1,47 @@
+package linter
+
+import "fmt"
+
+// prefixItemPath returns the path of the i-th positional schema of a tuple:
+// under prefixItems, or under items for a draft-07 items array.
+func prefixItemPath(schema *Schema, path Path, i int) Path {
+	if schema.legacyTuple {
+		return path.Child("items").Index(i)
+	}
+	return path.Child("prefixItems").Index(i)
+}
+
+// lintOpenTuple checks that a tuple (prefixItems, or a draft-07 items array)
+// rejects elements past its positional schemas, with items: false (or
+// additionalItems: false before draft 2020-12) or a maxItems of at most the
+// number of positions. Validators accept any extra elements otherwise, while
+// generated code has a fixed-arity type such as a struct or Go array that
+// cannot hold them. A schema for the rest makes the tuple variadic, which
+// is deliberate and not reported.
+func (l *Linter) lintOpenTuple(schema *Schema, path Path, result *Result) {
+	arity := len(schema.PrefixItems)
+	if schema.MaxItems != nil && *schema.MaxItems <= arity {
+		return
+	}
+	rest, keyword := schema.Items, "items"
+	if schema.legacyTuple {
+		rest, keyword = schema.AdditionalItems, "additionalItems"
+	}
+	if rest != nil && (!rest.IsBooleanSchema || !rest.BooleanValue) {
+		return
+	}
+
+	message := fmt.Sprintf("Tuple of %d positional schema(s) accepts any number of extra elements", arity)
+	suggestion := fmt.Sprintf("Set %s: false to close the tuple, or give the extra elements a schema if it is variadic", keyword)
+	if !schema.legacyTuple && schema.AdditionalItems != nil && schema.Items == nil {
+		message += "; additionalItems has no effect beside prefixItems"
+		suggestion = "Replace additionalItems with items: false to close the tuple, as additionalItems only applies to a draft-07 items array"
+	}
+	result.Issues = append(result.Issues, Issue{
+		Code:       CodeOpenTuple,
+		Severity:   SeverityWarning,
+		Path:       path.Child(keyword),
+		Message:    message,
+		Suggestion: suggestion,
+	})
+}
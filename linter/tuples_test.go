@@ -0,0 +1,63 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestOpenTuple(t *testing.T) {
+	tests := []struct {
+		name   string
+		schema string
+		want   int
+	}{
+		{"open prefixItems", `{"type": "array", "prefixItems": [{"type": "number"}, {"type": "number"}]}`, 1},
+		{"closed prefixItems", `{"type": "array", "prefixItems": [{"type": "number"}, {"type": "number"}], "items": false}`, 0},
+		{"variadic prefixItems", `{"type": "array", "prefixItems": [{"type": "string"}], "items": {"type": "number"}}`, 0},
+		{"items true", `{"type": "array", "prefixItems": [{"type": "string"}], "items": true}`, 1},
+		{"maxItems", `{"type": "array", "prefixItems": [{"type": "number"}, {"type": "number"}], "maxItems": 2}`, 0},
+		{"larger maxItems", `{"type": "array", "prefixItems": [{"type": "number"}, {"type": "number"}], "maxItems": 3}`, 1},
+		{"open items array", `{"type": "array", "items": [{"type": "number"}, {"type": "number"}]}`, 1},
+		{"closed items array", `{"type": "array", "items": [{"type": "number"}], "additionalItems": false}`, 0},
+		{"nested", `{"properties": {"point": {"type": "array", "prefixItems": [{"type": "number"}]}}}`, 1},
+		{"list", `{"type": "array", "items": {"type": "number"}}`, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := codeIssues(t, DefaultConfig(), tt.schema, CodeOpenTuple); len(got) != tt.want {
+				t.Errorf("Expected %d issues, got %v", tt.want, got)
+			}
+		})
+	}
+
+	issues := codeIssues(t, DefaultConfig(), `{"items": [{"type": "number"}]}`, CodeOpenTuple)
+	if len(issues) != 1 || issues[0].Path.String() != "$/additionalItems" || !strings.Contains(issues[0].Suggestion, "additionalItems: false") {
+		t.Errorf("Expected the issue at additionalItems, got %v", issues)
+	}
+
+	// additionalItems is ignored beside prefixItems
+	issues = codeIssues(t, DefaultConfig(), `{"prefixItems": [{"type": "number"}], "additionalItems": false}`, CodeOpenTuple)
+	if len(issues) != 1 || issues[0].Path.String() != "$/items" || !strings.Contains(issues[0].Message, "no effect") {
+		t.Errorf("Expected the issue at items, got %v", issues)
+	}
+}
+
+func TestTupleElements(t *testing.T) {
+	// The positional schemas of both forms are parsed and linted
+	for _, schema := range []string{
+		`{"prefixItems": [{"type": "string"}, {"type": "integer", "minLength": 1}], "items": false}`,
+		`{"items": [{"type": "string"}, {"type": "integer", "minLength": 1}], "additionalItems": false}`,
+	} {
+		doc, err := ParseSchema([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to parse %s: %v", schema, err)
+		}
+		if len(doc.PrefixItems) != 2 {
+			t.Errorf("Expected 2 positional schemas, got %v", doc.PrefixItems)
+		}
+	}
+	issues := codeIssues(t, DefaultConfig(), `{"items": [{"type": "string"}, {"type": "integer", "minLength": 1}], "additionalItems": false}`, CodeDeadKeyword)
+	if len(issues) != 1 || !strings.HasPrefix(issues[0].Path.String(), "$/items/1/") {
+		t.Errorf("Expected a dead keyword at $/items/1, got %v", issues)
+	}
+}
@@ -0,0 +1,168 @@
+package linter
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+)
+
+// lintVariantOrder flags anyOf variants that can never be chosen by
+// decoders that try the variants in order and take the first match, as
+// many generated decoders do without a discriminator: an earlier variant
+// accepts every payload the later one accepts (e.g., an open object before
+// a closed object with the same required properties).
+func (l *Linter) lintVariantOrder(variants []*Schema, path Path, result *Result) {
+	for j, later := range variants {
+		if later == nil {
+			continue
+		}
+		for i, earlier := range variants[:j] {
+			if earlier == nil || !schemaCovers(earlier, later) {
+				continue
+			}
+			suggestion := fmt.Sprintf("Move variant %d before variant %d, or add a discriminator const to each variant", j, i)
+			if schemaCovers(later, earlier) {
+				suggestion = fmt.Sprintf("Remove variant %d, which accepts the same payloads as variant %d, or add a discriminator const to each variant", j, i)
+			}
+			result.Issues = append(result.Issues, Issue{
+				Code:       CodeUnionVariantOrder,
+				Severity:   SeverityWarning,
+				Path:       path.Index(j),
+				Message:    fmt.Sprintf("anyOf variant %d accepts every payload variant %d accepts, so decoders that take the first matching variant never choose variant %d", i, j, j),
+				Suggestion: suggestion,
+			})
+			break
+		}
+	}
+}
+
+// valueConstraints return the values of the keywords that constrain
+// instances of a type, nil or zero when absent.
+var valueConstraints = []func(s *Schema) any{
+	func(s *Schema) any { return s.MinLength },
+	func(s *Schema) any { return s.MaxLength },
+	func(s *Schema) any { return s.Pattern },
+	func(s *Schema) any { return s.Format },
+	func(s *Schema) any { return s.Minimum },
+	func(s *Schema) any { return s.Maximum },
+	func(s *Schema) any { return s.ExclusiveMinimum },
+	func(s *Schema) any { return s.ExclusiveMaximum },
+	func(s *Schema) any { return s.MultipleOf },
+	func(s *Schema) any { return s.MinItems },
+	func(s *Schema) any { return s.MaxItems },
+	func(s *Schema) any { return s.UniqueItems },
+	func(s *Schema) any { return s.MinProperties },
+	func(s *Schema) any { return s.MaxProperties },
+}
+
+// schemaCovers reports whether every instance of b is also an instance of
+// a, as far as can be told without resolving $refs. It is conservative:
+// constraints it cannot compare, such as composition in a, make it return
+// false.
+func schemaCovers(a, b *Schema) bool {
+	if a.IsBooleanSchema || b.IsBooleanSchema {
+		return a.IsBooleanSchema && a.BooleanValue || b.IsBooleanSchema && !b.BooleanValue
+	}
+	if a.Ref != "" || b.Ref != "" {
+		return a.Ref == b.Ref
+	}
+	if len(a.AnyOf) > 0 || len(a.OneOf) > 0 || len(a.AllOf) > 0 || a.Contains != nil || a.AdditionalPropertiesSchema != nil {
+		return false
+	}
+	if !typesCover(declaredTypes(a), declaredTypes(b)) {
+		return false
+	}
+	if a.Const != nil && (b.Const == nil || !reflect.DeepEqual(a.Const, b.Const)) {
+		return false
+	}
+	if len(a.Enum) > 0 && !valuesWithin(b, a.Enum) {
+		return false
+	}
+	for _, constraint := range valueConstraints {
+		if v := reflect.ValueOf(constraint(a)); !v.IsZero() && !reflect.DeepEqual(constraint(a), constraint(b)) {
+			return false
+		}
+	}
+	if a.Items != nil && (b.Items == nil || !schemaCovers(a.Items, b.Items)) {
+		return false
+	}
+
+	// Objects: a must require no more than b, and accept b's properties
+	for _, name := range a.Required {
+		if !slices.Contains(b.Required, name) {
+			return false
+		}
+	}
+	bClosed := b.AdditionalProperties != nil && !*b.AdditionalProperties
+	for _, name := range sortedKeys(a.Properties) {
+		prop := a.Properties[name]
+		if other := b.Properties[name]; other != nil {
+			if prop != nil && !schemaCovers(prop, other) {
+				return false
+			}
+		} else if !bClosed && prop != nil && !schemaCovers(prop, &Schema{}) {
+			// b accepts any value for the property
+			return false
+		}
+	}
+	if a.AdditionalProperties != nil && !*a.AdditionalProperties {
+		if !bClosed {
+			return false
+		}
+		for name := range b.Properties {
+			if a.Properties[name] == nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// declaredTypes returns the types a schema declares, or nil if it accepts
+// any type.
+func declaredTypes(s *Schema) []string {
+	if len(s.TypeList) > 0 {
+		return s.TypeList
+	}
+	if s.Type != "" {
+		return []string{s.Type}
+	}
+	return nil
+}
+
+// typesCover reports whether every type in b is accepted by a, where nil
+// accepts any type and number accepts integers.
+func typesCover(a, b []string) bool {
+	if a == nil {
+		return true
+	}
+	if b == nil {
+		return false
+	}
+	for _, t := range b {
+		if !slices.Contains(a, t) && !(t == "integer" && slices.Contains(a, "number")) {
+			return false
+		}
+	}
+	return true
+}
+
+// valuesWithin reports whether the only values a schema accepts, by const
+// or enum, are among values.
+func valuesWithin(s *Schema, values []any) bool {
+	contains := func(v any) bool {
+		return slices.ContainsFunc(values, func(e any) bool { return reflect.DeepEqual(e, v) })
+	}
+	if s.Const != nil {
+		return contains(s.Const)
+	}
+	if len(s.Enum) == 0 {
+		return false
+	}
+	for _, v := range s.Enum {
+		if !contains(v) {
+			return false
+		}
+	}
+	return true
+}
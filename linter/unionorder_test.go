@@ -0,0 +1,74 @@
+package linter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLintVariantOrder(t *testing.T) {
+	schema := `{
+		"$defs": {
+			"Shape": {
+				"anyOf": [
+					{"type": "object", "required": ["id"], "properties": {"id": {"type": "string"}}},
+					{"type": "object", "required": ["id", "radius"], "additionalProperties": false,
+						"properties": {"id": {"type": "string"}, "radius": {"type": "number"}}}
+				]
+			},
+			"Value": {
+				"anyOf": [
+					{"type": "number"},
+					{"type": "integer", "minimum": 0},
+					{"type": "string", "enum": ["a", "b"]},
+					{"type": "string", "const": "a"},
+					{"type": "number"}
+				]
+			},
+			"Ordered": {
+				"anyOf": [
+					{"type": "object", "required": ["id", "radius"], "additionalProperties": false,
+						"properties": {"id": {"type": "string"}, "radius": {"type": "number"}}},
+					{"type": "object", "required": ["id"], "properties": {"id": {"type": "string"}}},
+					{"type": "string", "pattern": "^[a-z]+$"},
+					{"type": "string", "maxLength": 3}
+				]
+			},
+			"Tagged": {
+				"anyOf": [
+					{"type": "object", "properties": {"kind": {"const": "a"}}},
+					{"type": "object", "properties": {"kind": {"const": "b"}, "extra": {"type": "string"}}}
+				]
+			}
+		}
+	}`
+
+	l := NewWithDefaults()
+	result, err := l.Lint([]byte(schema))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+
+	expected := map[string]string{
+		"$/$defs/Shape/anyOf/1": "Move variant 1 before variant 0",
+		"$/$defs/Value/anyOf/1": "Move variant 1 before variant 0",
+		"$/$defs/Value/anyOf/3": "Move variant 3 before variant 2",
+		"$/$defs/Value/anyOf/4": "Remove variant 4",
+	}
+	for _, issue := range result.Issues {
+		if issue.Code != CodeUnionVariantOrder {
+			continue
+		}
+		want, ok := expected[issue.Path.String()]
+		if !ok {
+			t.Errorf("Unexpected union-variant-order issue at %s: %s", issue.Path, issue.Message)
+			continue
+		}
+		if !strings.HasPrefix(issue.Suggestion, want) {
+			t.Errorf("Expected suggestion %q at %s, got %q", want, issue.Path, issue.Suggestion)
+		}
+		delete(expected, issue.Path.String())
+	}
+	for path := range expected {
+		t.Errorf("Expected union-variant-order warning at %s", path)
+	}
+}
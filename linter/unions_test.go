@@ -0,0 +1,135 @@
+package linter_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/grokify/schemakit/linter"
+	lt "github.com/grokify/schemakit/linter/linttest"
+)
+
+func TestUnionRules(t *testing.T) {
+	dogOrCat := []lt.S{
+		lt.Variant("type", "dog", lt.S{"name": lt.String()}),
+		lt.Variant("type", "cat", lt.S{"name": lt.String()}),
+	}
+	variants := make([]lt.S, 11)
+	for i := range variants {
+		variants[i] = lt.Variant("type", fmt.Sprintf("v%d", i+1), nil)
+	}
+
+	lt.Run(t, []lt.Case{
+		{
+			Name:     "nullable pattern",
+			Schema:   lt.Defs(lt.S{"NullableString": lt.AnyOf(lt.String(), lt.Type("null"))}),
+			NoIssues: true,
+		},
+		{
+			Name:     "with discriminator",
+			Schema:   lt.Defs(lt.S{"Animal": lt.AnyOf(dogOrCat...)}),
+			NoErrors: true,
+		},
+		{
+			Name: "without discriminator",
+			Schema: lt.Defs(lt.S{"BadUnion": lt.AnyOf(
+				lt.Object(lt.S{"name": lt.String()}),
+				lt.Object(lt.S{"title": lt.String()}),
+			)}),
+			Rule: linter.CodeUnionNoDiscriminator,
+			Want: []lt.Want{{Code: linter.CodeUnionNoDiscriminator, Path: "$/$defs/BadUnion/anyOf", Severity: linter.SeverityError}},
+		},
+		{
+			Name:   "large union",
+			Schema: lt.Defs(lt.S{"LargeUnion": lt.OneOf(variants...)}),
+			Rule:   linter.CodeLargeUnion,
+			Want:   []lt.Want{{Code: linter.CodeLargeUnion, Path: "$/$defs/LargeUnion/oneOf", Severity: linter.SeverityWarning}},
+		},
+		{
+			Name: "open variant",
+			Schema: lt.Defs(lt.S{"OpenUnion": lt.AnyOf(
+				lt.Variant("type", "open", nil).With("additionalProperties", true),
+				lt.Variant("type", "closed", nil).With("additionalProperties", false),
+			)}),
+			Rule: linter.CodeAdditionalProps,
+			Want: []lt.Want{{Code: linter.CodeAdditionalProps, Path: "$/$defs/OpenUnion/anyOf/0"}},
+		},
+	})
+}
+
+func TestUnionProposedDiscriminator(t *testing.T) {
+	tests := []struct {
+		name     string
+		variants string
+		want     string
+	}{
+		{
+			"known values",
+			`{"type": "object", "properties": {"status": {"type": "string", "enum": ["pending"]}, "eta": {"type": "string"}}},
+			 {"type": "object", "properties": {"status": {"type": "string", "default": "done"}, "at": {"type": "string"}}}`,
+			"Add const values to existing property 'status': pending|done",
+		},
+		{
+			"configured field preferred",
+			`{"type": "object", "properties": {"kind": {"type": "string", "examples": ["a"]}, "label": {"type": "string", "examples": ["x"]}}},
+			 {"type": "object", "properties": {"kind": {"type": "string", "examples": ["b"]}, "label": {"type": "string", "examples": ["y"]}}}`,
+			"Add const values to existing property 'kind': a|b",
+		},
+		{
+			"no distinct values",
+			`{"type": "object", "properties": {"state": {"type": "string", "default": "on"}}},
+			 {"type": "object", "properties": {"state": {"type": "string", "default": "on"}}}`,
+			"Add a unique const value to existing property 'state' in each variant",
+		},
+		{
+			"no shared string property",
+			`{"type": "object", "properties": {"name": {"type": "string"}}},
+			 {"type": "object", "properties": {"name": {"type": "integer"}}}`,
+			"Add a const property (e.g., 'type' or 'kind') to each variant with a unique value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := lt.RunRule(t, linter.CodeUnionNoDiscriminator, `{"anyOf": [`+tt.variants+`]}`, linter.DefaultConfig())
+			if len(issues) != 1 {
+				t.Fatalf("Expected one union-no-discriminator issue, got %v", issues)
+			}
+			if issues[0].Suggestion != tt.want {
+				t.Errorf("Suggestion = %q, want %q", issues[0].Suggestion, tt.want)
+			}
+		})
+	}
+}
+
+func TestUnionArrayItems(t *testing.T) {
+	// An array of unions whose variants are arrays of unions
+	schema := lt.Array(lt.AnyOf(
+		lt.Object(lt.S{"name": lt.String()}),
+		lt.Array(lt.OneOf(
+			lt.Object(lt.S{"id": lt.Integer()}),
+			lt.Object(lt.S{"title": lt.String()}),
+		)),
+	))
+	config := linter.DefaultConfig()
+	config.MaxUnionNestingDepth = 1
+	outer, inner := "$/items/anyOf", "$/items/anyOf/1/items/oneOf"
+
+	discriminators := lt.RunRule(t, linter.CodeUnionNoDiscriminator, schema, config)
+	if len(discriminators) != 2 || discriminators[0].Path.String() != outer || discriminators[1].Path.String() != inner {
+		t.Errorf("Expected union-no-discriminator at %s and %s, got: %v", outer, inner, discriminators)
+	}
+	for _, issue := range discriminators {
+		if !strings.Contains(issue.Message, "heterogeneous array requires custom unmarshalling") {
+			t.Errorf("Expected array-aware message at %s, got %q", issue.Path, issue.Message)
+		}
+	}
+
+	nested := lt.RunRule(t, linter.CodeNestedUnion, schema, config)
+	if len(nested) != 1 || nested[0].Path.String() != inner {
+		t.Fatalf("Expected nested-union at %s, got: %v", inner, nested)
+	}
+	if nested[0].Message != "Array item union nested 2 levels deep (threshold: 1)" {
+		t.Errorf("Unexpected nested-union message: %q", nested[0].Message)
+	}
+}
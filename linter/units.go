@@ -0,0 +1,109 @@
+package linter
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultUnitSuffixes returns the default unit suffix conventions: the
+// units allowed after each bare duration or size word.
+func DefaultUnitSuffixes() map[string][]string {
+	durations := []string{"ms", "seconds"}
+	return map[string][]string{
+		"timeout":  durations,
+		"duration": durations,
+		"interval": durations,
+		"delay":    durations,
+		"latency":  durations,
+		"elapsed":  durations,
+		"ttl":      {"seconds"},
+		"size":     {"bytes"},
+	}
+}
+
+// validateUnitSuffixes returns an error if a word or unit of the
+// conventions is not a lowercase alphanumeric word.
+func validateUnitSuffixes(conventions map[string][]string) error {
+	isWord := func(s string) bool {
+		return s != "" && strings.IndexFunc(s, func(r rune) bool {
+			return !unicode.IsLower(r) && !unicode.IsDigit(r)
+		}) < 0
+	}
+	for word, units := range conventions {
+		if !isWord(word) {
+			return fmt.Errorf("invalid unit suffix word %q (use a lowercase word, e.g., timeout)", word)
+		}
+		for _, unit := range units {
+			if !isWord(unit) {
+				return fmt.Errorf("invalid unit %q for %q (use a lowercase word, e.g., ms)", unit, word)
+			}
+		}
+	}
+	return nil
+}
+
+// lintUnitSuffix flags numeric properties named for a duration or size
+// (e.g., timeout, size_bytes) that lack a unit suffix or carry one the
+// conventions do not allow. String properties are skipped, since their
+// values (e.g., "30s", ISO 8601 durations) can carry the unit.
+func (l *Linter) lintUnitSuffix(schema *Schema, path Path, result *Result) {
+	for _, name := range sortedKeys(schema.Properties) {
+		prop := schema.Properties[name]
+		if prop == nil || prop.IsBooleanSchema || prop.IsRef() {
+			continue
+		}
+		if kind := schemaKind(prop); kind != "integer" && kind != "number" {
+			continue
+		}
+
+		words := protoWords(name)
+		if len(words) == 0 {
+			continue
+		}
+		last := strings.ToLower(words[len(words)-1])
+		base, word, message := name, last, ""
+		if units := l.config.UnitSuffixes[last]; len(units) > 0 {
+			message = fmt.Sprintf("Property '%s' is a %s without a unit suffix", name, last)
+		} else if len(words) > 1 {
+			word = strings.ToLower(words[len(words)-2])
+			units := l.config.UnitSuffixes[word]
+			if len(units) == 0 || slices.Contains(units, last) {
+				continue
+			}
+			base = strings.TrimRight(name[:strings.LastIndex(name, words[len(words)-1])], "_-")
+			message = fmt.Sprintf("Property '%s' has unit suffix '%s', which is not allowed for a %s", name, last, word)
+		} else {
+			continue
+		}
+
+		units := l.config.UnitSuffixes[word]
+		names := make([]string, len(units))
+		for i, unit := range units {
+			names[i] = withUnit(base, unit)
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeMissingUnitSuffix,
+			Severity:   SeverityWarning,
+			Path:       path.Child("properties", name),
+			Message:    message,
+			Suggestion: fmt.Sprintf("Rename to %s", strings.Join(names, " or ")),
+		})
+	}
+}
+
+// withUnit appends a unit to a property name in the name's own style
+// (e.g., timeout_ms, request-timeout-ms, requestTimeoutMs).
+func withUnit(name, unit string) string {
+	switch {
+	case strings.Contains(name, "-"):
+		return name + "-" + unit
+	case strings.Contains(name, "_") || strings.ToLower(name) == name:
+		return name + "_" + unit
+	default:
+		r, size := utf8.DecodeRuneInString(unit)
+		return name + string(unicode.ToUpper(r)) + unit[size:]
+	}
+}
@@ -0,0 +1,70 @@
+package linter
+
+import (
+	"testing"
+)
+
+func TestLintUnitSuffix(t *testing.T) {
+	schema := `{
+		"type": "object",
+		"properties": {
+			"timeout": {"type": "integer"},
+			"timeout_ms": {"type": "integer"},
+			"retry_delay_minutes": {"type": "integer"},
+			"requestTimeoutMs": {"type": "integer"},
+			"cacheTtl": {"type": "number"},
+			"max_size": {"type": "integer"},
+			"size_bytes": {"type": "integer"},
+			"interval": {"type": "string", "format": "duration"},
+			"name": {"type": "string"}
+		}
+	}`
+
+	issues := func(config Config) map[string]string {
+		result, err := New(config).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		got := make(map[string]string)
+		for _, issue := range result.Issues {
+			if issue.Code == CodeMissingUnitSuffix {
+				got[issue.Path.String()] = issue.Suggestion
+			}
+		}
+		return got
+	}
+
+	config := DefaultConfig()
+	config.PropertyCase = CaseNone
+	if got := issues(config); len(got) != 0 {
+		t.Errorf("Did not expect missing-unit-suffix when the rule is not enabled, got %v", got)
+	}
+
+	config.DetectUnitSuffixes = true
+	got := issues(config)
+	want := map[string]string{
+		"$/properties/timeout":             "Rename to timeout_ms or timeout_seconds",
+		"$/properties/retry_delay_minutes": "Rename to retry_delay_ms or retry_delay_seconds",
+		"$/properties/cacheTtl":            "Rename to cacheTtlSeconds",
+		"$/properties/max_size":            "Rename to max_size_bytes",
+	}
+	if len(got) != len(want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+	for path, suggestion := range want {
+		if got[path] != suggestion {
+			t.Errorf("Expected %q at %s, got %q", suggestion, path, got[path])
+		}
+	}
+
+	config.UnitSuffixes = map[string][]string{"timeout": {"ms"}, "size": {}}
+	got = issues(config)
+	if len(got) != 1 || got["$/properties/timeout"] != "Rename to timeout_ms" {
+		t.Errorf("Expected only timeout with custom conventions, got %v", got)
+	}
+
+	config.UnitSuffixes = map[string][]string{"Timeout": {"ms"}}
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for a non-lowercase unit suffix word")
+	}
+}
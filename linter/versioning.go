@@ -0,0 +1,106 @@
+package linter
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// Default version conventions: a definition name ending in V and a number
+// (e.g., EventV2), and an $id with a /v and number segment (e.g.,
+// https://example.com/schemas/event/v2).
+const (
+	DefaultVersionNamePattern = `V([0-9]+)$`
+	DefaultVersionIDPattern   = `/v([0-9]+)(/|$)`
+)
+
+// compileVersionPattern compiles a version convention, or returns nil for
+// an empty pattern.
+func compileVersionPattern(pattern string) (*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid version pattern %q: %w", pattern, err)
+	}
+	return re, nil
+}
+
+// versionOf returns the version a convention finds in s: its first
+// capture group, or the whole match if it has none.
+func versionOf(re *regexp.Regexp, s string) string {
+	if re == nil {
+		return ""
+	}
+	m := re.FindStringSubmatch(s)
+	switch {
+	case m == nil:
+		return ""
+	case len(m) > 1:
+		return m[1]
+	default:
+		return m[0]
+	}
+}
+
+// lintVersionNaming reports definitions that are not exempt and have no
+// version marker in their name or $id, a marker in only one of them, or
+// different versions in each. The document root is checked by its $id.
+func (l *Linter) lintVersionNaming(schema *Schema, root Path, result *Result) {
+	if l.versionName == nil && l.versionID == nil {
+		return
+	}
+	if l.versionID != nil && schema.ID != "" && versionOf(l.versionID, schema.ID) == "" {
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeVersionNaming,
+			Severity:   SeverityWarning,
+			Path:       root,
+			Message:    fmt.Sprintf("Document $id %s has no version marker", schema.ID),
+			Suggestion: fmt.Sprintf("Add a version to the $id matching %s (e.g., .../v1)", l.versionID),
+		})
+	}
+
+	check := func(def *Schema, name string, path Path) {
+		if def == nil || matchesAny(l.config.VersionExemptions, name) {
+			return
+		}
+		nameVersion := versionOf(l.versionName, name)
+		idVersion := ""
+		if def.ID != "" {
+			idVersion = versionOf(l.versionID, def.ID)
+		}
+
+		var message, suggestion string
+		switch {
+		case nameVersion == "" && idVersion == "":
+			message = fmt.Sprintf("Definition '%s' has no version marker", name)
+			suggestion = "Add a version marker to the definition name or $id, or list the definition in version_exemptions"
+		case def.ID == "" || l.versionID == nil || l.versionName == nil:
+			return
+		case nameVersion == "":
+			message = fmt.Sprintf("Definition '%s' has version %s in its $id but no version marker in its name", name, idVersion)
+			suggestion = "Add the version to the definition name"
+		case idVersion == "":
+			message = fmt.Sprintf("Definition '%s' has version %s in its name but no version marker in its $id", name, nameVersion)
+			suggestion = "Add the version to the $id"
+		case nameVersion != idVersion:
+			message = fmt.Sprintf("Definition '%s' is version %s by name but version %s by $id", name, nameVersion, idVersion)
+			suggestion = "Make the name and $id versions agree"
+		default:
+			return
+		}
+		result.Issues = append(result.Issues, Issue{
+			Code:       CodeVersionNaming,
+			Severity:   SeverityWarning,
+			Path:       path,
+			Message:    message,
+			Suggestion: suggestion,
+		})
+	}
+	for _, name := range sortedKeys(schema.Defs) {
+		check(schema.Defs[name], name, root.Child("$defs", name))
+	}
+	for _, name := range sortedKeys(schema.Definitions) {
+		check(schema.Definitions[name], name, root.Child("definitions", name))
+	}
+}
@@ -0,0 +1,64 @@
+package linter
+
+import (
+	"testing"
+)
+
+func TestLintVersionNaming(t *testing.T) {
+	schema := `{
+		"$id": "https://example.com/schemas/events",
+		"$defs": {
+			"EventV2": {"$id": "https://example.com/schemas/event/v2", "type": "object"},
+			"OrderV3": {"$id": "https://example.com/schemas/order/v2", "type": "object"},
+			"RefundV1": {"$id": "https://example.com/schemas/refund", "type": "object"},
+			"PaymentV1": {"type": "object"},
+			"Shipment": {"type": "object"},
+			"Address": {"type": "object"}
+		}
+	}`
+
+	paths := func(config Config) []string {
+		result, err := New(config).Lint([]byte(schema))
+		if err != nil {
+			t.Fatalf("Failed to lint: %v", err)
+		}
+		var got []string
+		for _, issue := range result.Issues {
+			if issue.Code == CodeVersionNaming {
+				got = append(got, issue.Path.String())
+			}
+		}
+		return got
+	}
+
+	config := DefaultConfig()
+	if got := paths(config); len(got) != 0 {
+		t.Errorf("Did not expect version-naming when the rule is not enabled, got %v", got)
+	}
+
+	config.DetectVersionNaming = true
+	config.VersionExemptions = []string{"Address"}
+	got := paths(config)
+	want := []string{"$", "$/$defs/OrderV3", "$/$defs/RefundV1", "$/$defs/Shipment"}
+	if len(got) != len(want) {
+		t.Fatalf("Expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Expected %v, got %v", want, got)
+			break
+		}
+	}
+
+	config.VersionNamePattern = `_v(\d+)$`
+	config.VersionIDPattern = ""
+	config.VersionExemptions = []string{"*"}
+	if got := paths(config); len(got) != 0 {
+		t.Errorf("Expected no issues with all definitions exempt, got %v", got)
+	}
+
+	config.VersionNamePattern = `V(`
+	if err := config.Validate(); err == nil {
+		t.Error("Expected error for an invalid version pattern")
+	}
+}
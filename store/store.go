@@ -0,0 +1,204 @@
+// Package store records lint results in a SQLite database, so that the
+// issues of a schema can be followed across runs: when each appeared and
+// when it was fixed.
+//
+// The SQLite driver is pure Go, so the store works in builds with
+// CGO_ENABLED=0.
+package store
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	// Registers the sqlite database/sql driver
+	_ "modernc.org/sqlite"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+const schema = `
+CREATE TABLE IF NOT EXISTS runs (
+	id          INTEGER PRIMARY KEY,
+	schema_path TEXT NOT NULL,
+	schema_hash TEXT NOT NULL,
+	linted_at   TEXT NOT NULL,
+	errors      INTEGER NOT NULL,
+	warnings    INTEGER NOT NULL
+);
+CREATE INDEX IF NOT EXISTS runs_schema_path ON runs (schema_path, linted_at);
+CREATE TABLE IF NOT EXISTS issues (
+	run_id     INTEGER NOT NULL REFERENCES runs (id),
+	code       TEXT NOT NULL,
+	severity   TEXT NOT NULL,
+	path       TEXT NOT NULL,
+	message    TEXT NOT NULL,
+	suggestion TEXT NOT NULL,
+	owner      TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS issues_run_id ON issues (run_id);
+`
+
+// timeLayout is the layout of linted_at: RFC 3339 in UTC with all nine
+// fractional digits, so that the strings sort in time order. (RFC3339Nano
+// trims trailing zeros, so ":05.1Z" would sort after ":05.12Z".)
+const timeLayout = "2006-01-02T15:04:05.000000000Z07:00"
+
+// Store is a database of lint runs.
+type Store struct {
+	db *sql.DB
+}
+
+// Run is a recorded lint run of one schema.
+type Run struct {
+	ID         int64     `json:"id"`
+	SchemaPath string    `json:"schema_path"`
+	SchemaHash string    `json:"schema_hash"`
+	LintedAt   time.Time `json:"linted_at"`
+	Errors     int       `json:"errors"`
+	Warnings   int       `json:"warnings"`
+}
+
+// Change is a run with the issues that appeared and disappeared since the
+// schema's previous run.
+type Change struct {
+	Run
+	Appeared    []linter.Issue `json:"appeared"`
+	Disappeared []linter.Issue `json:"disappeared"`
+}
+
+// Open opens the store at path, creating the database if it does not exist.
+func Open(path string) (*Store, error) {
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open result store: %w", err)
+	}
+	if _, err := db.Exec(schema); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to open result store: %w", err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the database.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Hash returns the hash recorded for schema file contents.
+func Hash(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// Record stores a lint result with the hash of the linted schema and the
+// time of the run.
+func (s *Store) Record(result linter.Result, schemaHash string, at time.Time) (Run, error) {
+	run := Run{
+		SchemaPath: result.SchemaPath,
+		SchemaHash: schemaHash,
+		LintedAt:   at.UTC(),
+		Errors:     result.ErrorCount(),
+		Warnings:   result.WarningCount(),
+	}
+
+	tx, err := s.db.Begin()
+	if err != nil {
+		return run, fmt.Errorf("failed to record result: %w", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	res, err := tx.Exec(`INSERT INTO runs (schema_path, schema_hash, linted_at, errors, warnings) VALUES (?, ?, ?, ?, ?)`,
+		run.SchemaPath, run.SchemaHash, run.LintedAt.Format(timeLayout), run.Errors, run.Warnings)
+	if err != nil {
+		return run, fmt.Errorf("failed to record result: %w", err)
+	}
+	if run.ID, err = res.LastInsertId(); err != nil {
+		return run, fmt.Errorf("failed to record result: %w", err)
+	}
+	for _, issue := range result.Issues {
+		if _, err := tx.Exec(`INSERT INTO issues (run_id, code, severity, path, message, suggestion, owner) VALUES (?, ?, ?, ?, ?, ?, ?)`,
+			run.ID, issue.Code, issue.Severity, issue.Path.String(), issue.Message, issue.Suggestion, issue.Owner); err != nil {
+			return run, fmt.Errorf("failed to record result: %w", err)
+		}
+	}
+	if err := tx.Commit(); err != nil {
+		return run, fmt.Errorf("failed to record result: %w", err)
+	}
+	return run, nil
+}
+
+// Runs returns the recorded runs of a schema, oldest first.
+func (s *Store) Runs(schemaPath string) ([]Run, error) {
+	rows, err := s.db.Query(`SELECT id, schema_path, schema_hash, linted_at, errors, warnings FROM runs WHERE schema_path = ? ORDER BY linted_at, id`, schemaPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read runs: %w", err)
+	}
+	defer rows.Close()
+
+	runs := []Run{}
+	for rows.Next() {
+		var run Run
+		var at string
+		if err := rows.Scan(&run.ID, &run.SchemaPath, &run.SchemaHash, &at, &run.Errors, &run.Warnings); err != nil {
+			return nil, fmt.Errorf("failed to read runs: %w", err)
+		}
+		if run.LintedAt, err = time.Parse(time.RFC3339Nano, at); err != nil {
+			return nil, fmt.Errorf("failed to read runs: %w", err)
+		}
+		runs = append(runs, run)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read runs: %w", err)
+	}
+	return runs, nil
+}
+
+// Result returns the lint result recorded for a run.
+func (s *Store) Result(run Run) (linter.Result, error) {
+	result := linter.Result{SchemaPath: run.SchemaPath, Issues: []linter.Issue{}}
+	rows, err := s.db.Query(`SELECT code, severity, path, message, suggestion, owner FROM issues WHERE run_id = ? ORDER BY rowid`, run.ID)
+	if err != nil {
+		return result, fmt.Errorf("failed to read issues: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var issue linter.Issue
+		var path string
+		if err := rows.Scan(&issue.Code, &issue.Severity, &path, &issue.Message, &issue.Suggestion, &issue.Owner); err != nil {
+			return result, fmt.Errorf("failed to read issues: %w", err)
+		}
+		issue.Path = linter.ParsePath(path)
+		result.Issues = append(result.Issues, issue)
+	}
+	if err := rows.Err(); err != nil {
+		return result, fmt.Errorf("failed to read issues: %w", err)
+	}
+	return result, nil
+}
+
+// History returns the runs of a schema, oldest first, with the issues that
+// appeared and disappeared at each run. Issues are matched across runs as
+// in linter.Compare, by code and path.
+func (s *Store) History(schemaPath string) ([]Change, error) {
+	runs, err := s.Runs(schemaPath)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]Change, 0, len(runs))
+	previous := linter.Result{SchemaPath: schemaPath}
+	for _, run := range runs {
+		current, err := s.Result(run)
+		if err != nil {
+			return nil, err
+		}
+		c := linter.Compare(previous, current)
+		changes = append(changes, Change{Run: run, Appeared: c.New, Disappeared: c.Fixed})
+		previous = current
+	}
+	return changes, nil
+}
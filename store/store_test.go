@@ -0,0 +1,90 @@
+package store
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+func TestHistory(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "results.db"))
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	defer s.Close()
+
+	union := linter.Issue{Code: linter.CodeUnionNoDiscriminator, Severity: linter.SeverityError, Path: linter.ParsePath("$/oneOf"), Message: "no discriminator"}
+	enum := linter.Issue{Code: linter.CodeLargeEnum, Severity: linter.SeverityWarning, Path: linter.ParsePath("$/enum"), Message: "large enum", Owner: "team-pets"}
+	start := time.Date(2026, 10, 1, 9, 0, 0, 0, time.UTC)
+	for i, issues := range [][]linter.Issue{
+		{union},
+		{union, enum},
+		{enum},
+	} {
+		result := linter.Result{SchemaPath: "pets.json", Issues: issues}
+		if _, err := s.Record(result, Hash([]byte{byte(i)}), start.Add(time.Duration(i)*24*time.Hour)); err != nil {
+			t.Fatalf("Failed to record result: %v", err)
+		}
+	}
+	if _, err := s.Record(linter.Result{SchemaPath: "orders.json"}, Hash(nil), start); err != nil {
+		t.Fatalf("Failed to record result: %v", err)
+	}
+
+	changes, err := s.History("pets.json")
+	if err != nil {
+		t.Fatalf("Failed to read history: %v", err)
+	}
+	if len(changes) != 3 {
+		t.Fatalf("Expected 3 runs, got %d", len(changes))
+	}
+	if c := changes[0]; len(c.Appeared) != 1 || !reflect.DeepEqual(c.Appeared[0], union) || len(c.Disappeared) != 0 || c.Errors != 1 {
+		t.Errorf("Unexpected first run: %+v", c)
+	}
+	if c := changes[1]; len(c.Appeared) != 1 || !reflect.DeepEqual(c.Appeared[0], enum) || len(c.Disappeared) != 0 {
+		t.Errorf("Unexpected second run: %+v", c)
+	}
+	if c := changes[2]; len(c.Appeared) != 0 || len(c.Disappeared) != 1 || !reflect.DeepEqual(c.Disappeared[0], union) {
+		t.Errorf("Unexpected third run: %+v", c)
+	}
+	if !changes[2].LintedAt.Equal(start.Add(48*time.Hour)) || changes[2].SchemaHash != Hash([]byte{2}) {
+		t.Errorf("Unexpected run metadata: %+v", changes[2].Run)
+	}
+}
+
+func TestRunsSubsecondOrder(t *testing.T) {
+	s, err := Open(filepath.Join(t.TempDir(), "results.db"))
+	if err != nil {
+		t.Fatalf("Failed to open store: %v", err)
+	}
+	defer s.Close()
+
+	start := time.Date(2026, 10, 1, 9, 0, 5, 0, time.UTC)
+	times := []time.Time{
+		start.Add(120 * time.Millisecond),
+		start,
+		start.Add(100 * time.Millisecond),
+		start.Add(100*time.Millisecond + 1),
+	}
+	for _, at := range times {
+		if _, err := s.Record(linter.Result{SchemaPath: "pets.json"}, Hash(nil), at); err != nil {
+			t.Fatalf("Failed to record result: %v", err)
+		}
+	}
+
+	runs, err := s.Runs("pets.json")
+	if err != nil {
+		t.Fatalf("Failed to read runs: %v", err)
+	}
+	want := []time.Time{times[1], times[2], times[3], times[0]}
+	if len(runs) != len(want) {
+		t.Fatalf("Expected %d runs, got %d", len(want), len(runs))
+	}
+	for i, run := range runs {
+		if !run.LintedAt.Equal(want[i]) {
+			t.Errorf("Run %d linted at %s, want %s", i, run.LintedAt.Format(time.RFC3339Nano), want[i].Format(time.RFC3339Nano))
+		}
+	}
+}
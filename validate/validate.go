@@ -0,0 +1,153 @@
+// Package validate validates JSON instance documents against a JSON Schema,
+// reporting failures as lint issues so that validation shares the linter's
+// Result and output formats.
+package validate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// schemaURL is the resource URL used for schemas compiled from bytes.
+const schemaURL = "schema.json"
+
+// Validator validates instance documents against a compiled JSON Schema.
+// It is safe for concurrent use.
+type Validator struct {
+	schema *jsonschema.Schema
+}
+
+// New compiles the JSON Schema data into a Validator. As with lint, the
+// schema is normalized with linter.NormalizeJSON.
+func New(schemaData []byte) (*Validator, error) {
+	schemaData, err := linter.NormalizeJSON(schemaData)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(schemaData))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse JSON Schema: %w", err)
+	}
+
+	c := jsonschema.NewCompiler()
+	if err := c.AddResource(schemaURL, doc); err != nil {
+		return nil, fmt.Errorf("failed to load JSON Schema: %w", err)
+	}
+	return compile(c, schemaURL)
+}
+
+// NewFromFile compiles the JSON Schema file into a Validator. Relative
+// $refs to other schema files are resolved against its location.
+func NewFromFile(path string) (*Validator, error) {
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	c := jsonschema.NewCompiler()
+	c.UseLoader(jsonschema.SchemeURLLoader{"file": jsoncLoader{}})
+	return compile(c, abs)
+}
+
+// jsoncLoader loads schema files normalized with linter.NormalizeJSON.
+type jsoncLoader struct {
+	jsonschema.FileLoader
+}
+
+func (l jsoncLoader) Load(url string) (any, error) {
+	path, err := l.ToFile(url)
+	if err != nil {
+		return nil, err
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if data, err = linter.NormalizeJSON(data); err != nil {
+		return nil, err
+	}
+	return jsonschema.UnmarshalJSON(bytes.NewReader(data))
+}
+
+func compile(c *jsonschema.Compiler, url string) (*Validator, error) {
+	schema, err := c.Compile(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile JSON Schema: %w", err)
+	}
+	return &Validator{schema: schema}, nil
+}
+
+// Validate validates the instance data. Each validation failure is reported
+// as an invalid-instance error at its location within the instance (e.g.,
+// "$/pets/0/name"). It returns an error only if the data is not valid JSON.
+func (v *Validator) Validate(data []byte) (*linter.Result, error) {
+	inst, err := jsonschema.UnmarshalJSON(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse instance: %w", err)
+	}
+
+	result := &linter.Result{Issues: []linter.Issue{}}
+
+	var verr *jsonschema.ValidationError
+	if err := v.schema.Validate(inst); errors.As(err, &verr) {
+		result.Issues = issues(verr)
+	} else if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// ValidateFile validates the instance file.
+func (v *Validator) ValidateFile(path string) (*linter.Result, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	result, err := v.Validate(data)
+	if err != nil {
+		return nil, err
+	}
+	result.SchemaPath = path
+	return result, nil
+}
+
+// printer formats validation error messages.
+var printer = message.NewPrinter(language.English)
+
+// issues converts the leaf causes of a validation error to issues.
+func issues(verr *jsonschema.ValidationError) []linter.Issue {
+	if len(verr.Causes) == 0 {
+		return []linter.Issue{{
+			Code:     linter.CodeInvalidInstance,
+			Severity: linter.SeverityError,
+			Path:     linter.NewPath("$", verr.InstanceLocation...),
+			Message:  fmt.Sprintf("%s (schema: %s)", verr.ErrorKind.LocalizedString(printer), keywordLocation(verr)),
+		}}
+	}
+
+	var result []linter.Issue
+	for _, cause := range verr.Causes {
+		result = append(result, issues(cause)...)
+	}
+	return result
+}
+
+// keywordLocation returns the fragment of the failing keyword's absolute
+// location within its schema document (e.g., "#/$defs/Pet/required").
+func keywordLocation(verr *jsonschema.ValidationError) string {
+	loc := verr.SchemaURL
+	if i := strings.LastIndex(loc, "#"); i >= 0 {
+		loc = loc[i:]
+	}
+	return loc + linter.Path{Segments: verr.ErrorKind.KeywordPath()}.Pointer()
+}
@@ -0,0 +1,159 @@
+package validate
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+const petSchema = `{
+	"$defs": {
+		"Pet": {
+			"type": "object",
+			"properties": {
+				"name": {"type": "string", "minLength": 1},
+				"age": {"type": "integer"}
+			},
+			"required": ["name"]
+		}
+	},
+	"type": "object",
+	"properties": {
+		"pets": {"type": "array", "items": {"$ref": "#/$defs/Pet"}}
+	}
+}`
+
+func TestValidate(t *testing.T) {
+	v, err := New([]byte(petSchema))
+	if err != nil {
+		t.Fatalf("Failed to compile: %v", err)
+	}
+
+	result, err := v.Validate([]byte(`{"pets": [{"name": "Rex", "age": 3}]}`))
+	if err != nil {
+		t.Fatalf("Failed to validate: %v", err)
+	}
+	if len(result.Issues) != 0 {
+		t.Errorf("Expected no issues, got: %v", result.Issues)
+	}
+
+	result, err = v.Validate([]byte(`{"pets": [{"name": "Rex"}, {"age": "old"}]}`))
+	if err != nil {
+		t.Fatalf("Failed to validate: %v", err)
+	}
+	paths := make(map[string]bool)
+	for _, issue := range result.Issues {
+		if issue.Code != linter.CodeInvalidInstance || issue.Severity != linter.SeverityError {
+			t.Errorf("Unexpected issue: %v", issue)
+		}
+		paths[issue.Path.String()] = true
+	}
+	if len(result.Issues) != 2 || !paths["$/pets/1"] || !paths["$/pets/1/age"] {
+		t.Errorf("Expected issues at $/pets/1 and $/pets/1/age, got: %v", result.Issues)
+	}
+	for _, issue := range result.Issues {
+		if issue.Path.String() == "$/pets/1" && issue.Message != "missing property 'name' (schema: #/$defs/Pet/required)" {
+			t.Errorf("Unexpected message: %s", issue.Message)
+		}
+	}
+}
+
+func TestValidateEscapedPaths(t *testing.T) {
+	v, err := New([]byte(`{"type": "object", "additionalProperties": {"type": "integer"}}`))
+	if err != nil {
+		t.Fatalf("Failed to compile: %v", err)
+	}
+	result, err := v.Validate([]byte(`{"a/b": "x", "c~d": "y", "ok": 1}`))
+	if err != nil {
+		t.Fatalf("Failed to validate: %v", err)
+	}
+	paths := make(map[string]bool)
+	for _, issue := range result.Issues {
+		paths[issue.Path.String()] = true
+		if p := issue.Path; len(p.Segments) != 1 || p.Segments[0] != "a/b" && p.Segments[0] != "c~d" {
+			t.Errorf("Unexpected location %+v of %s", p, issue.Path)
+		}
+	}
+	if len(result.Issues) != 2 || !paths["$/a~1b"] || !paths["$/c~0d"] {
+		t.Errorf("Expected issues at $/a~1b and $/c~0d, got: %v", result.Issues)
+	}
+}
+
+func TestValidateErrors(t *testing.T) {
+	if _, err := New([]byte(`{"type": 5}`)); err == nil {
+		t.Error("Expected error for invalid schema")
+	}
+
+	v, err := New([]byte(`{"type": "object"}`))
+	if err != nil {
+		t.Fatalf("Failed to compile: %v", err)
+	}
+	if _, err := v.Validate([]byte(`{`)); err == nil {
+		t.Error("Expected error for invalid JSON instance")
+	}
+}
+
+func TestNewFromFileJSONC(t *testing.T) {
+	dir := t.TempDir()
+	schema := "{\n  // Pets\n  \"type\": \"object\",\n  \"properties\": {\"name\": {\"type\": \"string\"},},\n}"
+	path := filepath.Join(dir, "pet.jsonc")
+	if err := os.WriteFile(path, []byte(schema), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	v, err := NewFromFile(path)
+	if err != nil {
+		t.Fatalf("Failed to compile JSONC schema: %v", err)
+	}
+	result, err := v.Validate([]byte(`{"name": 3}`))
+	if err != nil {
+		t.Fatalf("Failed to validate: %v", err)
+	}
+	if len(result.Issues) != 1 {
+		t.Errorf("Expected one issue, got: %v", result.Issues)
+	}
+}
+
+func TestResultSchema(t *testing.T) {
+	v, err := New(linter.ResultSchema)
+	if err != nil {
+		t.Fatalf("Failed to compile the result schema: %v", err)
+	}
+
+	config := linter.DefaultConfig()
+	config.ProfileRules = true
+	result, err := linter.New(config).Lint([]byte(`{
+		"type": "object",
+		"properties": {"Bad_Name": {"type": "string"}},
+		"$defs": {"Tag": {"type": "object", "properties": {"Tag_Name": {"type": "string"}}, "x-schemalint": {"ignore": ["invalid-property-case"]}}}
+	}`))
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if len(result.Issues) == 0 || len(result.Suppressed) == 0 || result.Timing == nil {
+		t.Fatalf("Expected issues, suppressions, and timing, got %+v", result)
+	}
+	result.SchemaPath = "pet.json"
+	results := []*linter.Result{result, {SchemaPath: "empty.json"}}
+
+	for name, output := range map[string]any{
+		"result":  result,
+		"results": results,
+		"rollup":  map[string]any{"results": results, "rollup": linter.NewRollup(results, nil)},
+	} {
+		data, err := json.Marshal(output)
+		if err != nil {
+			t.Fatalf("Failed to marshal: %v", err)
+		}
+		validation, err := v.Validate(data)
+		if err != nil {
+			t.Fatalf("Failed to validate: %v", err)
+		}
+		if len(validation.Issues) > 0 {
+			t.Errorf("Expected the %s output to match the result schema, got %v", name, validation.Issues)
+		}
+	}
+}
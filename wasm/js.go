@@ -0,0 +1,27 @@
+//go:build js && wasm
+
+package wasm
+
+import (
+	"syscall/js"
+)
+
+// Register exposes Lint to JavaScript as the global function
+// schemakitLint(schemaJSON, configJSON), which returns an object with
+// either a "result" (JSON string) or an "error" (message) property.
+func Register() {
+	js.Global().Set("schemakitLint", js.FuncOf(func(this js.Value, args []js.Value) any {
+		if len(args) < 1 {
+			return map[string]any{"error": "schemakitLint requires a schema argument"}
+		}
+		configJSON := ""
+		if len(args) > 1 && args[1].Type() == js.TypeString {
+			configJSON = args[1].String()
+		}
+		result, err := Lint(args[0].String(), configJSON)
+		if err != nil {
+			return map[string]any{"error": err.Error()}
+		}
+		return map[string]any{"result": result}
+	}))
+}
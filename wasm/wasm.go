@@ -0,0 +1,36 @@
+// Package wasm provides a string-in, string-out linter API for WebAssembly
+// builds, such as browser-based schema editors.
+package wasm
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/grokify/schemakit/linter"
+)
+
+// Lint lints a JSON Schema document and returns the JSON-encoded result.
+// configJSON is a JSON-encoded linter.Config applied over the defaults
+// (e.g., {"profile": "scale", "property_case": "snake_case"}); it may be empty.
+func Lint(schemaJSON, configJSON string) (string, error) {
+	config := linter.DefaultConfig()
+	if configJSON != "" {
+		if err := json.Unmarshal([]byte(configJSON), &config); err != nil {
+			return "", fmt.Errorf("failed to parse config: %w", err)
+		}
+	}
+	if err := config.Validate(); err != nil {
+		return "", err
+	}
+
+	result, err := linter.New(config).Lint([]byte(schemaJSON))
+	if err != nil {
+		return "", err
+	}
+
+	data, err := result.JSON()
+	if err != nil {
+		return "", fmt.Errorf("failed to serialize result: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,30 @@
+package wasm
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLint(t *testing.T) {
+	schema := `{"type": "object", "properties": {"user_name": {"type": "string"}}}`
+
+	out, err := Lint(schema, "")
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if !strings.Contains(out, `"invalid-property-case"`) {
+		t.Errorf("Expected invalid-property-case with default config, got: %s", out)
+	}
+
+	out, err = Lint(schema, `{"property_case": "snake_case"}`)
+	if err != nil {
+		t.Fatalf("Failed to lint: %v", err)
+	}
+	if strings.Contains(out, `"invalid-property-case"`) {
+		t.Errorf("Expected no invalid-property-case with snake_case config, got: %s", out)
+	}
+
+	if _, err := Lint(schema, `{"profile": "unknown"}`); err == nil {
+		t.Error("Expected error for unknown profile")
+	}
+}